@@ -47,4 +47,5 @@ Fitur:
 func init() {
 	defaultOpts := defaultVal.DefaultBackupOptions(consts.ModeAll)
 	flags.AddBackupAllFlags(CmdBackupAll, &defaultOpts)
+	runner.WithFleet(CmdBackupAll)
 }
@@ -0,0 +1,60 @@
+// File : cmd/backup/coverage.go
+// Deskripsi : Command laporan coverage backup (database tanpa backup sukses terbaru)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package backupcmd
+
+import (
+	"fmt"
+
+	"sfdbtools/internal/app/backup/coverage"
+	defaultVal "sfdbtools/internal/cli/defaults"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/cli/parsing"
+
+	"github.com/spf13/cobra"
+)
+
+// CmdBackupCoverage adalah perintah untuk memeriksa database mana yang belum punya backup sukses terbaru.
+var CmdBackupCoverage = &cobra.Command{
+	Use:   "coverage",
+	Short: "Laporan database yang belum memiliki backup sukses terbaru",
+	Long: `Membandingkan daftar database live di server dengan backup sukses terbaru di direktori backup.
+
+Setiap file backup dicocokkan ke database melalui .meta.json (status, waktu selesai, daftar database)
+atau, jika metadata tidak ada, melalui pola nama file. Hasil per database:
+  - covered    backup sukses terakhir masih dalam batas --max-age
+  - stale      backup sukses terakhir lebih tua dari --max-age
+  - uncovered  tidak ada backup sukses sama sekali
+  - orphaned   backup ada tetapi database sudah tidak ada di server (informasi)
+
+Command exit non-zero jika ada database uncovered atau stale, sehingga bisa dipakai di monitoring/cron.
+Database pada backup.exclude.databases di config tidak diperiksa.`,
+	Example: `  # Periksa coverage dengan max-age default (26h)
+  sfdbtools db-backup coverage --profile prod-db
+
+  # Direktori backup dan batas umur custom
+  sfdbtools db-backup coverage --profile prod-db --backup-dir /media/ArchiveDB --max-age 50h
+
+  # Abaikan database tertentu dan terima backup lama tanpa .meta.json
+  sfdbtools db-backup coverage --profile prod-db --exclude-db 'tmp_*' --allow-missing-meta`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appdeps.Deps == nil {
+			return fmt.Errorf("dependencies tidak tersedia. Pastikan aplikasi diinisialisasi dengan benar")
+		}
+
+		opts, err := parsing.ParsingBackupCoverageOptions(cmd)
+		if err != nil {
+			return err
+		}
+		return coverage.Execute(appdeps.Deps.Config, appdeps.Deps.Logger, opts)
+	},
+}
+
+func init() {
+	defaultOpts := defaultVal.DefaultBackupCoverageOptions()
+	flags.AddBackupCoverageFlags(CmdBackupCoverage, &defaultOpts)
+}
@@ -56,6 +56,7 @@ func init() {
 
 	// Tambahkan flag --mode khusus untuk filter command
 	CmdBackupFilter.Flags().String("mode", "single-file", "Mode backup: single-file (semua database dalam satu file) atau multi-file (satu file per database)")
+	runner.WithFleet(CmdBackupFilter)
 }
 
 func getBackupMode(cmd *cobra.Command) (string, error) {
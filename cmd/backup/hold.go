@@ -0,0 +1,99 @@
+// File : cmd/backup/hold.go
+// Deskripsi : Command legal hold backup (db-backup hold add|remove|list)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package backupcmd
+
+import (
+	"fmt"
+
+	"sfdbtools/internal/app/backup/hold"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/cli/parsing"
+
+	"github.com/spf13/cobra"
+)
+
+// CmdBackupHold adalah parent command untuk legal hold backup.
+var CmdBackupHold = &cobra.Command{
+	Use:   "hold",
+	Short: "Kelola legal hold: backup yang tidak boleh dihapus cleanup",
+	Long: `Legal hold menandai backup yang wajib disimpan (mis. kondisi sebelum data fix untuk audit).
+Hold dicatat di registry (backup.hold.registry) dengan alasan, owner, dan kedaluwarsa opsional.
+
+Hold dapat menargetkan satu file backup atau semua backup dengan ticket tertentu di metadata.
+Semua strategi cleanup melewati backup set yang ditahan dan menampilkannya terpisah.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+// CmdBackupHoldAdd menambahkan legal hold.
+var CmdBackupHoldAdd = &cobra.Command{
+	Use:   "add [file]",
+	Short: "Tambahkan legal hold untuk file backup atau ticket",
+	Example: `  sfdbtools db-backup hold add /media/ArchiveDB/20261017/dbsf_billing_20261017_020000_db01.sql.zst.enc --reason "Audit Q4"
+  sfdbtools db-backup hold add --ticket FIX-1234 --reason "Kondisi sebelum data fix" --expires 180d`,
+	Args:         cobra.MaximumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runHold(cmd, args, "add")
+	},
+}
+
+// CmdBackupHoldRemove menghapus legal hold.
+var CmdBackupHoldRemove = &cobra.Command{
+	Use:   "remove [file]",
+	Short: "Hapus legal hold berdasarkan file, --id, atau --ticket",
+	Example: `  sfdbtools db-backup hold remove --id hold-20261018093000
+  sfdbtools db-backup hold remove --ticket FIX-1234`,
+	Args:         cobra.MaximumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runHold(cmd, args, "remove")
+	},
+}
+
+// CmdBackupHoldList menampilkan legal hold.
+var CmdBackupHoldList = &cobra.Command{
+	Use:          "list",
+	Short:        "Tampilkan legal hold yang terdaftar",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runHold(cmd, args, "list")
+	},
+}
+
+func runHold(cmd *cobra.Command, args []string, action string) error {
+	if appdeps.Deps == nil {
+		return fmt.Errorf("dependencies tidak tersedia. Pastikan aplikasi diinisialisasi dengan benar")
+	}
+
+	opts, err := parsing.ParsingBackupHoldOptions(cmd, args, appdeps.Deps.Config.Backup.Hold.Registry)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "add":
+		return hold.ExecuteAdd(appdeps.Deps.Logger, opts)
+	case "remove":
+		if opts.File == "" && opts.ID == "" && opts.Ticket == "" {
+			return fmt.Errorf("tentukan hold yang dihapus: <file>, --id, atau --ticket")
+		}
+		return hold.ExecuteRemove(appdeps.Deps.Logger, opts)
+	default:
+		return hold.ExecuteList(opts)
+	}
+}
+
+func init() {
+	flags.AddBackupHoldAddFlags(CmdBackupHoldAdd)
+	flags.AddBackupHoldRemoveFlags(CmdBackupHoldRemove)
+	flags.AddBackupHoldListFlags(CmdBackupHoldList)
+
+	CmdBackupHold.AddCommand(CmdBackupHoldAdd, CmdBackupHoldRemove, CmdBackupHoldList)
+}
@@ -14,6 +14,8 @@ Mendukung berbagai skenario backup:
   - Backup Selektif/Bulk (filter)
   - Backup Database Tunggal (single)
   - Backup Berbasis Konvensi (primary/secondary)
+  - Laporan Coverage Backup (coverage)
+  - Legal Hold Backup (hold)
 
 Setiap command mendukung opsi standar seperti kompresi, enkripsi (opsional), dan custom output.`,
 	Example: `  # Lihat bantuan untuk command spesifik
@@ -32,4 +34,6 @@ func init() {
 	CmdBackupMain.AddCommand(CmdBackupSingle)
 	CmdBackupMain.AddCommand(CmdBackupPrimary)
 	CmdBackupMain.AddCommand(CmdBackupSecondary)
+	CmdBackupMain.AddCommand(CmdBackupCoverage)
+	CmdBackupMain.AddCommand(CmdBackupHold)
 }
@@ -43,4 +43,5 @@ Contoh:
 func init() {
 	defaultOpts := defaultVal.DefaultBackupOptions(consts.ModePrimary)
 	flags.AddBackupFlgs(CmdBackupPrimary, &defaultOpts, consts.ModePrimary)
+	runner.WithFleet(CmdBackupPrimary)
 }
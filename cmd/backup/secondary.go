@@ -43,4 +43,5 @@ Contoh:
 func init() {
 	defaultOpts := defaultVal.DefaultBackupOptions(consts.ModeSecondary)
 	flags.AddBackupFlgs(CmdBackupSecondary, &defaultOpts, consts.ModeSecondary)
+	runner.WithFleet(CmdBackupSecondary)
 }
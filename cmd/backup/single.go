@@ -43,4 +43,5 @@ Jika nama database tidak diberikan via flag, akan muncul menu interaktif untuk m
 func init() {
 	defaultOpts := defaultVal.DefaultBackupOptions(consts.ModeSingle)
 	flags.AddBackupFlgs(CmdBackupSingle, &defaultOpts, consts.ModeSingle)
+	runner.WithFleet(CmdBackupSingle)
 }
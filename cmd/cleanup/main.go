@@ -10,6 +10,10 @@ var CmdCleanupMain = &cobra.Command{
 	Long: `Perintah untuk membersihkan file-file backup lama di direktori output.
 Gunakan sub-perintah untuk menjalankan pembersihan, atau membersihkan berdasarkan pola tertentu.
 
+File backup diperlakukan sebagai backup set: dump beserta .meta.json, file grants (_users.sql),
+dan file GTID ikut terhapus bersama dump-nya. Metadata yang merujuk beberapa dump baru dihapus
+setelah semua dump tersebut terhapus. Gunakan 'cleanup orphans' untuk melihat file yang tertinggal.
+
 Gunakan flag --dry-run untuk melihat pratinjau tanpa menghapus file.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cmd.Help()
@@ -0,0 +1,37 @@
+package cleanupcmd
+
+import (
+	"sfdbtools/internal/app/cleanup"
+	defaultVal "sfdbtools/internal/cli/defaults"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+
+	"github.com/spf13/cobra"
+)
+
+// CmdCleanupOrphans melaporkan file yang tidak lagi membentuk backup set utuh
+var CmdCleanupOrphans = &cobra.Command{
+	Use:   "orphans",
+	Short: "Laporkan metadata tanpa dump, dump tanpa metadata, dan direktori kosong",
+	Long: `Memeriksa direktori backup dan melaporkan file yang tidak lagi membentuk backup set utuh:
+  - metadata (.meta.json) yang dump-nya sudah tidak ada
+  - file grants (_users.sql) yang dump-nya sudah tidak ada
+  - dump tanpa metadata (status backup tidak dapat diverifikasi)
+  - direktori tanggal yang kosong
+
+Perintah ini hanya melaporkan; tidak ada file yang dihapus.`,
+	Example: `  sfdbtools cleanup orphans
+  sfdbtools cleanup orphans --backup-dir /mnt/nas/backup`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cleanup.ExecuteCleanup(cmd, appdeps.Deps, "orphans"); err != nil {
+			appdeps.Deps.Logger.Error("cleanup orphans gagal: " + err.Error())
+		}
+	},
+}
+
+func init() {
+	defaultOpts := defaultVal.DefaultCleanupOptions()
+	flags.AddCleanupOrphansFlags(CmdCleanupOrphans, &defaultOpts)
+
+	CmdCleanupMain.AddCommand(CmdCleanupOrphans)
+}
@@ -13,8 +13,36 @@ import (
 var CmdCleanupRun = &cobra.Command{
 	Use:   "run",
 	Short: "Jalankan pembersihan file backup lama (sesuai retensi)",
-	Long: `Menjalankan pembersihan file backup lama sesuai konfigurasi retensi (backup.retention.days).
-File yang lebih tua dari jumlah hari retensi akan dihapus.`,
+	Long: `Menjalankan pembersihan file backup lama sesuai konfigurasi retensi (backup.cleanup).
+
+Default: file yang lebih tua dari jumlah hari retensi (backup.cleanup.days / --days) akan dihapus.
+Jika salah satu keep_daily/keep_weekly/keep_monthly/keep_yearly diisi, policy GFS dipakai:
+per database, backup sukses terbaru di setiap hari/minggu/bulan/tahun dipertahankan sampai batas
+masing-masing bucket, sisanya dihapus.
+
+Strategi lain (pilih salah satu, via config backup.cleanup atau flag):
+  --keep-last N    simpan N backup sukses terakhir per database (cocok untuk backup per jam)
+  --max-total SIZE jaga total ukuran backup di bawah kuota, hapus file terlama lebih dulu
+
+Semua strategi per database tidak pernah menghapus backup sukses terbaru database tersebut.
+Flag strategi di CLI meng-override strategi dari config. --job NAME memakai output.base_directory dan
+strategi cleanup dari backup.scheduler.jobs[] (mis. per share/NAS); --backup-dir meng-override direktorinya.
+
+Gunakan --dry-run untuk melihat rencana file mana mengisi bucket mana.`,
+	Example: `  # Retensi berbasis hari
+  sfdbtools cleanup run --days 30
+
+  # Pratinjau policy GFS: 7 harian, 4 mingguan, 12 bulanan, 3 tahunan
+  sfdbtools cleanup run --keep-daily 7 --keep-weekly 4 --keep-monthly 12 --keep-yearly 3 --dry-run
+
+  # Simpan 7 backup terakhir per database
+  sfdbtools cleanup run --keep-last 7
+
+  # Jaga share NAS di bawah kuota 2TB
+  sfdbtools cleanup run --backup-dir /mnt/nas/backup --max-total 2TB --dry-run
+
+  # Strategi cleanup dari job scheduler (backup.scheduler.jobs[].cleanup)
+  sfdbtools cleanup run --job nfs_every_3_days --dry-run`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := cleanup.ExecuteCleanup(cmd, appdeps.Deps, "run"); err != nil {
 			appdeps.Deps.Logger.Error("cleanup gagal: " + err.Error())
@@ -27,4 +55,6 @@ func init() {
 	defaultOpts := defaultVal.DefaultCleanupOptions()
 
 	flags.AddCleanupFlags(CmdCleanupRun, &defaultOpts)
+
+	CmdCleanupMain.AddCommand(CmdCleanupRun)
 }
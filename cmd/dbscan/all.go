@@ -8,6 +8,7 @@ import (
 	appdeps "sfdbtools/internal/cli/deps"
 	"sfdbtools/internal/cli/flags"
 	"sfdbtools/internal/cli/parsing"
+	"sfdbtools/internal/cli/runner"
 	"sfdbtools/internal/shared/validation"
 
 	"github.com/spf13/cobra"
@@ -21,6 +22,9 @@ var CmdDBScanAll = &cobra.Command{
 Contoh penggunaan:
 	sfdbtools dbscan all --profile /path/to/profile.cnf.enc --profile-key SECRET
 	sfdbtools dbscan all --profile /path/to/profile.cnf.enc --exclude-system=false
+	sfdbtools dbscan all --profile prod-db --save-to inventory-db --save-db sfdbtools_inventory
+	sfdbtools dbscan all --profile prod-db --export report.xlsx
+	sfdbtools dbscan all --profile prod-db --deep --top-tables 30
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		// Pastikan dependencies tersedia
@@ -67,4 +71,5 @@ Contoh penggunaan:
 func init() {
 	// Tambahkan hanya flags yang diminta untuk 'all'
 	flags.AddDbScanAllFlags(CmdDBScanAll)
+	runner.WithFleet(CmdDBScanAll)
 }
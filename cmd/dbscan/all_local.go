@@ -21,6 +21,7 @@ var CmdDBScanAllLocal = &cobra.Command{
 Contoh penggunaan:
 	sfdbtools dbscan all-local --profile /path/to/profile.cnf.enc --profile-key SECRET
 	sfdbtools dbscan all-local --profile /path/to/profile.cnf.enc --exclude-system=false
+	sfdbtools dbscan all-local --profile /path/to/profile.cnf.enc --export report.csv
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		// Pastikan dependencies tersedia
@@ -8,6 +8,7 @@ import (
 	appdeps "sfdbtools/internal/cli/deps"
 	"sfdbtools/internal/cli/flags"
 	"sfdbtools/internal/cli/parsing"
+	"sfdbtools/internal/cli/runner"
 	"sfdbtools/internal/shared/validation"
 
 	"github.com/spf13/cobra"
@@ -27,11 +28,15 @@ Contoh penggunaan:
   sfdbtools dbscan filter --db db1,db2,db3
   sfdbtools dbscan filter --db-file path/to/file.txt
   sfdbtools dbscan filter --db db1,db2 --db-file path/to/file.txt
+  sfdbtools dbscan filter --db-file path/to/file.txt --save-to inventory-db --save-db sfdbtools_inventory
+  sfdbtools dbscan filter --db db1,db2 --export report.json
 
 Catatan:
   - Flag --exclude-db dan --exclude-file dapat dikombinasikan (hasil akan di-merge).
   - Flag --db dan --db-file dapat dikombinasikan (hasil akan di-merge).
-  - Jika kedua include dan exclude digunakan, proses scan akan dilakukan pada database yang ada di include namun tidak ada di exclude.`,
+  - Jika kedua include dan exclude digunakan, proses scan akan dilakukan pada database yang ada di include namun tidak ada di exclude.
+  - Flag --save-to menyimpan hasil scan (upsert per server+database) ke database inventory pada profile tersebut.
+  - Flag --export menulis ringkasan + detail per database ke .xlsx, .csv (plus .summary.csv), atau .json.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if appdeps.Deps == nil {
 			return fmt.Errorf("dependencies tidak tersedia. Pastikan aplikasi diinisialisasi dengan benar")
@@ -78,4 +83,5 @@ func init() {
 	// (mengikuti pattern seperti AddDbScanFlags)
 	// Catatan: flags ini tidak di-bind ke struct langsung; parsing dilakukan di pkg/parsing
 	flags.AddDbScanFilterFlags(CmdDBScanFilter)
+	runner.WithFleet(CmdDBScanFilter)
 }
@@ -0,0 +1,49 @@
+package dbscancmd
+
+import (
+	"fmt"
+	"sfdbtools/internal/app/dbscan"
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/cli/parsing"
+
+	"github.com/spf13/cobra"
+)
+
+var CmdDBScanForecast = &cobra.Command{
+	Use:   "forecast",
+	Short: "Estimasi kapan volume datadir mencapai threshold kapasitas",
+	Long: `Menghitung growth rate total ukuran data dari histori scan penuh (all / all-local) dan
+mengestimasi sisa hari sampai volume datadir mencapai threshold (default dari config).
+
+Kapasitas volume hanya tercatat otomatis untuk server lokal. Untuk server remote gunakan --volume-size.
+
+Contoh penggunaan:
+	sfdbtools db-scan forecast
+	sfdbtools db-scan forecast --profile prod-db --threshold 85
+	sfdbtools db-scan forecast --profile remote-db --volume-size 2TB --export forecast.csv
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if appdeps.Deps == nil {
+			fmt.Println("✗ Dependencies tidak tersedia. Pastikan aplikasi diinisialisasi dengan benar.")
+			return
+		}
+		logger := appdeps.Deps.Logger
+
+		opts, err := parsing.ParsingScanTrendOptions(cmd)
+		if err != nil {
+			logger.Error("gagal parsing opsi: " + err.Error())
+			return
+		}
+
+		svc := dbscan.NewDBScanService(appdeps.Deps.Config, logger, dbscanmodel.ScanOptions{})
+		if err := svc.ExecuteForecast(opts); err != nil {
+			logger.Error("db-scan forecast gagal: " + err.Error())
+		}
+	},
+}
+
+func init() {
+	flags.AddDbScanForecastFlags(CmdDBScanForecast)
+}
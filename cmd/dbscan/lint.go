@@ -0,0 +1,74 @@
+package dbscancmd
+
+import (
+	"errors"
+	"fmt"
+	"sfdbtools/internal/app/dbscan"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/cli/parsing"
+	"sfdbtools/internal/cli/runner"
+	"sfdbtools/internal/shared/validation"
+
+	"github.com/spf13/cobra"
+)
+
+// CmdDBScanLint mengimplementasikan perintah `dbscan lint` (schema advisor).
+var CmdDBScanLint = &cobra.Command{
+	Use:   "lint",
+	Short: "Periksa schema database dengan rule lint (MyISAM, tanpa PK, utf8mb3, dll)",
+	Long: `Menjalankan rule lint terhadap information_schema database terpilih dan melaporkan temuan
+beserta severity (info, warning, error) dan suggested DDL.
+
+Rule bawaan:
+  - myisam_table             tabel dengan engine MyISAM
+  - missing_primary_key      tabel tanpa PRIMARY KEY
+  - utf8mb3_charset          kolom utf8/utf8mb3 (bukan utf8mb4)
+  - duplicate_index          index duplikat / redundan
+  - auto_increment_overflow  auto-increment mendekati batas tipe kolom
+
+Rule dapat diaktifkan/nonaktifkan di config (dbscan.lint.rules). Command exit non-zero jika ada
+temuan dengan severity >= --fail-on (default dbscan.lint.fail_on), sehingga bisa dipakai sebagai gate deployment.
+
+Contoh penggunaan:
+  sfdbtools dbscan lint --list-rules
+  sfdbtools dbscan lint --profile prod-db
+  sfdbtools dbscan lint --profile prod-db --db dbsf_tenant1,dbsf_tenant2 --fail-on warning
+  sfdbtools dbscan lint --profile prod-db --rules missing_primary_key,myisam_table --show-ddl
+  sfdbtools dbscan lint --profile prod-db --export lint.xlsx --fail-on none`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appdeps.Deps == nil {
+			return fmt.Errorf("dependencies tidak tersedia. Pastikan aplikasi diinisialisasi dengan benar")
+		}
+
+		logger := appdeps.Deps.Logger
+		cfg := appdeps.Deps.Config
+
+		scanOpts, lintOpts, err := parsing.ParsingScanLintOptions(cmd, cfg)
+		if err != nil {
+			return err
+		}
+
+		if !lintOpts.ListRules {
+			if err := dbscan.ResolveScanLists(&scanOpts); err != nil {
+				return err
+			}
+		}
+
+		svc := dbscan.NewDBScanService(cfg, logger, scanOpts)
+		if err := svc.ExecuteLint(lintOpts); err != nil {
+			if errors.Is(err, validation.ErrUserCancelled) {
+				logger.Warn("Proses dibatalkan oleh pengguna.")
+				return nil
+			}
+			return err
+		}
+		return nil
+	},
+}
+
+func init() {
+	flags.AddDbScanLintFlags(CmdDBScanLint)
+	runner.WithFleet(CmdDBScanLint)
+}
@@ -8,7 +8,7 @@ var CmdDBScanMain = &cobra.Command{
 	Aliases: []string{"dbscan"},
 	Short:   "Database scanning tools (all, filter, dll)",
 	Long: `Perintah 'dbscan' digunakan untuk melakukan scanning database.
-Tersedia beberapa sub-perintah seperti all dan filter. Gunakan 'dbscan <sub-command> --help' untuk informasi lebih lanjut.`,
+Tersedia beberapa sub-perintah seperti all, filter, tables, lint, trend, dan forecast. Gunakan 'dbscan <sub-command> --help' untuk informasi lebih lanjut.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cmd.Help()
 	},
@@ -19,4 +19,8 @@ func init() {
 	CmdDBScanMain.AddCommand(CmdDBScanAll)
 	CmdDBScanMain.AddCommand(CmdDBScanFilter)
 	CmdDBScanMain.AddCommand(CmdDBScanAllLocal)
+	CmdDBScanMain.AddCommand(CmdDBScanTables)
+	CmdDBScanMain.AddCommand(CmdDBScanLint)
+	CmdDBScanMain.AddCommand(CmdDBScanTrend)
+	CmdDBScanMain.AddCommand(CmdDBScanForecast)
 }
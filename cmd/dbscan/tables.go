@@ -0,0 +1,78 @@
+package dbscancmd
+
+import (
+	"errors"
+	"fmt"
+	"sfdbtools/internal/app/dbscan"
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/cli/parsing"
+	"sfdbtools/internal/cli/runner"
+	"sfdbtools/internal/shared/validation"
+
+	"github.com/spf13/cobra"
+)
+
+// CmdDBScanTables mengimplementasikan perintah `dbscan tables`
+// untuk deep scan metrik per tabel lintas database.
+var CmdDBScanTables = &cobra.Command{
+	Use:   "tables",
+	Short: "Deep scan metrik per tabel (ukuran, fragmentasi, auto-increment)",
+	Long: `Scan database lalu kumpulkan metrik per tabel dari information_schema.TABLES:
+engine, estimasi rows, data/index length, DATA_FREE (fragmentasi), sisa auto-increment terhadap
+nilai maksimum tipe kolom, charset/collation, serta create/update time.
+
+Hasil menampilkan top-N tabel terbesar lintas semua database yang di-scan.
+Secara default semua database non-system di-scan; gunakan --db/--exclude-db untuk membatasi.
+
+Contoh penggunaan:
+  sfdbtools dbscan tables --profile prod-db
+  sfdbtools dbscan tables --profile prod-db --top-tables 50
+  sfdbtools dbscan tables --profile prod-db --db billing,crm --export tables.xlsx
+
+Catatan:
+  - Deep scan juga tersedia pada all, filter, dan all-local melalui flag --deep.
+  - Rows adalah estimasi dari information_schema (InnoDB tidak menyimpan jumlah baris pasti).`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appdeps.Deps == nil {
+			return fmt.Errorf("dependencies tidak tersedia. Pastikan aplikasi diinisialisasi dengan benar")
+		}
+
+		logger := appdeps.Deps.Logger
+		cfg := appdeps.Deps.Config
+
+		scanOpts, err := parsing.ParsingScanTablesOptions(cmd, cfg)
+		if err != nil {
+			return err
+		}
+
+		if err := dbscan.ResolveScanLists(&scanOpts); err != nil {
+			return err
+		}
+
+		svc := dbscan.NewDBScanService(cfg, logger, scanOpts)
+
+		scanConfig := dbscanmodel.ScanEntryConfig{
+			HeaderTitle: "Database Scanning - Tables",
+			ShowOptions: scanOpts.ShowOptions,
+			SuccessMsg:  "Proses deep scan tabel selesai.",
+			LogPrefix:   "Proses database scan (tables)",
+			Mode:        "tables",
+		}
+
+		if err := dbscan.ExecuteScanCommand(svc, scanConfig); err != nil {
+			if errors.Is(err, validation.ErrUserCancelled) {
+				logger.Warn("Proses dibatalkan oleh pengguna.")
+				return nil
+			}
+			return err
+		}
+		return nil
+	},
+}
+
+func init() {
+	flags.AddDbScanTablesFlags(CmdDBScanTables)
+	runner.WithFleet(CmdDBScanTables)
+}
@@ -0,0 +1,48 @@
+package dbscancmd
+
+import (
+	"fmt"
+	"sfdbtools/internal/app/dbscan"
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/cli/parsing"
+
+	"github.com/spf13/cobra"
+)
+
+var CmdDBScanTrend = &cobra.Command{
+	Use:   "trend",
+	Short: "Tampilkan pertumbuhan ukuran database dari histori scan",
+	Long: `Menganalisis histori snapshot hasil db-scan (disimpan otomatis setiap scan) untuk
+menampilkan growth rate per database serta anomali ukuran (lonjakan atau penyusutan tajam).
+
+Contoh penggunaan:
+	sfdbtools db-scan trend
+	sfdbtools db-scan trend --profile prod-db --days 30 --top 10
+	sfdbtools db-scan trend --profile prod-db --database billing
+	sfdbtools db-scan trend --days 180 --export trend.xlsx
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if appdeps.Deps == nil {
+			fmt.Println("✗ Dependencies tidak tersedia. Pastikan aplikasi diinisialisasi dengan benar.")
+			return
+		}
+		logger := appdeps.Deps.Logger
+
+		opts, err := parsing.ParsingScanTrendOptions(cmd)
+		if err != nil {
+			logger.Error("gagal parsing opsi: " + err.Error())
+			return
+		}
+
+		svc := dbscan.NewDBScanService(appdeps.Deps.Config, logger, dbscanmodel.ScanOptions{})
+		if err := svc.ExecuteTrend(opts); err != nil {
+			logger.Error("db-scan trend gagal: " + err.Error())
+		}
+	},
+}
+
+func init() {
+	flags.AddDbScanTrendFlags(CmdDBScanTrend)
+}
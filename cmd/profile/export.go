@@ -0,0 +1,39 @@
+package profilecmd
+
+import (
+	"sfdbtools/internal/app/profile"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+var CmdProfileExport = &cobra.Command{
+	Use:   "export",
+	Short: "Export profil koneksi database ke XLSX/CSV",
+	Long: `Export profil di config_dir.database_profile ke XLSX atau CSV dengan kolom yang sama seperti
+'profile import' (name, host, port, user, password, profile_key, ssh_*), sehingga hasilnya dapat di-import kembali.
+
+Password dapat dikosongkan dengan --redact, atau seluruh workbook XLSX dienkripsi dengan --encrypt-key
+(import kembali dengan --input-password). Filter nama profile dengan --name (glob).
+
+` + consts.ProfileCLIModeNonInteractiveHeader + `
+	- Wajib isi --output dan --profile-key atau --key-map.`,
+	Example: `  # 1) Export semua profil
+	sfdbtools profile export --output profiles.xlsx --profile-key "kunci"
+
+	# 2) Export profil prod tanpa password (serah terima)
+	sfdbtools profile export --output prod.xlsx --name 'prod-*' --redact
+
+	# 3) Workbook terenkripsi, lalu import kembali
+	sfdbtools profile export --output profiles.xlsx --encrypt-key "rahasia"
+	sfdbtools profile import --input profiles.xlsx --input-password "rahasia"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return profile.ExecuteProfile(cmd, appdeps.Deps, consts.ProfileModeExport)
+	},
+}
+
+func init() {
+	flags.ProfileExport(CmdProfileExport)
+}
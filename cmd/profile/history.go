@@ -0,0 +1,72 @@
+package profilecmd
+
+import (
+	"sfdbtools/internal/app/profile"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+var CmdProfileHistory = &cobra.Command{
+	Use:   "history <nama>",
+	Short: "Tampilkan riwayat versi profil (waktu, user OS, field yang berubah)",
+	Long: `Menampilkan daftar versi lama profil yang tersimpan di folder history (profile.history.dir).
+
+Versi baru dicatat otomatis setiap kali profil di-edit, di-rename, ditimpa oleh import, dihapus,
+atau di-rollback. Setiap versi berisi isi profil SEBELUM aksi tersebut dan tetap terenkripsi.
+Jumlah versi yang disimpan per profil diatur lewat profile.history.keep.`,
+	Example:      `  sfdbtools profile history prod-db`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return profile.ExecuteProfile(cmd, appdeps.Deps, consts.ProfileModeHistory)
+	},
+}
+
+var CmdProfileDiff = &cobra.Command{
+	Use:   "diff <nama> <v1> <v2>",
+	Short: "Bandingkan dua versi profil (password disamarkan)" + consts.ProfileCLIAutoInteractiveSuffix,
+	Long: `Menampilkan field yang berbeda antara dua versi profil. Versi berupa nomor dari 'profile history'
+(3 atau v3) atau "current" untuk file profil aktif. Password tidak pernah ditampilkan.
+
+` + consts.ProfileCLIModeNonInteractiveHeader + `
+	- Wajib isi --profile-key ` + consts.ProfileCLINonInteractiveEnvProfileKeyNote,
+	Example: `  # Bandingkan versi 3 dengan profil aktif
+	sfdbtools profile diff prod-db 3 current
+
+	# Bandingkan dua versi lama
+	sfdbtools profile diff --quiet prod-db v2 v5 --profile-key "my-key"`,
+	Args:         cobra.ExactArgs(3),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return profile.ExecuteProfile(cmd, appdeps.Deps, consts.ProfileModeDiff)
+	},
+}
+
+var CmdProfileRollback = &cobra.Command{
+	Use:   "rollback <nama> <versi>",
+	Short: "Kembalikan profil ke versi lama" + consts.ProfileCLIAutoInteractiveSuffix,
+	Long: `Mengembalikan file profil ke versi dari 'profile history'. Perbedaan ditampilkan sebelum konfirmasi.
+Isi profil aktif disimpan dulu ke history sehingga rollback dapat dibatalkan dengan rollback berikutnya.
+Profil yang sudah dihapus juga dapat dipulihkan selama history-nya masih ada.
+
+` + consts.ProfileCLIModeNonInteractiveHeader + `
+	- Wajib isi --profile-key ` + consts.ProfileCLINonInteractiveEnvProfileKeyNote,
+	Example: `  # Interaktif (tampilkan diff lalu konfirmasi)
+	sfdbtools profile rollback prod-db 3
+
+	# Non-interaktif
+	sfdbtools profile rollback --quiet prod-db 3 --profile-key "my-key"`,
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return profile.ExecuteProfile(cmd, appdeps.Deps, consts.ProfileModeRollback)
+	},
+}
+
+func init() {
+	flags.ProfileDiff(CmdProfileDiff)
+	flags.ProfileRollback(CmdProfileRollback)
+}
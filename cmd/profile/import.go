@@ -11,12 +11,20 @@ import (
 
 var CmdProfileImport = &cobra.Command{
 	Use:   "import",
-	Short: "Import profil database dari XLSX lokal atau Google Spreadsheet" + consts.ProfileCLIAutoInteractiveSuffix,
+	Short: "Import profil database dari XLSX/CSV, manifest YAML/JSON, Google Spreadsheet, atau option file MySQL" + consts.ProfileCLIAutoInteractiveSuffix,
 	Long: `Import profil database secara bulk.
 
 Sumber import:
-	- XLSX lokal: gunakan --input <file.xlsx>
+	- XLSX/CSV lokal: gunakan --input <file.xlsx>
+	- Manifest YAML/JSON: gunakan --input <profiles.yaml> (defaults + daftar profiles; lihat juga 'profile sync')
 	- Google Spreadsheet: gunakan --gsheet <url> dan --gid <gid>
+	- Option file MySQL/MariaDB: gunakan --from-mycnf <file> (mis. /root/.my.cnf, /etc/mysql/debian.cnf)
+	- Login path mysql_config_editor: gunakan --from-login-path (default ~/.mylogin.cnf; file lain: --from-login-path=<file>)
+
+Option file / login path:
+	- Setiap section client ([client], [mysqldump], login path, dll) menjadi 1 profile; !include/!includedir diikuti.
+	- Nama profile: <nama file>[-<section>] untuk option file, nama login path untuk --from-login-path.
+	- Semua profile dienkripsi dengan --profile-key (ENV: SFDB_TARGET_PROFILE_KEY).
 
 Aturan utama:
 	- 1 row = 1 profile = 1 encryption key.
@@ -34,7 +42,14 @@ Automation:
 	# 2) Import dari Google Spreadsheet (public via link)
 	sfdbtools profile import --gsheet "https://docs.google.com/spreadsheets/d/<id>/edit?usp=sharing" --gid 0
 
-	# 3) Automation (tanpa prompt)
+	# 3) Import kredensial dari option file dan login path
+	sfdbtools profile import --from-mycnf /etc/mysql/debian.cnf --profile-key "kunci"
+	sfdbtools profile import --from-login-path --on-conflict=rename --profile-key "kunci"
+
+	# 4) Import dari manifest YAML (profile_key default via --profile-key)
+	sfdbtools profile import --input ./profiles.yaml --profile-key file:///run/secrets/sfdb_profile_key
+
+	# 5) Automation (tanpa prompt)
 	sfdbtools profile import --quiet --skip-confirm \
 	  --input ./profiles.xlsx \
 	  --on-conflict=rename \
@@ -42,6 +57,7 @@ Automation:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return profile.ExecuteProfile(cmd, appdeps.Deps, "import")
 	},
+	Args: cobra.NoArgs,
 }
 
 func init() {
@@ -35,4 +35,12 @@ func init() {
 	CmdProfileMain.AddCommand(CmdProfileEdit)
 	CmdProfileMain.AddCommand(CmdProfileClone)
 	CmdProfileMain.AddCommand(CmdProfileImport)
+	CmdProfileMain.AddCommand(CmdProfileSync)
+	CmdProfileMain.AddCommand(CmdProfileRekey)
+	CmdProfileMain.AddCommand(CmdProfileExport)
+	CmdProfileMain.AddCommand(CmdProfileTest)
+	CmdProfileMain.AddCommand(CmdProfileSSH)
+	CmdProfileMain.AddCommand(CmdProfileHistory)
+	CmdProfileMain.AddCommand(CmdProfileDiff)
+	CmdProfileMain.AddCommand(CmdProfileRollback)
 }
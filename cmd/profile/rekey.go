@@ -0,0 +1,41 @@
+package profilecmd
+
+import (
+	"sfdbtools/internal/app/profile"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+var CmdProfileRekey = &cobra.Command{
+	Use:   "rekey",
+	Short: "Rotasi kunci enkripsi profil koneksi database",
+	Long: `Mendekripsi profil dengan kunci lama lalu mengenkripsi ulang dengan kunci baru.
+
+Semua profil target harus berhasil didekripsi sebelum ada file yang diubah. File lama dibackup ke
+direktori .rekey-backup-<timestamp> di config_dir.database_profile selama proses berjalan; jika penulisan
+gagal, semua profil dikembalikan ke kunci lama. Backup dihapus setelah sukses (kecuali --keep-backup).
+
+Profil dengan kunci lama berbeda-beda dapat dipetakan via --key-map (format per baris: nama_profile = kunci).
+Kunci boleh berupa referensi secret (vault://, file://, cmd://).
+
+` + consts.ProfileCLIModeNonInteractiveHeader + `
+	- Wajib isi --new-key, --old-key atau --key-map, dan --skip-confirm.`,
+	Example: `  # 1) Rekey semua profil
+	sfdbtools profile rekey --all --old-key "lama" --new-key "baru"
+
+	# 2) Rekey profil tertentu
+	sfdbtools profile rekey --profile "prod-db" --profile "dr-db" --old-key "lama" --new-key "baru"
+
+	# 3) Profil dengan kunci lama berbeda (non-interaktif)
+	sfdbtools profile rekey --quiet --skip-confirm --all --key-map ./keys.map --new-key "vault://kv/sfdbtools/profile#key"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return profile.ExecuteProfile(cmd, appdeps.Deps, consts.ProfileModeRekey)
+	},
+}
+
+func init() {
+	flags.ProfileRekey(CmdProfileRekey)
+}
@@ -0,0 +1,53 @@
+package profilecmd
+
+import (
+	"sfdbtools/internal/app/profile"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+// CmdProfileSSH adalah perintah induk untuk utilitas SSH tunnel profil.
+var CmdProfileSSH = &cobra.Command{
+	Use:   "ssh",
+	Short: "Utilitas SSH tunnel profil (host key)",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var CmdProfileSSHTrust = &cobra.Command{
+	Use:   "trust",
+	Short: "Tampilkan dan pin fingerprint host key SSH profil" + consts.ProfileCLIAutoInteractiveSuffix,
+	Long: `Menghubungi setiap hop SSH profil (jump host berurutan lalu bastion), menampilkan fingerprint
+host key-nya, lalu menyimpannya ke known_hosts sfdbtools setelah dikonfirmasi.
+
+Host yang belum dikenal otomatis di-pin saat koneksi pertama, tetapi host key yang BERUBAH selalu
+ditolak. Gunakan command ini untuk menerima host key baru setelah memverifikasi fingerprint-nya.
+
+Kredensial SSH hanya dikirim ke jump host yang host key-nya sudah dipercaya. Jika sebuah jump host
+belum dipercaya, pemindaian berhenti di hop tersebut; pin host key-nya lalu jalankan ulang command
+untuk memindai hop berikutnya.
+
+` + consts.ProfileCLIModeNonInteractiveHeader + `
+	- Wajib isi --profile dan --profile-key ` + consts.ProfileCLINonInteractiveEnvProfileKeyNote + `
+	- Wajib isi --fingerprint (untuk setiap hop) atau --skip-confirm
+	- --skip-confirm hanya mem-pin host key yang belum dikenal; host key yang BERUBAH wajib --fingerprint`,
+	Example: `  # 1) Interaktif (pilih profil, konfirmasi per host)
+	sfdbtools profile ssh trust
+
+	# 2) Non-interaktif: pin hanya jika fingerprint sesuai
+	sfdbtools profile ssh trust --quiet --profile "prod-db" --profile-key "my-key" \
+	  --fingerprint SHA256:jumpHostFingerprint --fingerprint SHA256:bastionFingerprint`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return profile.ExecuteProfile(cmd, appdeps.Deps, consts.ProfileModeSSHTrust)
+	},
+}
+
+func init() {
+	flags.ProfileSSHTrust(CmdProfileSSHTrust)
+	CmdProfileSSH.AddCommand(CmdProfileSSHTrust)
+}
@@ -0,0 +1,45 @@
+package profilecmd
+
+import (
+	"sfdbtools/internal/app/profile"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+var CmdProfileSync = &cobra.Command{
+	Use:   "sync",
+	Short: "Samakan direktori profile dengan manifest YAML/JSON",
+	Long: `Sinkronisasi direktori profile agar sama persis dengan manifest (sumber kebenaran di git).
+
+Rencana sync ditampilkan terlebih dahulu:
+	- create    : profile ada di manifest tapi belum ada filenya.
+	- update    : isi profile berbeda dari manifest (versi lama tersimpan di history).
+	- unchanged : profile sudah sesuai.
+	- extra     : file profile tidak ada di manifest.
+
+Profile extra tidak pernah dihapus tanpa konfirmasi: mode interaktif selalu bertanya,
+mode automation hanya menghapus bila --prune disertakan.
+Manifest divalidasi sama seperti 'profile import'; satu row invalid membatalkan seluruh sync.
+`,
+	Example: `  # 1) Lihat rencana tanpa mengubah apa pun
+	sfdbtools profile sync --input ./profiles.yaml --dry-run
+
+	# 2) Sync interaktif (konfirmasi sebelum menulis dan menghapus)
+	sfdbtools profile sync --input ./profiles.yaml
+
+	# 3) Automation (CI/CD): tulis perubahan dan hapus profile di luar manifest
+	sfdbtools profile sync --quiet --skip-confirm --prune \
+	  --input ./profiles.yaml \
+	  --profile-key file:///run/secrets/sfdb_profile_key`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return profile.ExecuteProfile(cmd, appdeps.Deps, consts.ProfileModeSync)
+	},
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	flags.ProfileSync(CmdProfileSync)
+}
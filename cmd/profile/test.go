@@ -0,0 +1,45 @@
+package profilecmd
+
+import (
+	"sfdbtools/internal/app/profile"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+var CmdProfileTest = &cobra.Command{
+	Use:   "test",
+	Short: "Test koneksi profil database" + consts.ProfileCLIAutoInteractiveSuffix,
+	Long: `Menjalankan test koneksi bertahap untuk sebuah profil: DNS, TCP, SSH tunnel (jika aktif),
+dan autentikasi database. Setelah terkoneksi, server diperiksa: versi dan flavor, status read_only,
+role replikasi (primary/replica/standalone), serta privilege user untuk backup dan restore.
+Exit code non-zero jika ada profil yang tidak sehat.
+
+Gunakan --all atau --profiles untuk mengetes banyak profil sekaligus secara paralel
+(lewat SSH tunnel jika dikonfigurasi). Laporan berupa tabel atau JSON (--format json),
+cocok untuk health check terjadwal.
+
+` + consts.ProfileCLIModeNonInteractiveHeader + `
+	- Wajib isi --profile (atau --all/--profiles) dan --profile-key ` + consts.ProfileCLINonInteractiveEnvProfileKeyNote,
+	Example: `  # 1) Interaktif (pilih profil)
+	sfdbtools profile test
+
+	# 2) Non-interaktif
+	sfdbtools profile test --quiet --profile "dev-db" --profile-key "my-key"
+
+	# 3) Semua profil production, 8 paralel
+	sfdbtools profile test --profiles 'tag:env=prod' --concurrency 8 --profile-key "my-key"
+
+	# 4) Health check pagi: semua profil, laporan JSON
+	sfdbtools profile test --quiet --all --format json --profile-key "my-key" > health.json`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return profile.ExecuteProfile(cmd, appdeps.Deps, consts.ProfileModeTest)
+	},
+}
+
+func init() {
+	flags.ProfileTest(CmdProfileTest)
+}
@@ -2,7 +2,7 @@
 // Deskripsi : Root command untuk aplikasi sfdbtools
 // Author : Hadiyatna Muflihun
 // Tanggal : 3 Oktober 2024
-// Last Modified : 26 Januari 2026
+// Last Modified : 18 Oktober 2026
 package cmd
 
 import (
@@ -17,6 +17,7 @@ import (
 	profilecmd "sfdbtools/cmd/profile"
 	restorecmd "sfdbtools/cmd/restore"
 	scriptcmd "sfdbtools/cmd/script"
+	"sfdbtools/internal/app/fleet"
 	appdeps "sfdbtools/internal/cli/deps"
 	"sfdbtools/internal/shared/runtimecfg"
 	"sfdbtools/internal/shared/sanitize"
@@ -90,6 +91,14 @@ func Execute(deps *appdeps.Dependencies) {
 	// 1. INJEKSI DEPENDENSI
 	appdeps.Deps = deps
 
+	// Proses child fleet (--profiles): exit non-zero jika command mencatat error,
+	// karena banyak command hanya me-log error tanpa mengubah exit code.
+	var childErrors *fleet.ErrorCounterHook
+	if fleet.IsChild() && appdeps.Deps != nil && appdeps.Deps.Logger != nil {
+		childErrors = &fleet.ErrorCounterHook{}
+		appdeps.Deps.Logger.AddHook(childErrors)
+	}
+
 	// 2. Eksekusi perintah Cobra
 	if err := rootCmd.Execute(); err != nil {
 		if appdeps.Deps != nil && appdeps.Deps.Logger != nil {
@@ -99,6 +108,9 @@ func Execute(deps *appdeps.Dependencies) {
 			os.Exit(1)
 		}
 	}
+	if childErrors != nil && childErrors.Count() > 0 {
+		os.Exit(fleet.ExitChildFailed)
+	}
 }
 
 func init() {
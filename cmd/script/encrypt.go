@@ -13,7 +13,16 @@ import (
 var CmdScriptEncrypt = &cobra.Command{
 	Use:   "encrypt",
 	Short: "Encrypt satu folder script menjadi .sftools",
-	Long:  "Membundle file entrypoint (mode single) atau seluruh isi foldernya (mode bundle) menjadi satu file .sftools terenkripsi.",
+	Long: `Membundle file entrypoint (mode single) atau seluruh isi foldernya (mode bundle) menjadi satu file .sftools terenkripsi.
+
+Gunakan --sign-key untuk menandatangani bundle dengan private key ed25519. Bundle tanpa tanda tangan
+(atau dari signer di luar config script.trusted_signers) ditolak oleh script run/extract/info.
+
+Parameter, binary yang dibutuhkan, versi minimal sfdbtools, dan OS target dideklarasikan di file spec
+(default sftools.yaml di folder entrypoint, atau --spec) dan disimpan di manifest bundle.
+
+Entrypoint .sql, atau folder berisi file .sql (dijalankan berurutan menurut nama), menghasilkan bundle SQL
+yang dijalankan dengan 'script run --profile --database'.`,
 	Example: `
 # Encrypt bundle dari entrypoint
 sfdbtools script encrypt --file scripts/sftr_sf7_main_menu/main_menu.sh --key "mypassword"
@@ -26,6 +35,15 @@ sfdbtools script encrypt -f scripts/tools/hello.sh -m single -k "mypassword"
 
 # Key bisa dari env SFDB_SCRIPT_KEY
 SFDB_SCRIPT_KEY="mypassword" sfdbtools script encrypt --file scripts/sftr_sf7_main_menu/main_menu.sh
+
+# Dengan file spec parameter/requirement
+sfdbtools script encrypt -f scripts/fix_saldo/main.sh -k "mypassword" --spec scripts/fix_saldo/sftools.yaml
+
+# Tandatangani bundle (key dibuat dengan: ssh-keygen -t ed25519 -f ~/.ssh/sfdb_sign)
+sfdbtools script encrypt -f scripts/tools/hello.sh -m single -k "mypassword" --sign-key ~/.ssh/sfdb_sign
+
+# Bundle SQL dari folder (001_schema.sql, 002_data.sql, ...)
+sfdbtools script encrypt -f scripts/migrasi_tenant -k "mypassword" --sign-key ~/.ssh/sfdb_sign
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		print.PrintAppHeader("Script Encrypt Tools")
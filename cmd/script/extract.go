@@ -13,7 +13,7 @@ import (
 var CmdScriptExtract = &cobra.Command{
 	Use:   "extract",
 	Short: "Extract bundle .sftools ke folder",
-	Long:  "Mendekripsi .sftools lalu mengekstrak isinya ke out-dir. Command ini butuh proteksi ganda: key + password aplikasi.",
+	Long:  "Mendekripsi .sftools lalu mengekstrak isinya ke out-dir. Command ini butuh proteksi ganda: key + password aplikasi, dan bundle harus ditandatangani signer terpercaya.",
 	Example: `
 # Extract bundle untuk diedit
 sfdbtools script extract -f /etc/sfDBTools/scripts/tes.sftools -o ./tes_extracted -k "mypassword"
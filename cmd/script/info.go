@@ -13,10 +13,13 @@ import (
 var CmdScriptInfo = &cobra.Command{
 	Use:   "info",
 	Short: "Tampilkan info bundle .sftools",
-	Long:  "Mendekripsi bundle .sftools dan membaca manifest untuk menampilkan entrypoint dan metadata.",
+	Long:  "Mendekripsi bundle .sftools dan membaca manifest untuk menampilkan entrypoint, metadata, digest, dan signer.",
 	Example: `
 # Info bundle
 sfdbtools script info -f /etc/sfDBTools/scripts/tes.sftools -k "mypassword"
+
+# Lihat signer bundle yang belum dipercaya (untuk ditambahkan ke script.trusted_signers)
+sfdbtools script info -f /etc/sfDBTools/scripts/tes.sftools -k "mypassword" --allow-untrusted
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		print.PrintAppHeader("Script Info Tools")
@@ -1,10 +1,15 @@
 package scriptcmd
 
 import (
+	"fmt"
+
+	"sfdbtools/internal/app/profile/helpers/loader"
 	"sfdbtools/internal/app/script"
 	"sfdbtools/internal/cli/deps"
 	"sfdbtools/internal/cli/flags"
 	"sfdbtools/internal/cli/parsing"
+	"sfdbtools/internal/cli/runner"
+	"sfdbtools/internal/shared/consts"
 	"sfdbtools/internal/ui/print"
 
 	"github.com/spf13/cobra"
@@ -13,7 +18,7 @@ import (
 var CmdScriptRun = &cobra.Command{
 	Use:   "run",
 	Short: "Jalankan .sftools",
-	Long:  "Mendekripsi .sftools ke folder temporary, lalu menjalankan entrypoint-nya dengan bash.",
+	Long:  "Memverifikasi tanda tangan .sftools (signer harus ada di config script.trusted_signers), mendekripsinya ke folder temporary, lalu menjalankan entrypoint-nya dengan bash. Dengan --profile, koneksi profile (termasuk SSH tunnel) disiapkan untuk script lewat env SFDB_DB_* dan defaults-file sementara; ticket dan profile dicatat di audit log. Bundle SQL dijalankan lewat client database ke --database dan dicatat di tabel sfdbtools_script_runs.",
 	Example: `
 # Run bundle
 sfdbtools script run --file scripts/sftr_sf7_main_menu/main_menu.sftools --key "mypassword"
@@ -29,19 +34,72 @@ sfdbtools script run -f tes -k "mypassword" -- --version
 sfdbtools script run -f tes -k "mypassword" -- training ticket tes
 sfdbtools script run -f tes -k "mypassword" -- --mode=1
 sfdbtools script run -f tes -k "mypassword" -- --mode 1
+
+# Bundle dengan deklarasi parameter (lihat: script info); nilai diterima script sebagai env SFDB_PARAM_<NAMA>
+sfdbtools script run -f fix_saldo -k "mypassword" -- tenant=acme limit=100 --dry_run
+SFDB_PARAM_DB_PASSWORD='vault://kv/db/prod#password' sfdbtools script run -f fix_saldo -k "mypassword" -- tenant=acme
+
+# Jalankan terhadap profile database (SSH tunnel dibuka selama script berjalan)
+# Script menerima SFDB_DB_HOST/PORT/USER/NAME dan SFDB_DB_DEFAULTS_FILE (0600, dihapus setelah selesai):
+#   mysql --defaults-file="$SFDB_DB_DEFAULTS_FILE" -e "SELECT 1"
+sfdbtools script run -f fix_saldo -k "mypassword" --profile prod-db --database app --ticket OPS-123
+
+# Bundle SQL: dijalankan via client mariadb/mysql, berhenti di error pertama, dan dicatat per file di tabel
+# sfdbtools_script_runs database target (file dengan hash yang sudah sukses tidak diterapkan dua kali)
+sfdbtools script run -f migrasi_tenant -k "mypassword" --profile prod-db --database app --ticket OPS-124 --transaction
+
+# Lanjutkan bundle SQL yang sebelumnya gagal tanpa transaksi (setelah database diperiksa/diperbaiki manual)
+sfdbtools script run -f migrasi_tenant -k "mypassword" --profile prod-db --database app --ticket OPS-124 --force-rerun
+
+# Ke banyak profile sekaligus (kunci profile lewat env, tidak pernah terlihat oleh script)
+SFDB_SCRIPT_KEY=... SFDB_SOURCE_PROFILE_KEY=... sfdbtools script run -f fix_saldo --profiles 'tag:env=prod' --ticket OPS-123 --quiet
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		print.PrintAppHeader("Script Run Tools")
 
-		opts := parsing.ParsingScriptRunOptions(cmd)
+		opts, err := parsing.ParsingScriptRunOptions(cmd)
+		if err != nil {
+			deps.Deps.Logger.Error(err.Error())
+			return
+		}
 		opts.Args = args
+		if err := loadRunProfile(&opts); err != nil {
+			deps.Deps.Logger.Error(err.Error())
+			return
+		}
 		if err := script.ExecuteRunBundle(deps.Deps.Logger, deps.Deps.Config, opts); err != nil {
 			deps.Deps.Logger.Error(err.Error())
 		}
 	},
 }
 
+// loadRunProfile me-load profile --profile (secret sudah di-resolve) untuk diteruskan ke script.
+// Dilakukan di sini karena package script tidak boleh bergantung pada loader profile (import cycle).
+func loadRunProfile(opts *script.RunOptions) error {
+	if opts.ProfilePath == "" {
+		return nil
+	}
+	cfg := deps.Deps.Config
+	if cfg == nil {
+		return fmt.Errorf("config tidak tersedia untuk memuat profile")
+	}
+	profile, err := loader.ResolveAndLoadProfile(loader.ProfileLoadOptions{
+		ConfigDir:      cfg.ConfigDir.DatabaseProfile,
+		ProfilePath:    opts.ProfilePath,
+		ProfileKey:     opts.ProfileKey,
+		EnvProfileKey:  consts.ENV_SOURCE_PROFILE_KEY,
+		RequireProfile: true,
+		ProfilePurpose: "script",
+	})
+	if err != nil {
+		return err
+	}
+	opts.Profile = profile
+	return nil
+}
+
 func init() {
 	CmdScriptMain.AddCommand(CmdScriptRun)
 	flags.AddScriptRunFlags(CmdScriptRun)
+	runner.WithFleet(CmdScriptRun)
 }
@@ -0,0 +1,292 @@
+// File : internal/app/backup/coverage/coverage.go
+// Deskripsi : Laporan coverage backup: database live tanpa backup sukses yang cukup baru
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package coverage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"sfdbtools/internal/app/backup/model/types_backup"
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/loader"
+	appconfig "sfdbtools/internal/services/config"
+	applog "sfdbtools/internal/services/log"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// ErrCoverageViolated dikembalikan jika ada database live yang uncovered atau stale.
+var ErrCoverageViolated = errors.New("coverage backup tidak terpenuhi")
+
+// Execute menjalankan laporan coverage: ambil daftar database live dari server,
+// cocokkan dengan backup sukses terbaru di direktori backup, lalu tampilkan hasilnya.
+func Execute(cfg *appconfig.Config, logger applog.Logger, opts types_backup.CoverageOptions) error {
+	print.PrintAppHeader("Backup Coverage Report")
+
+	profile, err := loader.ResolveAndLoadProfile(loader.ProfileLoadOptions{
+		ConfigDir:      cfg.ConfigDir.DatabaseProfile,
+		ProfilePath:    opts.Profile.Path,
+		ProfileKey:     opts.Profile.EncryptionKey,
+		EnvProfilePath: consts.ENV_SOURCE_PROFILE,
+		EnvProfileKey:  consts.ENV_SOURCE_PROFILE_KEY,
+		RequireProfile: true,
+		ProfilePurpose: "source",
+	})
+	if err != nil {
+		return fmt.Errorf("gagal load profile: %w", err)
+	}
+
+	client, err := profileconn.ConnectWithProfile(cfg, profile, consts.DefaultInitialDatabase)
+	if err != nil {
+		return fmt.Errorf("koneksi ke database gagal: %w", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	liveDBs, err := client.GetNonSystemDatabases(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Infof("Database live di %s:%d: %d", profile.DBInfo.Host, profile.DBInfo.Port, len(liveDBs))
+
+	logger.Infof("Memindai direktori backup: %s", opts.BackupDir)
+	index, warnings, err := IndexBackups(opts.BackupDir)
+	if err != nil {
+		return fmt.Errorf("gagal memindai direktori backup: %w", err)
+	}
+	for _, w := range warnings {
+		logger.Warnf("Metadata tidak dapat dibaca: %s", w)
+	}
+
+	report := BuildReport(liveDBs, index, opts, time.Now())
+	displayReport(report)
+
+	if report.Violated() {
+		return fmt.Errorf("%w: %d uncovered, %d stale (max-age %s)", ErrCoverageViolated,
+			report.Counts[types_backup.CoverageUncovered], report.Counts[types_backup.CoverageStale], opts.MaxAge)
+	}
+	print.PrintSuccess("Semua database memiliki backup sukses dalam batas max-age.")
+	return nil
+}
+
+// BuildReport mengevaluasi coverage setiap database live terhadap index backup.
+// Database di index yang tidak ada di server dilaporkan sebagai orphaned (informasi saja).
+func BuildReport(liveDBs []string, index map[string][]types_backup.CoverageBackupRecord, opts types_backup.CoverageOptions, now time.Time) types_backup.CoverageReport {
+	report := types_backup.CoverageReport{
+		GeneratedAt: now,
+		BackupDir:   opts.BackupDir,
+		MaxAge:      opts.MaxAge,
+		Counts:      make(map[string]int),
+	}
+
+	live := make(map[string]bool, len(liveDBs))
+	for _, db := range liveDBs {
+		if isExcluded(db, opts.ExcludeDatabases) {
+			report.Excluded = append(report.Excluded, db)
+			continue
+		}
+		live[db] = true
+		report.Entries = append(report.Entries, evaluateDatabase(db, index[db], opts, now))
+	}
+
+	for db, records := range index {
+		if live[db] || database.IsSystemDatabase(db) || isExcluded(db, opts.ExcludeDatabases) || containsString(liveDBs, db) {
+			continue
+		}
+		latest := latestRecord(records, func(types_backup.CoverageBackupRecord) bool { return true })
+		report.Entries = append(report.Entries, types_backup.CoverageEntry{
+			Database:   db,
+			Status:     types_backup.CoverageOrphaned,
+			LastFile:   latest.File,
+			LastTime:   latest.Time,
+			Age:        now.Sub(latest.Time),
+			TotalFound: len(records),
+			Note:       "database tidak ada di server",
+		})
+	}
+
+	for _, e := range report.Entries {
+		report.Counts[e.Status]++
+	}
+	sort.Strings(report.Excluded)
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+		a, b := report.Entries[i], report.Entries[j]
+		if statusRank(a.Status) != statusRank(b.Status) {
+			return statusRank(a.Status) < statusRank(b.Status)
+		}
+		return a.Database < b.Database
+	})
+	return report
+}
+
+// evaluateDatabase menentukan status coverage satu database live.
+func evaluateDatabase(db string, records []types_backup.CoverageBackupRecord, opts types_backup.CoverageOptions, now time.Time) types_backup.CoverageEntry {
+	entry := types_backup.CoverageEntry{Database: db, TotalFound: len(records)}
+
+	good := latestRecord(records, func(r types_backup.CoverageBackupRecord) bool {
+		return r.Successful || (!r.HasMetadata && opts.AllowMissingMeta)
+	})
+	if good.File == "" {
+		entry.Status = types_backup.CoverageUncovered
+		switch {
+		case len(records) == 0:
+			entry.Note = "tidak ada file backup"
+		default:
+			last := latestRecord(records, func(types_backup.CoverageBackupRecord) bool { return true })
+			entry.LastFile = last.File
+			entry.LastTime = last.Time
+			entry.Age = now.Sub(last.Time)
+			if last.HasMetadata {
+				entry.Note = fmt.Sprintf("tidak ada backup sukses (status terakhir: %s)", last.Status)
+			} else {
+				entry.Note = "backup tanpa .meta.json (gunakan --allow-missing-meta untuk menerima)"
+			}
+		}
+		return entry
+	}
+
+	entry.LastFile = good.File
+	entry.LastTime = good.Time
+	entry.Age = now.Sub(good.Time)
+	entry.Status = types_backup.CoverageCovered
+	if opts.MaxAge > 0 && entry.Age > opts.MaxAge {
+		entry.Status = types_backup.CoverageStale
+	}
+
+	var notes []string
+	if good.CombinedFile {
+		notes = append(notes, "backup combined")
+	}
+	if !good.HasMetadata {
+		notes = append(notes, "tanpa .meta.json")
+	} else if good.Status == consts.BackupStatusSuccessWithWarnings {
+		notes = append(notes, "sukses dengan warning")
+	}
+	entry.Note = strings.Join(notes, ", ")
+	return entry
+}
+
+// latestRecord mengembalikan record terbaru yang memenuhi accept (zero value jika tidak ada).
+func latestRecord(records []types_backup.CoverageBackupRecord, accept func(types_backup.CoverageBackupRecord) bool) types_backup.CoverageBackupRecord {
+	var latest types_backup.CoverageBackupRecord
+	for _, r := range records {
+		if !accept(r) {
+			continue
+		}
+		if latest.File == "" || r.Time.After(latest.Time) {
+			latest = r
+		}
+	}
+	return latest
+}
+
+// isExcluded mengecek nama database terhadap daftar exclude (mendukung wildcard glob).
+func isExcluded(db string, patterns []string) bool {
+	for _, p := range patterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if p == db {
+			return true
+		}
+		if ok, _ := filepath.Match(p, db); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func statusRank(status string) int {
+	switch status {
+	case types_backup.CoverageUncovered:
+		return 0
+	case types_backup.CoverageStale:
+		return 1
+	case types_backup.CoverageCovered:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// displayReport menampilkan ringkasan dan tabel detail coverage.
+func displayReport(report types_backup.CoverageReport) {
+	print.PrintHeader("RINGKASAN COVERAGE BACKUP")
+	table.Render([]string{"Metrik", "Nilai"}, [][]string{
+		{"Direktori backup", report.BackupDir},
+		{"Max age", report.MaxAge.String()},
+		{"Covered", text.ColorText(fmt.Sprintf("%d", report.Counts[types_backup.CoverageCovered]), consts.UIColorGreen)},
+		{"Stale", text.ColorText(fmt.Sprintf("%d", report.Counts[types_backup.CoverageStale]), consts.UIColorYellow)},
+		{"Uncovered", text.ColorText(fmt.Sprintf("%d", report.Counts[types_backup.CoverageUncovered]), consts.UIColorRed)},
+		{"Orphaned", fmt.Sprintf("%d", report.Counts[types_backup.CoverageOrphaned])},
+		{"Dikecualikan", fmt.Sprintf("%d", len(report.Excluded))},
+	})
+
+	if len(report.Entries) == 0 {
+		return
+	}
+
+	print.PrintSubHeader("Detail per Database")
+	rows := make([][]string, 0, len(report.Entries))
+	for _, e := range report.Entries {
+		last, age := "-", "-"
+		if !e.LastTime.IsZero() {
+			last = e.LastTime.Format("2006-01-02 15:04:05")
+			age = formatAge(e.Age)
+		}
+		file := "-"
+		if e.LastFile != "" {
+			file = filepath.Base(e.LastFile)
+		}
+		rows = append(rows, []string{e.Database, coverageStatusLabel(e.Status), last, age, file, e.Note})
+	}
+	table.Render([]string{"Database", "Status", "Backup Terakhir", "Umur", "File", "Catatan"}, rows)
+}
+
+func coverageStatusLabel(status string) string {
+	switch status {
+	case types_backup.CoverageCovered:
+		return text.ColorText("COVERED", consts.UIColorGreen)
+	case types_backup.CoverageStale:
+		return text.ColorText("STALE", consts.UIColorYellow)
+	case types_backup.CoverageUncovered:
+		return text.ColorText("UNCOVERED", consts.UIColorRed)
+	default:
+		return "ORPHANED"
+	}
+}
+
+// formatAge memformat durasi umur backup (mis. "2d 3h", "5h 12m").
+func formatAge(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+	return fmt.Sprintf("%dh %dm", hours, int(d.Minutes())%60)
+}
@@ -0,0 +1,119 @@
+package coverage
+
+import (
+	"testing"
+	"time"
+
+	"sfdbtools/internal/app/backup/model/types_backup"
+	"sfdbtools/internal/shared/consts"
+)
+
+func TestBuildReport(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	record := func(db string, age time.Duration, status string) types_backup.CoverageBackupRecord {
+		return types_backup.CoverageBackupRecord{
+			Database:    db,
+			File:        db + "_" + now.Add(-age).Format("20060102_150405") + ".sql.gz",
+			Time:        now.Add(-age),
+			Status:      status,
+			HasMetadata: status != "",
+			Successful:  status == consts.BackupStatusSuccess || status == consts.BackupStatusSuccessWithWarnings,
+		}
+	}
+
+	index := map[string][]types_backup.CoverageBackupRecord{
+		"billing": {record("billing", 2*time.Hour, consts.BackupStatusSuccess)},
+		"crm":     {record("crm", 72*time.Hour, consts.BackupStatusSuccess)},
+		"hr":      {record("hr", 2*time.Hour, "failed"), record("hr", 100*time.Hour, consts.BackupStatusSuccessWithWarnings)},
+		"legacy":  {record("legacy", 24*time.Hour, "")},
+		"old_app": {record("old_app", 24*time.Hour, consts.BackupStatusSuccess)},
+		"mysql":   {record("mysql", time.Hour, consts.BackupStatusSuccess)},
+	}
+	live := []string{"billing", "crm", "hr", "legacy", "new_db", "tmp_import"}
+
+	tests := []struct {
+		name     string
+		opts     types_backup.CoverageOptions
+		want     map[string]string
+		violated bool
+		excluded int
+	}{
+		{
+			name: "max age and missing metadata",
+			opts: types_backup.CoverageOptions{MaxAge: 48 * time.Hour, ExcludeDatabases: []string{"tmp_*"}},
+			want: map[string]string{
+				"billing": types_backup.CoverageCovered,
+				"crm":     types_backup.CoverageStale,
+				"hr":      types_backup.CoverageStale,
+				"legacy":  types_backup.CoverageUncovered,
+				"new_db":  types_backup.CoverageUncovered,
+				"old_app": types_backup.CoverageOrphaned,
+			},
+			violated: true,
+			excluded: 1,
+		},
+		{
+			name: "allow missing meta and no max age",
+			opts: types_backup.CoverageOptions{AllowMissingMeta: true, ExcludeDatabases: []string{"new_db", "tmp_import"}},
+			want: map[string]string{
+				"billing": types_backup.CoverageCovered,
+				"crm":     types_backup.CoverageCovered,
+				"hr":      types_backup.CoverageCovered,
+				"legacy":  types_backup.CoverageCovered,
+				"old_app": types_backup.CoverageOrphaned,
+			},
+			violated: false,
+			excluded: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := BuildReport(live, index, tt.opts, now)
+
+			got := make(map[string]string, len(report.Entries))
+			for _, e := range report.Entries {
+				got[e.Database] = e.Status
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("entries = %v, want %v", got, tt.want)
+			}
+			for db, status := range tt.want {
+				if got[db] != status {
+					t.Fatalf("status %s = %q, want %q (entries %v)", db, got[db], status, got)
+				}
+			}
+			if report.Violated() != tt.violated {
+				t.Fatalf("Violated() = %v, want %v", report.Violated(), tt.violated)
+			}
+			if len(report.Excluded) != tt.excluded {
+				t.Fatalf("Excluded = %v, want %d", report.Excluded, tt.excluded)
+			}
+			for i := 1; i < len(report.Entries); i++ {
+				if statusRank(report.Entries[i-1].Status) > statusRank(report.Entries[i].Status) {
+					t.Fatalf("entries tidak terurut berdasarkan status: %+v", report.Entries)
+				}
+			}
+		})
+	}
+}
+
+func TestIsExcluded(t *testing.T) {
+	tests := []struct {
+		db       string
+		patterns []string
+		want     bool
+	}{
+		{db: "tmp_import", patterns: []string{"tmp_*"}, want: true},
+		{db: "billing", patterns: []string{" billing "}, want: true},
+		{db: "billing", patterns: []string{"", "crm"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.db, func(t *testing.T) {
+			if got := isExcluded(tt.db, tt.patterns); got != tt.want {
+				t.Fatalf("isExcluded(%q, %v) = %v, want %v", tt.db, tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
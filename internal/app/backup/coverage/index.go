@@ -0,0 +1,140 @@
+// File : internal/app/backup/coverage/index.go
+// Deskripsi : Indexing file backup + .meta.json di direktori backup per nama database
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package coverage
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	backupfile "sfdbtools/internal/app/backup/helpers/file"
+	"sfdbtools/internal/app/backup/metadata"
+	"sfdbtools/internal/app/backup/model/types_backup"
+	"sfdbtools/internal/shared/consts"
+)
+
+// IndexBackups menelusuri dir secara rekursif dan mengelompokkan backup per nama database.
+// Status dan waktu backup diambil dari .meta.json; file yang tidak dirujuk metadata mana pun
+// tetap dicatat (HasMetadata=false) dengan nama database dari pola filename dan waktu dari mtime.
+func IndexBackups(dir string) (map[string][]types_backup.CoverageBackupRecord, []string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backupFiles := make(map[string]fs.FileInfo)
+	var metaFiles []string
+
+	err = filepath.WalkDir(absDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		switch {
+		case strings.HasSuffix(name, consts.ExtMetaJSON):
+			metaFiles = append(metaFiles, path)
+		case strings.HasSuffix(strings.ToLower(name), consts.UsersSQLSuffix):
+			// File grants user bukan backup database.
+		case backupfile.IsBackupFile(name):
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			backupFiles[filepath.Clean(path)] = info
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	index := make(map[string][]types_backup.CoverageBackupRecord)
+	referenced := make(map[string]bool)
+	var warnings []string
+
+	for _, metaPath := range metaFiles {
+		meta, err := metadata.ReadBackupMetadata(metaPath)
+		if err != nil {
+			warnings = append(warnings, metaPath+": "+err.Error())
+			continue
+		}
+		metaDir := filepath.Dir(metaPath)
+		primary := filepath.Clean(strings.TrimSuffix(metaPath, consts.ExtMetaJSON))
+		successful := metadata.IsSuccessfulStatus(meta.BackupStatus)
+
+		add := func(dbName, file string, combined bool) {
+			info, ok := backupFiles[file]
+			if !ok || dbName == "" {
+				return
+			}
+			referenced[file] = true
+			t := meta.BackupEndTime
+			if t.IsZero() {
+				t = info.ModTime()
+			}
+			index[dbName] = append(index[dbName], types_backup.CoverageBackupRecord{
+				Database:     dbName,
+				File:         file,
+				Time:         t,
+				Status:       meta.BackupStatus,
+				HasMetadata:  true,
+				Successful:   successful,
+				CombinedFile: combined,
+			})
+		}
+
+		if len(meta.DatabaseDetails) > 0 {
+			// Mode separated/primary/secondary: satu metadata untuk beberapa file.
+			for _, d := range meta.DatabaseDetails {
+				add(d.DatabaseName, resolveBackupPath(metaDir, d.BackupFile), false)
+			}
+			continue
+		}
+
+		names := meta.DatabaseNames
+		if len(names) == 0 {
+			names = []string{backupfile.ExtractDatabaseNameFromFile(primary)}
+		}
+		for _, dbName := range names {
+			add(dbName, primary, len(names) > 1)
+		}
+	}
+
+	for path, info := range backupFiles {
+		if referenced[path] {
+			continue
+		}
+		dbName := backupfile.ExtractDatabaseNameFromFile(path)
+		if dbName == "" {
+			continue
+		}
+		index[dbName] = append(index[dbName], types_backup.CoverageBackupRecord{
+			Database: dbName,
+			File:     path,
+			Time:     info.ModTime(),
+		})
+	}
+
+	return index, warnings, nil
+}
+
+// resolveBackupPath mengembalikan path file backup dari metadata.
+// Jika path di metadata tidak ditemukan (mis. direktori dipindah), fallback ke basename di direktori metadata.
+func resolveBackupPath(metaDir, file string) string {
+	if file == "" {
+		return ""
+	}
+	if filepath.IsAbs(file) {
+		if _, err := os.Stat(file); err == nil {
+			return filepath.Clean(file)
+		}
+	}
+	return filepath.Join(metaDir, filepath.Base(file))
+}
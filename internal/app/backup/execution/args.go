@@ -2,7 +2,7 @@
 // Deskripsi : Mysqldump arguments builder dan password masking
 // Author : Hadiyatna Muflihun
 // Tanggal : 2025-12-30
-// Last Modified : 2026-01-26
+// Last Modified : 18 Oktober 2026
 
 package execution
 
@@ -11,8 +11,28 @@ import (
 	"strings"
 
 	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/database"
+	"sfdbtools/internal/shared/execx"
 )
 
+// DumpTLSArgs membuat argumen TLS dari profile sesuai flavor binary dump (mariadb-dump/mysqldump).
+// Error jika ssl_mode tidak bisa dipenuhi dump CLI lewat SSH tunnel (lihat database.ValidateClientTLSTunnel).
+func DumpTLSArgs(profile *domain.ProfileInfo) ([]string, error) {
+	t := profile.DBInfo.TLS
+	if strings.TrimSpace(t.Mode) == "" {
+		return nil, nil
+	}
+	bin, err := execx.ResolveMariaDBDumpOrMysqldump()
+	if err != nil {
+		return nil, nil
+	}
+	mariadb := execx.IsMariaDBClient(bin)
+	if err := database.ValidateClientTLSTunnel(t, profile.SSHTunnel.Enabled, mariadb); err != nil {
+		return nil, err
+	}
+	return database.ClientTLSArgs(t, mariadb), nil
+}
+
 // BuildMysqldumpArgs membuat argumen mysqldump dari konfigurasi backup.
 // Function ini pure logic tanpa wrapper - langsung menggunakan types yang sudah ada.
 func BuildMysqldumpArgs(
@@ -26,12 +46,16 @@ func BuildMysqldumpArgs(
 ) []string {
 	var args []string
 
-	// Connection parameters
-	if dbInfo.Host != "" {
-		args = append(args, "--host="+dbInfo.Host)
-	}
-	if dbInfo.Port != 0 {
-		args = append(args, "--port="+strconv.Itoa(dbInfo.Port))
+	// Connection parameters (socket lokal menggantikan host/port)
+	if strings.TrimSpace(dbInfo.Socket) != "" {
+		args = append(args, "--socket="+dbInfo.Socket)
+	} else {
+		if dbInfo.Host != "" {
+			args = append(args, "--host="+dbInfo.Host)
+		}
+		if dbInfo.Port != 0 {
+			args = append(args, "--port="+strconv.Itoa(dbInfo.Port))
+		}
 	}
 	if dbInfo.User != "" {
 		args = append(args, "--user="+dbInfo.User)
@@ -0,0 +1,36 @@
+package execution
+
+import (
+	"slices"
+	"testing"
+
+	"sfdbtools/internal/domain"
+)
+
+func TestBuildMysqldumpArgsConnection(t *testing.T) {
+	tests := []struct {
+		name string
+		info domain.DBInfo
+		want []string
+	}{
+		{
+			name: "tcp",
+			info: domain.DBInfo{Host: "10.0.0.5", Port: 3306, User: "backup", Password: "pw"},
+			want: []string{"--host=10.0.0.5", "--port=3306", "--user=backup", "--password=pw", "app"},
+		},
+		{
+			name: "socket replaces host and port",
+			info: domain.DBInfo{Host: "localhost", Port: 3306, User: "root", Socket: "/run/mysqld/mysqld.sock"},
+			want: []string{"--socket=/run/mysqld/mysqld.sock", "--user=root", "app"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildMysqldumpArgs("", tt.info, domain.FilterOptions{}, nil, "app", 1, nil)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("BuildMysqldumpArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
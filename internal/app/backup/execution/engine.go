@@ -2,7 +2,7 @@
 // Deskripsi : Main backup execution engine dengan orchestration logic
 // Author : Hadiyatna Muflihun
 // Tanggal : 2025-12-05
-// Last Modified : 27 Januari 2026
+// Last Modified : 18 Oktober 2026
 package execution
 
 import (
@@ -123,6 +123,11 @@ func (e *Engine) ExecuteAndBuildBackup(
 		cfg.TotalDBFound,
 		e.Options.SkipTablesData,
 	)
+	tlsArgs, err := DumpTLSArgs(&e.Options.Profile)
+	if err != nil {
+		return types_backup.DatabaseBackupInfo{}, err
+	}
+	mysqldumpArgs = append(tlsArgs, mysqldumpArgs...)
 
 	if e.Options.DryRun {
 		return e.buildDryRunInfo(cfg, mysqldumpArgs, timer, startTime), nil
@@ -2,7 +2,7 @@
 // Deskripsi : Error detection dan retry strategies untuk mysqldump failures
 // Author : Hadiyatna Muflihun
 // Tanggal : 2025-12-30
-// Last Modified : 20 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package execution
 
@@ -30,6 +30,8 @@ func IsSSLMismatchRequiredButServerNoSupport(stderrOutput string) bool {
 
 // AddDisableSSLArgs menambahkan opsi untuk disable SSL/TLS pada mysqldump.
 // Menggunakan '--skip-ssl' untuk kompatibilitas MariaDB/MySQL client.
+// Tidak dilakukan jika args sudah berisi pengaturan TLS eksplisit (ssl_mode profile),
+// agar profile yang mewajibkan TLS tidak diam-diam turun ke koneksi tanpa enkripsi.
 //
 // Returns: (newArgs, added)
 // - newArgs: args baru dengan --skip-ssl
@@ -38,7 +40,8 @@ func AddDisableSSLArgs(args []string) ([]string, bool) {
 	// Check apakah sudah ada SSL-related args
 	for _, a := range args {
 		al := strings.ToLower(strings.TrimSpace(a))
-		if al == "--skip-ssl" || strings.HasPrefix(al, "--ssl-mode") || strings.HasPrefix(al, "--ssl=") {
+		if al == "--skip-ssl" || al == "--ssl" || strings.HasPrefix(al, "--ssl-mode") || strings.HasPrefix(al, "--ssl=") ||
+			strings.HasPrefix(al, "--ssl-ca") || strings.HasPrefix(al, "--ssl-verify-server-cert") {
 			return args, false
 		}
 	}
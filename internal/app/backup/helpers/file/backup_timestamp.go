@@ -0,0 +1,28 @@
+package file
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ExtractTimestampFromFile mengekstrak waktu backup dari nama file dengan pola standar
+// {database}_{YYYYMMDD}_{HHMMSS}_{hostname}. Jika hanya tanggal yang ada, jam diset 00:00:00.
+// Waktu diinterpretasikan dalam timezone lokal.
+func ExtractTimestampFromFile(filePath string) (time.Time, bool) {
+	parts := strings.Split(StripAllBackupExtensions(filepath.Base(filePath)), "_")
+	for i, part := range parts {
+		if len(part) != 8 || !isAllDigits(part) {
+			continue
+		}
+		if i+1 < len(parts) && len(parts[i+1]) == 6 && isAllDigits(parts[i+1]) {
+			if t, err := time.ParseInLocation("20060102150405", part+parts[i+1], time.Local); err == nil {
+				return t, true
+			}
+		}
+		if t, err := time.ParseInLocation("20060102", part, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
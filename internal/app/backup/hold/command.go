@@ -0,0 +1,150 @@
+// File : internal/app/backup/hold/command.go
+// Deskripsi : Eksekusi perintah db-backup hold add|remove|list
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package hold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	backupfile "sfdbtools/internal/app/backup/helpers/file"
+	"sfdbtools/internal/app/backup/model/types_backup"
+	applog "sfdbtools/internal/services/log"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// ExecuteAdd menambahkan legal hold untuk satu file backup atau satu ticket.
+func ExecuteAdd(logger applog.Logger, opts types_backup.HoldOptions) error {
+	if strings.TrimSpace(opts.Reason) == "" {
+		return fmt.Errorf("--reason wajib diisi")
+	}
+
+	h := types_backup.BackupHold{
+		Ticket:    opts.Ticket,
+		Reason:    opts.Reason,
+		Owner:     opts.Owner,
+		ExpiresAt: opts.ExpiresAt,
+	}
+	if opts.File != "" {
+		file, err := resolveBackupFile(opts.File)
+		if err != nil {
+			return err
+		}
+		h.File = file
+	}
+
+	reg, err := Load(opts.Registry)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if h.ExpiresAt != nil && !h.ExpiresAt.After(now) {
+		return fmt.Errorf("waktu kedaluwarsa hold harus di masa depan")
+	}
+	added, err := reg.Add(h, now)
+	if err != nil {
+		return err
+	}
+	if err := reg.Save(); err != nil {
+		return fmt.Errorf("gagal menyimpan registry hold: %w", err)
+	}
+
+	logger.Infof("Hold %s ditambahkan untuk %s oleh %s: %s", added.ID, added.Target(), added.Owner, added.Reason)
+	print.PrintSuccess(fmt.Sprintf("Hold %s ditambahkan untuk %s", added.ID, added.Target()))
+	return nil
+}
+
+// ExecuteRemove menghapus hold berdasarkan ID, file, atau ticket.
+func ExecuteRemove(logger applog.Logger, opts types_backup.HoldOptions) error {
+	file := opts.File
+	if file != "" {
+		if abs, err := filepath.Abs(file); err == nil {
+			file = abs
+		}
+	}
+
+	reg, err := Load(opts.Registry)
+	if err != nil {
+		return err
+	}
+	removed := reg.Remove(opts.ID, file, opts.Ticket)
+	if len(removed) == 0 {
+		return fmt.Errorf("hold tidak ditemukan")
+	}
+	if err := reg.Save(); err != nil {
+		return fmt.Errorf("gagal menyimpan registry hold: %w", err)
+	}
+
+	for _, h := range removed {
+		logger.Infof("Hold %s untuk %s dihapus (owner: %s, alasan: %s)", h.ID, h.Target(), h.Owner, h.Reason)
+	}
+	print.PrintSuccess(fmt.Sprintf("%d hold dihapus", len(removed)))
+	return nil
+}
+
+// ExecuteList menampilkan hold yang terdaftar.
+func ExecuteList(opts types_backup.HoldOptions) error {
+	reg, err := Load(opts.Registry)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	holds := reg.Active(now)
+	if opts.ShowAll {
+		holds = reg.Holds
+	}
+
+	print.PrintHeader("LEGAL HOLD BACKUP")
+	if len(holds) == 0 {
+		print.PrintInfo("Tidak ada hold aktif.")
+		return nil
+	}
+
+	rows := make([][]string, 0, len(holds))
+	for _, h := range holds {
+		status := text.ColorText("aktif", consts.UIColorGreen)
+		if h.Expired(now) {
+			status = text.ColorText("kedaluwarsa", consts.UIColorYellow)
+		}
+		expires := "-"
+		if h.ExpiresAt != nil {
+			expires = h.ExpiresAt.Format("2006-01-02 15:04")
+		}
+		rows = append(rows, []string{h.ID, h.Target(), h.Reason, h.Owner, h.CreatedAt.Format("2006-01-02 15:04"), expires, status})
+	}
+	table.Render([]string{"ID", "Target", "Alasan", "Owner", "Dibuat", "Kedaluwarsa", "Status"}, rows)
+	fmt.Printf("Registry: %s\n", reg.Path)
+	return nil
+}
+
+// resolveBackupFile memvalidasi bahwa path adalah file backup yang ada dan mengembalikan path absolut.
+func resolveBackupFile(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return "", fmt.Errorf("file backup tidak ditemukan: %w", err)
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%s adalah direktori, bukan file backup", abs)
+	}
+	name := info.Name()
+	if strings.HasSuffix(name, consts.ExtMetaJSON) {
+		return "", fmt.Errorf("gunakan file dump, bukan metadata: %s", name)
+	}
+	if !backupfile.IsBackupFile(name) {
+		return "", fmt.Errorf("bukan file backup: %s", name)
+	}
+	return abs, nil
+}
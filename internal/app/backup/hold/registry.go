@@ -0,0 +1,152 @@
+// File : internal/app/backup/hold/registry.go
+// Deskripsi : Registry legal hold backup (file JSON) dan pencocokan hold terhadap file backup
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package hold
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"sfdbtools/internal/app/backup/model/types_backup"
+	"sfdbtools/internal/shared/fsops"
+)
+
+// registryFile adalah format file registry hold.
+type registryFile struct {
+	Holds []types_backup.BackupHold `json:"holds"`
+}
+
+// Registry menyimpan daftar hold yang dimuat dari file.
+type Registry struct {
+	Path  string
+	Holds []types_backup.BackupHold
+}
+
+// Load membaca registry dari path. File yang belum ada dianggap registry kosong.
+func Load(path string) (*Registry, error) {
+	reg := &Registry{Path: path}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return reg, nil
+		}
+		return nil, fmt.Errorf("gagal membaca registry hold: %w", err)
+	}
+
+	var rf registryFile
+	if err := json.Unmarshal(data, &rf); err != nil {
+		return nil, fmt.Errorf("gagal parse registry hold %s: %w", path, err)
+	}
+	reg.Holds = rf.Holds
+	return reg, nil
+}
+
+// Save menulis registry secara atomic.
+func (r *Registry) Save() error {
+	if err := fsops.CreateDirIfNotExist(filepath.Dir(r.Path)); err != nil {
+		return fmt.Errorf("gagal membuat direktori registry hold: %w", err)
+	}
+	data, err := json.MarshalIndent(registryFile{Holds: r.Holds}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fsops.WriteFile(r.Path, data)
+}
+
+// Add menambahkan hold baru. ID dibuat otomatis.
+// Mengembalikan error jika target yang sama sudah memiliki hold aktif.
+func (r *Registry) Add(h types_backup.BackupHold, now time.Time) (types_backup.BackupHold, error) {
+	if (h.File == "") == (h.Ticket == "") {
+		return h, fmt.Errorf("hold harus menargetkan tepat satu: file atau ticket")
+	}
+	for _, existing := range r.Holds {
+		if existing.Expired(now) {
+			continue
+		}
+		if (h.File != "" && existing.File == h.File) || (h.Ticket != "" && existing.Ticket == h.Ticket) {
+			return h, fmt.Errorf("target %s sudah memiliki hold aktif (%s)", h.Target(), existing.ID)
+		}
+	}
+
+	h.CreatedAt = now
+	h.ID = r.nextID(now)
+	r.Holds = append(r.Holds, h)
+	return h, nil
+}
+
+// Remove menghapus hold berdasarkan ID, file, atau ticket. Mengembalikan hold yang dihapus.
+func (r *Registry) Remove(id, file, ticket string) []types_backup.BackupHold {
+	var kept, removed []types_backup.BackupHold
+	for _, h := range r.Holds {
+		match := (id != "" && h.ID == id) ||
+			(file != "" && h.File == file) ||
+			(ticket != "" && h.Ticket == ticket)
+		if match {
+			removed = append(removed, h)
+			continue
+		}
+		kept = append(kept, h)
+	}
+	r.Holds = kept
+	return removed
+}
+
+// Active mengembalikan hold yang belum kedaluwarsa, diurutkan berdasarkan waktu dibuat.
+func (r *Registry) Active(now time.Time) []types_backup.BackupHold {
+	var out []types_backup.BackupHold
+	for _, h := range r.Holds {
+		if !h.Expired(now) {
+			out = append(out, h)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+// Match mencari hold aktif untuk file backup. ticket adalah ticket dari metadata backup (boleh kosong).
+func (r *Registry) Match(file, ticket string, now time.Time) (types_backup.BackupHold, bool) {
+	for _, h := range r.Active(now) {
+		if h.File != "" && sameFile(h.File, file) {
+			return h, true
+		}
+		if h.Ticket != "" && ticket != "" && h.Ticket == ticket {
+			return h, true
+		}
+	}
+	return types_backup.BackupHold{}, false
+}
+
+func (r *Registry) nextID(now time.Time) string {
+	base := "hold-" + now.Format("20060102150405")
+	id := base
+	for n := 2; r.hasID(id); n++ {
+		id = fmt.Sprintf("%s-%d", base, n)
+	}
+	return id
+}
+
+func (r *Registry) hasID(id string) bool {
+	for _, h := range r.Holds {
+		if h.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// sameFile membandingkan dua path file backup secara absolut.
+func sameFile(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return filepath.Clean(a) == filepath.Clean(b)
+	}
+	return absA == absB
+}
@@ -0,0 +1,128 @@
+package hold
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"sfdbtools/internal/app/backup/model/types_backup"
+)
+
+func TestRegistryAdd(t *testing.T) {
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		holds   []types_backup.BackupHold
+		add     types_backup.BackupHold
+		wantID  string
+		wantErr bool
+	}{
+		{name: "file hold", add: types_backup.BackupHold{File: "/backup/a.sql.gz"}, wantID: "hold-20261018100000"},
+		{name: "ticket hold", add: types_backup.BackupHold{Ticket: "LEGAL-1"}, wantID: "hold-20261018100000"},
+		{name: "no target", add: types_backup.BackupHold{}, wantErr: true},
+		{name: "both targets", add: types_backup.BackupHold{File: "/backup/a.sql.gz", Ticket: "LEGAL-1"}, wantErr: true},
+		{
+			name:    "duplicate active target",
+			holds:   []types_backup.BackupHold{{ID: "hold-1", Ticket: "LEGAL-1"}},
+			add:     types_backup.BackupHold{Ticket: "LEGAL-1"},
+			wantErr: true,
+		},
+		{
+			name:   "expired hold does not block",
+			holds:  []types_backup.BackupHold{{ID: "hold-1", Ticket: "LEGAL-1", ExpiresAt: &past}},
+			add:    types_backup.BackupHold{Ticket: "LEGAL-1"},
+			wantID: "hold-20261018100000",
+		},
+		{
+			name:   "id collision gets suffix",
+			holds:  []types_backup.BackupHold{{ID: "hold-20261018100000", File: "/backup/b.sql.gz"}},
+			add:    types_backup.BackupHold{File: "/backup/a.sql.gz"},
+			wantID: "hold-20261018100000-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &Registry{Holds: tt.holds}
+			got, err := reg.Add(tt.add, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Add() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(reg.Holds) != len(tt.holds) {
+					t.Fatalf("Add() gagal tetapi registry berubah: %+v", reg.Holds)
+				}
+				return
+			}
+			if got.ID != tt.wantID || !got.CreatedAt.Equal(now) {
+				t.Fatalf("Add() = %+v, want ID %s", got, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestRegistryMatch(t *testing.T) {
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	reg := &Registry{Holds: []types_backup.BackupHold{
+		{ID: "h-file", File: "/backup/./db/a.sql.gz"},
+		{ID: "h-ticket", Ticket: "LEGAL-1"},
+		{ID: "h-expired", File: "/backup/db/old.sql.gz", ExpiresAt: &past},
+	}}
+
+	tests := []struct {
+		name   string
+		file   string
+		ticket string
+		wantID string
+	}{
+		{name: "file match with unclean path", file: "/backup/db/a.sql.gz", wantID: "h-file"},
+		{name: "ticket from metadata", file: "/backup/db/b.sql.gz", ticket: "LEGAL-1", wantID: "h-ticket"},
+		{name: "expired hold ignored", file: "/backup/db/old.sql.gz"},
+		{name: "no ticket in metadata", file: "/backup/db/c.sql.gz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, ok := reg.Match(tt.file, tt.ticket, now)
+			if ok != (tt.wantID != "") || h.ID != tt.wantID {
+				t.Fatalf("Match() = %q, %v, want %q", h.ID, ok, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestRegistrySaveLoadRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "holds", "registry.json")
+
+	empty, err := Load(path)
+	if err != nil || len(empty.Holds) != 0 {
+		t.Fatalf("Load() registry belum ada = %+v, %v", empty, err)
+	}
+
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	if _, err := empty.Add(types_backup.BackupHold{File: "/backup/a.sql.gz", Reason: "audit"}, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := empty.Add(types_backup.BackupHold{Ticket: "LEGAL-1"}, now.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := empty.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(reg.Active(now)) != 2 || reg.Holds[0].Reason != "audit" {
+		t.Fatalf("Load() = %+v", reg.Holds)
+	}
+
+	removed := reg.Remove("", "", "LEGAL-1")
+	if len(removed) != 1 || len(reg.Holds) != 1 || reg.Holds[0].File != "/backup/a.sql.gz" {
+		t.Fatalf("Remove() removed=%+v kept=%+v", removed, reg.Holds)
+	}
+}
@@ -0,0 +1,37 @@
+// File : internal/app/backup/metadata/reader.go
+// Deskripsi : Membaca file .meta.json untuk kebutuhan laporan dan retensi
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sfdbtools/internal/app/backup/model/types_backup"
+	"sfdbtools/internal/shared/consts"
+)
+
+// ReadBackupMetadata membaca file .meta.json (format grouped maupun flat).
+func ReadBackupMetadata(metaPath string) (*types_backup.BackupMetadata, error) {
+	data, err := os.ReadFile(metaPath)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca metadata: %w", err)
+	}
+
+	var meta types_backup.BackupMetadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("gagal parse metadata: %w", err)
+	}
+	return &meta, nil
+}
+
+// IsSuccessfulStatus mengecek apakah status backup dianggap berhasil (success / success_with_warnings).
+func IsSuccessfulStatus(status string) bool {
+	switch status {
+	case consts.BackupStatusSuccess, consts.BackupStatusSuccessWithWarnings:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+// File : internal/app/backup/model/types_backup/coverage.go
+// Deskripsi : Struct untuk laporan coverage backup (db-backup coverage)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package types_backup
+
+import (
+	"sfdbtools/internal/domain"
+	"time"
+)
+
+// Status coverage per database.
+const (
+	CoverageCovered   = "covered"   // Ada backup sukses yang masih dalam batas max-age
+	CoverageStale     = "stale"     // Backup sukses terakhir lebih tua dari max-age
+	CoverageUncovered = "uncovered" // Tidak ada backup sukses sama sekali
+	CoverageOrphaned  = "orphaned"  // Backup ada tetapi database sudah tidak ada di server
+)
+
+// CoverageOptions menyimpan opsi untuk db-backup coverage.
+type CoverageOptions struct {
+	Profile          domain.ProfileInfo
+	BackupDir        string
+	MaxAge           time.Duration
+	ExcludeDatabases []string
+	AllowMissingMeta bool // Anggap file backup tanpa .meta.json sebagai backup sukses
+}
+
+// CoverageBackupRecord adalah satu backup yang ditemukan untuk sebuah database.
+type CoverageBackupRecord struct {
+	Database     string
+	File         string
+	Time         time.Time
+	Status       string // Status dari metadata; kosong jika metadata tidak ditemukan
+	HasMetadata  bool
+	Successful   bool
+	CombinedFile bool // File berisi lebih dari satu database
+}
+
+// CoverageEntry adalah hasil evaluasi coverage untuk satu database.
+type CoverageEntry struct {
+	Database   string
+	Status     string
+	LastFile   string
+	LastTime   time.Time
+	Age        time.Duration
+	TotalFound int
+	Note       string
+}
+
+// CoverageReport adalah hasil lengkap laporan coverage.
+type CoverageReport struct {
+	GeneratedAt time.Time
+	BackupDir   string
+	MaxAge      time.Duration
+	Entries     []CoverageEntry
+	Counts      map[string]int
+	Excluded    []string
+}
+
+// Violated mengembalikan true jika ada database yang uncovered atau stale.
+func (r CoverageReport) Violated() bool {
+	return r.Counts[CoverageUncovered] > 0 || r.Counts[CoverageStale] > 0
+}
@@ -0,0 +1,45 @@
+// File : internal/app/backup/model/types_backup/hold.go
+// Deskripsi : Struct legal hold backup (db-backup hold)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package types_backup
+
+import "time"
+
+// BackupHold adalah satu legal hold. Hold menargetkan satu file backup (File)
+// atau semua backup dengan ticket tertentu di metadata (Ticket).
+type BackupHold struct {
+	ID        string     `json:"id"`
+	File      string     `json:"file,omitempty"`
+	Ticket    string     `json:"ticket,omitempty"`
+	Reason    string     `json:"reason"`
+	Owner     string     `json:"owner"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// Expired mengembalikan true jika hold sudah melewati waktu kedaluwarsa.
+func (h BackupHold) Expired(now time.Time) bool {
+	return h.ExpiresAt != nil && !now.Before(*h.ExpiresAt)
+}
+
+// Target mengembalikan deskripsi target hold (file atau ticket).
+func (h BackupHold) Target() string {
+	if h.Ticket != "" {
+		return "ticket:" + h.Ticket
+	}
+	return h.File
+}
+
+// HoldOptions menyimpan opsi untuk db-backup hold add|remove|list.
+type HoldOptions struct {
+	Registry  string
+	File      string
+	Ticket    string
+	ID        string
+	Reason    string
+	Owner     string
+	ExpiresAt *time.Time
+	ShowAll   bool // list: tampilkan juga hold yang sudah kedaluwarsa
+}
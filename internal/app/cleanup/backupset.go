@@ -0,0 +1,248 @@
+// File : internal/app/cleanup/backupset.go
+// Deskripsi : Pengelompokan file backup menjadi backup set (dump + .meta.json + grants + GTID)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package cleanup
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	backupfile "sfdbtools/internal/app/backup/helpers/file"
+	"sfdbtools/internal/app/backup/metadata"
+	"sfdbtools/internal/app/backup/model/types_backup"
+	"sfdbtools/internal/shared/consts"
+)
+
+// companionGroup adalah file pendamping (metadata, grants, GTID) beserta dump yang dirujuknya.
+// Satu metadata bisa merujuk beberapa dump (mode separated/primary/secondary), sehingga
+// companion hanya boleh dihapus jika semua dump yang masih ada ikut terhapus.
+type companionGroup struct {
+	MetaPath string   // Kosong untuk grants tanpa metadata (aturan nama file)
+	Files    []string // File companion yang ada di disk
+	Refs     []string // Dump yang dirujuk (hanya yang ada di disk)
+	Missing  []string // Dump yang dirujuk tetapi sudah tidak ada
+	Ticket   string   // Ticket dari metadata (untuk legal hold berbasis ticket)
+}
+
+// dumpStatus adalah status backup sebuah dump menurut metadata yang merujuknya.
+type dumpStatus struct {
+	Database   string // Kosong jika metadata mencakup beberapa database (combined)
+	Time       time.Time
+	Successful bool
+}
+
+// backupSetIndex adalah index seluruh file di direktori backup.
+type backupSetIndex struct {
+	Dumps       map[string]bool // Dump (file backup utama) yang ada
+	Groups      []*companionGroup
+	Referenced  map[string]bool // Dump yang dirujuk metadata mana pun
+	Companions  map[string]bool // File companion yang dimiliki grup mana pun
+	Grants      []string        // Semua file grants (_users.sql)
+	EmptyDirs   []string
+	MetaErrors  map[string]error
+	Status      map[string]dumpStatus // Status dump dari metadata; dump tanpa metadata terbaca tidak ada di map
+	fileSizeMap map[string]int64
+}
+
+// isCompanionFile mengecek apakah file adalah companion (metadata atau grants), bukan dump.
+func isCompanionFile(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.HasSuffix(lower, consts.ExtMetaJSON) || strings.HasSuffix(lower, consts.UsersSQLSuffix)
+}
+
+// buildBackupSetIndex menelusuri baseDir dan menyusun backup set dari referensi metadata dan aturan nama file.
+func buildBackupSetIndex(baseDir string) (*backupSetIndex, error) {
+	idx := &backupSetIndex{
+		Dumps:       make(map[string]bool),
+		Referenced:  make(map[string]bool),
+		Companions:  make(map[string]bool),
+		MetaErrors:  make(map[string]error),
+		Status:      make(map[string]dumpStatus),
+		fileSizeMap: make(map[string]int64),
+	}
+
+	var metaFiles []string
+	err := filepath.WalkDir(baseDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != baseDir {
+				if entries, err := os.ReadDir(path); err == nil && len(entries) == 0 {
+					idx.EmptyDirs = append(idx.EmptyDirs, path)
+				}
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		idx.fileSizeMap[path] = info.Size()
+
+		name := d.Name()
+		lower := strings.ToLower(name)
+		switch {
+		case strings.HasSuffix(lower, consts.ExtMetaJSON):
+			metaFiles = append(metaFiles, path)
+		case strings.HasSuffix(lower, consts.UsersSQLSuffix):
+			idx.Grants = append(idx.Grants, path)
+		case backupfile.IsBackupFile(name):
+			idx.Dumps[path] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, metaPath := range metaFiles {
+		group := &companionGroup{MetaPath: metaPath, Files: []string{metaPath}}
+		idx.Companions[metaPath] = true
+		metaDir := filepath.Dir(metaPath)
+
+		addRef := func(dump string) {
+			if dump == "" {
+				return
+			}
+			for _, r := range append(group.Refs, group.Missing...) {
+				if r == dump {
+					return
+				}
+			}
+			if idx.Dumps[dump] {
+				group.Refs = append(group.Refs, dump)
+				idx.Referenced[dump] = true
+			} else {
+				group.Missing = append(group.Missing, dump)
+			}
+		}
+		addCompanion := func(path string) {
+			if path == "" || path == "none" {
+				return
+			}
+			path = resolveSiblingPath(metaDir, path)
+			if _, ok := idx.fileSizeMap[path]; !ok || idx.Companions[path] {
+				return
+			}
+			group.Files = append(group.Files, path)
+			idx.Companions[path] = true
+		}
+
+		primary := strings.TrimSuffix(metaPath, consts.ExtMetaJSON)
+		addRef(primary)
+
+		meta, err := metadata.ReadBackupMetadata(metaPath)
+		if err != nil {
+			idx.MetaErrors[metaPath] = err
+		} else {
+			successful := metadata.IsSuccessfulStatus(meta.BackupStatus)
+			setStatus := func(dump, dbName string) {
+				if idx.Dumps[dump] {
+					idx.Status[dump] = dumpStatus{Database: dbName, Time: meta.BackupEndTime, Successful: successful}
+				}
+			}
+			primaryDB := ""
+			if len(meta.DatabaseNames) == 1 {
+				primaryDB = meta.DatabaseNames[0]
+			}
+			setStatus(primary, primaryDB)
+			// Mode separated/primary/secondary: status per file mengikuti metadata set-nya.
+			for _, d := range meta.DatabaseDetails {
+				if d.BackupFile != "" {
+					dump := resolveSiblingPath(metaDir, d.BackupFile)
+					addRef(dump)
+					setStatus(dump, d.DatabaseName)
+				}
+			}
+			group.Ticket = meta.Ticket
+			addCompanion(meta.UserGrantsFile)
+			addCompanion(meta.GTIDFile)
+		}
+		// Aturan nama file: <dump tanpa ekstensi>_users.sql
+		addCompanion(filepath.Join(metaDir, backupfile.GenerateGrantsFilename(filepath.Base(primary))))
+
+		idx.Groups = append(idx.Groups, group)
+	}
+
+	// Dump tanpa metadata: grants mengikuti aturan nama file.
+	for dump := range idx.Dumps {
+		if idx.Referenced[dump] {
+			continue
+		}
+		grants := filepath.Join(filepath.Dir(dump), backupfile.GenerateGrantsFilename(filepath.Base(dump)))
+		if _, ok := idx.fileSizeMap[grants]; !ok || idx.Companions[grants] {
+			continue
+		}
+		idx.Companions[grants] = true
+		idx.Groups = append(idx.Groups, &companionGroup{Files: []string{grants}, Refs: []string{dump}})
+	}
+
+	sort.Strings(idx.EmptyDirs)
+	sort.Strings(idx.Grants)
+	return idx, nil
+}
+
+// companionsToDelete mengembalikan file companion yang ikut terhapus jika semua dump pada deleted dihapus.
+// Grup hanya disertakan jika semua dump yang dirujuknya (yang masih ada) termasuk deleted.
+func (idx *backupSetIndex) companionsToDelete(deleted map[string]bool) []*companionGroup {
+	var groups []*companionGroup
+	for _, g := range idx.Groups {
+		if len(g.Refs) == 0 {
+			continue // Orphan: ditangani oleh `cleanup orphans`
+		}
+		all := true
+		for _, r := range g.Refs {
+			if !deleted[r] {
+				all = false
+				break
+			}
+		}
+		if all {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+// ticketOf mengembalikan ticket metadata yang merujuk dump (kosong jika tidak ada).
+func (idx *backupSetIndex) ticketOf(dump string) string {
+	for _, g := range idx.Groups {
+		if g.Ticket == "" {
+			continue
+		}
+		for _, r := range g.Refs {
+			if r == dump {
+				return g.Ticket
+			}
+		}
+	}
+	return ""
+}
+
+// fileInfo mengembalikan BackupFileInfo untuk path yang sudah diindex.
+func (idx *backupSetIndex) fileInfo(path string) types_backup.BackupFileInfo {
+	info := types_backup.BackupFileInfo{Path: path, Size: idx.fileSizeMap[path]}
+	if st, err := os.Stat(path); err == nil {
+		info.ModTime = st.ModTime()
+	}
+	return info
+}
+
+// resolveSiblingPath me-resolve path dari metadata. Path absolut dipakai jika ada di disk,
+// selain itu dicari berdasarkan basename di direktori metadata (direktori backup bisa dipindah).
+func resolveSiblingPath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		if _, err := os.Stat(path); err == nil {
+			return filepath.Clean(path)
+		}
+	}
+	return filepath.Join(dir, filepath.Base(path))
+}
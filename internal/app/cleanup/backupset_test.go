@@ -0,0 +1,109 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"sfdbtools/internal/app/backup/model/types_backup"
+)
+
+func TestBackupSetIndex(t *testing.T) {
+	dir := t.TempDir()
+	path := func(name string) string { return filepath.Join(dir, name) }
+	write := func(name string, data []byte) {
+		t.Helper()
+		if err := os.WriteFile(path(name), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeMeta := func(name string, meta types_backup.BackupMetadata) {
+		t.Helper()
+		b, err := json.Marshal(meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		write(name, b)
+	}
+
+	// Set combined: dump + metadata + grants (aturan nama file).
+	write("full_20261018.sql.gz", []byte("dump"))
+	writeMeta("full_20261018.sql.gz.meta.json", types_backup.BackupMetadata{BackupFile: "full_20261018.sql.gz"})
+	write("full_20261018_users.sql", []byte("grants"))
+	// Set separated: satu metadata merujuk dua dump.
+	write("app_20261018.sql.gz", []byte("dump"))
+	write("crm_20261018.sql.gz", []byte("dump"))
+	writeMeta("sep_20261018.meta.json", types_backup.BackupMetadata{
+		BackupFile: "sep_20261018",
+		DatabaseDetails: []types_backup.DatabaseBackupDetail{
+			{DatabaseName: "app", BackupFile: "app_20261018.sql.gz"},
+			{DatabaseName: "crm", BackupFile: "/pindah/crm_20261018.sql.gz"},
+		},
+	})
+	write("sep_20261018_users.sql", []byte("grants"))
+	// Orphan dan file rusak.
+	writeMeta("gone_20261017.sql.gz.meta.json", types_backup.BackupMetadata{BackupFile: "gone_20261017.sql.gz"})
+	write("bad.sql.gz.meta.json", []byte("{"))
+	write("legacy.sql.gz", []byte("dump"))
+	write("legacy_users.sql", []byte("grants"))
+	write("stray_users.sql", []byte("grants"))
+	if err := os.Mkdir(path("emptydir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	idx, err := buildBackupSetIndex(dir)
+	if err != nil {
+		t.Fatalf("buildBackupSetIndex() error = %v", err)
+	}
+
+	t.Run("companions", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			deleted []string
+			want    []string
+		}{
+			{name: "combined set", deleted: []string{"full_20261018.sql.gz"}, want: []string{"full_20261018.sql.gz.meta.json", "full_20261018_users.sql"}},
+			{name: "separated set partially deleted", deleted: []string{"app_20261018.sql.gz"}, want: nil},
+			{name: "separated set fully deleted", deleted: []string{"app_20261018.sql.gz", "crm_20261018.sql.gz"}, want: []string{"sep_20261018.meta.json", "sep_20261018_users.sql"}},
+			{name: "dump without metadata", deleted: []string{"legacy.sql.gz"}, want: []string{"legacy_users.sql"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				deleted := make(map[string]bool)
+				for _, d := range tt.deleted {
+					deleted[path(d)] = true
+				}
+				var got []string
+				for _, g := range idx.companionsToDelete(deleted) {
+					for _, f := range g.Files {
+						got = append(got, filepath.Base(f))
+					}
+				}
+				slices.Sort(got)
+				if !slices.Equal(got, tt.want) {
+					t.Fatalf("companionsToDelete() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("orphans", func(t *testing.T) {
+		var got []string
+		for _, e := range findOrphans(idx) {
+			got = append(got, e.Kind+": "+filepath.Base(e.Path))
+		}
+		want := []string{
+			orphanEmptyDirectory + ": emptydir",
+			orphanDumpWithoutMeta + ": legacy.sql.gz",
+			orphanGrantsWithoutDump + ": stray_users.sql",
+			orphanMetaWithoutDump + ": bad.sql.gz.meta.json",
+			orphanMetaWithoutDump + ": gone_20261017.sql.gz.meta.json",
+			orphanUnreadableMeta + ": bad.sql.gz.meta.json",
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("findOrphans() = %q, want %q", got, want)
+		}
+	})
+}
@@ -2,7 +2,7 @@
 // Deskripsi : Command execution functions untuk cmd layer
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 6 Januari 2026
+// Last Modified : 18 Oktober 2026
 package cleanup
 
 import (
@@ -94,6 +94,13 @@ func GetExecutionConfig(mode string) (cleanupmodel.CleanupEntryConfig, error) {
 			SuccessMsg:  "✓ Cleanup by pattern selesai",
 			LogPrefix:   "cleanup-pattern",
 		},
+		"orphans": {
+			HeaderTitle: "Cleanup Orphan Report",
+			Mode:        "orphans",
+			ShowOptions: false,
+			SuccessMsg:  "✓ Pemeriksaan orphan selesai",
+			LogPrefix:   "cleanup-orphans",
+		},
 	}
 
 	config, ok := configs[mode]
@@ -2,7 +2,7 @@
 // Deskripsi : Display functions untuk cleanup results dan options
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 17 Desember 2025
+// Last Modified : 18 Oktober 2026
 
 package cleanup
 
@@ -20,8 +20,21 @@ func (s *Service) displayCleanupOptions() {
 	print.PrintSubHeader("Konfigurasi Cleanup")
 
 	data := [][]string{
-		{"Base Directory", s.Config.Backup.Output.BaseDirectory},
-		{"Retention Days", fmt.Sprintf("%d", s.Config.Backup.Cleanup.Days)},
+		{"Base Directory", s.baseDirectory()},
+		{"Retention Days", fmt.Sprintf("%d", s.CleanupOptions.Days)},
+	}
+
+	if p := s.CleanupOptions.Retention; p.Enabled() {
+		data = append(data, []string{"Retention GFS", fmt.Sprintf("daily=%d weekly=%d monthly=%d yearly=%d",
+			p.KeepDaily, p.KeepWeekly, p.KeepMonthly, p.KeepYearly)})
+	}
+
+	if s.CleanupOptions.KeepLast > 0 {
+		data = append(data, []string{"Keep Last", fmt.Sprintf("%d per database", s.CleanupOptions.KeepLast)})
+	}
+
+	if s.CleanupOptions.MaxTotalBytes > 0 {
+		data = append(data, []string{"Max Total", text.FormatFileSize(s.CleanupOptions.MaxTotalBytes)})
 	}
 
 	if s.CleanupOptions.Pattern != "" {
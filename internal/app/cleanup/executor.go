@@ -2,7 +2,7 @@
 // Deskripsi : Core execution logic untuk scanning dan deletion
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 30 Desember 2025
+// Last Modified : 18 Oktober 2026
 
 package cleanup
 
@@ -33,31 +33,46 @@ func (s *Service) cleanupCore(dryRun bool, pattern string) error {
 		s.Log.Infof("%s proses cleanup backup...", mode)
 	}
 
-	retentionDays := s.Config.Backup.Cleanup.Days
+	switch modes := s.CleanupOptions.RetentionModes(); len(modes) {
+	case 0:
+		// Retensi berbasis days (di bawah).
+	case 1:
+		return s.cleanupRetention(dryRun, pattern, modes[0])
+	default:
+		return fmt.Errorf("%w: strategi retensi bentrok (%v), pilih salah satu", ErrInvalidCleanupMode, modes)
+	}
+
+	retentionDays := s.CleanupOptions.Days
 	if retentionDays <= 0 {
 		s.Log.Info("Retention days tidak valid, melewati proses")
 		return nil
 	}
 
-	s.Log.Info("Path backup base directory:", s.Config.Backup.Output.BaseDirectory)
+	s.Log.Info("Path backup base directory:", s.baseDirectory())
 	s.Log.Infof("Cleanup policy: hapus file backup lebih dari %d hari", retentionDays)
 
 	cutoffTime := time.Now().AddDate(0, 0, -retentionDays)
 	s.Log.Infof("Cutoff time: %s", cutoffTime.Format(consts.CleanupTimeFormat))
 
 	// Pindai file
-	filesToDelete, err := s.scanFiles(s.Config.Backup.Output.BaseDirectory, cutoffTime, pattern)
+	filesToDelete, err := s.scanFiles(s.baseDirectory(), cutoffTime, pattern)
 	if err != nil {
 		return fmt.Errorf("gagal memindai file backup: %w", err)
 	}
 
+	filesToDelete, held, err := s.excludeHeld(filesToDelete)
+	if err != nil {
+		return err
+	}
+	s.displayHeldBackups(held)
+
 	if len(filesToDelete) == 0 {
 		s.Log.Info("Tidak ada file backup lama yang perlu dihapus")
 		return nil
 	}
 
 	if dryRun {
-		s.logDryRunSummary(filesToDelete)
+		s.logDryRunSummary(s.withCompanions(filesToDelete))
 	} else {
 		s.performDeletion(filesToDelete)
 	}
@@ -65,6 +80,19 @@ func (s *Service) cleanupCore(dryRun bool, pattern string) error {
 	return nil
 }
 
+// baseDirectory mengembalikan direktori yang dibersihkan: --backup-dir atau backup.output.base_directory.
+// Path dikembalikan absolut agar cocok dengan path yang dirujuk metadata.
+func (s *Service) baseDirectory() string {
+	dir := s.CleanupOptions.BaseDirectory
+	if dir == "" {
+		dir = s.Config.Backup.Output.BaseDirectory
+	}
+	if abs, err := filepath.Abs(dir); err == nil && dir != "" {
+		return abs
+	}
+	return dir
+}
+
 // scanFiles memindai file berdasarkan kriteria retensi dan pattern.
 func (s *Service) scanFiles(baseDir string, cutoff time.Time, pattern string) ([]types_backup.BackupFileInfo, error) {
 	if pattern == "" {
@@ -87,8 +115,9 @@ func (s *Service) scanFiles(baseDir string, cutoff time.Time, pattern string) ([
 			continue
 		}
 
-		// Validasi tipe file dan kriteria
-		if info.IsDir() || (pattern == "**/*" && !backupfile.IsBackupFile(fullPath)) {
+		// Validasi tipe file dan kriteria. Companion (.meta.json, _users.sql) tidak dinilai sendiri;
+		// companion ikut terhapus bersama backup set-nya (lihat performDeletion).
+		if info.IsDir() || isCompanionFile(info.Name()) || (pattern == "**/*" && !backupfile.IsBackupFile(fullPath)) {
 			continue
 		}
 
@@ -109,23 +138,68 @@ func (s *Service) scanFiles(baseDir string, cutoff time.Time, pattern string) ([
 	return filesToDelete, nil
 }
 
-// performDeletion menghapus file yang ada dalam daftar.
+// performDeletion menghapus file dalam daftar beserta companion backup set-nya.
+// Companion (metadata, grants, GTID) dihapus setelah semua dump yang dirujuknya berhasil dihapus,
+// sehingga kegagalan hapus dump tidak meninggalkan dump tanpa metadata.
 func (s *Service) performDeletion(files []types_backup.BackupFileInfo) {
 	s.Log.Infof("Ditemukan %d file backup lama yang akan dihapus", len(files))
 
+	idx, err := buildBackupSetIndex(s.baseDirectory())
+	if err != nil {
+		s.Log.Warnf("Gagal mengindex backup set, companion tidak ikut dihapus: %v", err)
+	}
+
 	var deletedCount int
 	var totalFreedSize int64
+	deleted := make(map[string]bool, len(files))
 
 	for _, file := range files {
 		if err := os.Remove(file.Path); err != nil {
 			s.Log.Errorf("Gagal menghapus file %s: %v", file.Path, err)
 			continue
 		}
+		deleted[file.Path] = true
 		deletedCount++
 		totalFreedSize += file.Size
 		s.Log.Infof("Dihapus: %s (size: %s)", file.Path, text.FormatFileSize(file.Size))
 	}
 
+	if idx != nil {
+		for _, group := range idx.companionsToDelete(deleted) {
+			for _, path := range group.Files {
+				info := idx.fileInfo(path)
+				if err := os.Remove(path); err != nil {
+					s.Log.Errorf("Gagal menghapus companion %s: %v", path, err)
+					continue
+				}
+				deletedCount++
+				totalFreedSize += info.Size
+				s.Log.Infof("Dihapus (companion): %s", path)
+			}
+		}
+	}
+
 	s.Log.Infof("Cleanup selesai: %d file dihapus, total %s ruang dibebaskan.",
 		deletedCount, text.FormatFileSize(totalFreedSize))
 }
+
+// withCompanions menambahkan file companion yang ikut terhapus bersama files (untuk pratinjau dry-run).
+func (s *Service) withCompanions(files []types_backup.BackupFileInfo) []types_backup.BackupFileInfo {
+	idx, err := buildBackupSetIndex(s.baseDirectory())
+	if err != nil {
+		s.Log.Warnf("Gagal mengindex backup set: %v", err)
+		return files
+	}
+
+	deleted := make(map[string]bool, len(files))
+	for _, f := range files {
+		deleted[f.Path] = true
+	}
+	out := append([]types_backup.BackupFileInfo(nil), files...)
+	for _, group := range idx.companionsToDelete(deleted) {
+		for _, path := range group.Files {
+			out = append(out, idx.fileInfo(path))
+		}
+	}
+	return out
+}
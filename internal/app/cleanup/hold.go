@@ -0,0 +1,80 @@
+// File : internal/app/cleanup/hold.go
+// Deskripsi : Pengecualian backup yang berada dalam legal hold dari semua strategi cleanup
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package cleanup
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"sfdbtools/internal/app/backup/hold"
+	"sfdbtools/internal/app/backup/model/types_backup"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// heldBackup adalah file yang batal dihapus karena legal hold.
+type heldBackup struct {
+	File types_backup.BackupFileInfo
+	Hold types_backup.BackupHold
+}
+
+// excludeHeld memisahkan file yang berada dalam legal hold dari daftar hapus.
+// Jika registry hold tidak dapat dibaca, cleanup dibatalkan (fail-safe).
+func (s *Service) excludeHeld(files []types_backup.BackupFileInfo) ([]types_backup.BackupFileInfo, []heldBackup, error) {
+	if len(files) == 0 {
+		return files, nil, nil
+	}
+
+	reg, err := hold.Load(s.Config.Backup.Hold.Registry)
+	if err != nil {
+		return nil, nil, fmt.Errorf("registry hold tidak dapat dibaca, cleanup dibatalkan: %w", err)
+	}
+	now := time.Now()
+	if len(reg.Active(now)) == 0 {
+		return files, nil, nil
+	}
+
+	idx, err := buildBackupSetIndex(s.baseDirectory())
+	if err != nil {
+		return nil, nil, fmt.Errorf("gagal mengindex backup set untuk pengecekan hold: %w", err)
+	}
+
+	var deletable []types_backup.BackupFileInfo
+	var held []heldBackup
+	for _, f := range files {
+		if h, ok := reg.Match(f.Path, idx.ticketOf(f.Path), now); ok {
+			held = append(held, heldBackup{File: f, Hold: h})
+			continue
+		}
+		deletable = append(deletable, f)
+	}
+	return deletable, held, nil
+}
+
+// displayHeldBackups menampilkan backup yang dilewati karena legal hold.
+func (s *Service) displayHeldBackups(held []heldBackup) {
+	if len(held) == 0 {
+		return
+	}
+
+	var totalSize int64
+	rows := make([][]string, 0, len(held))
+	for _, h := range held {
+		totalSize += h.File.Size
+		expires := "-"
+		if h.Hold.ExpiresAt != nil {
+			expires = h.Hold.ExpiresAt.Format("2006-01-02")
+		}
+		rows = append(rows, []string{filepath.Base(h.File.Path), h.Hold.ID, h.Hold.Target(), h.Hold.Reason, h.Hold.Owner, expires})
+	}
+
+	print.PrintSubHeader("Backup Dalam Legal Hold (dilewati)")
+	table.Render([]string{"File", "Hold", "Target", "Alasan", "Owner", "Kedaluwarsa"}, rows)
+	s.Log.Infof("Legal hold: %d file (%s) tidak dihapus", len(held), text.FormatFileSize(totalSize))
+}
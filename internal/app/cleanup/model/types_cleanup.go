@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // CleanupOptions menyimpan opsi cleanup untuk backup.
 type CleanupOptions struct {
 	Enabled         bool
@@ -7,6 +9,74 @@ type CleanupOptions struct {
 	CleanupSchedule string
 	Pattern         string
 	DryRun          bool
+	BaseDirectory   string // Direktori yang dibersihkan (default: backup.output.base_directory)
+	Retention       RetentionPolicy
+	KeepLast        int   // Simpan N backup sukses terakhir per database
+	MaxTotalBytes   int64 // Batas total ukuran direktori; file terlama dihapus lebih dulu
+}
+
+// Strategi retensi cleanup.
+const (
+	RetentionModeDays     = "days"
+	RetentionModeGFS      = "gfs"
+	RetentionModeKeepLast = "keep-last"
+	RetentionModeMaxTotal = "max-total"
+)
+
+// RetentionModes mengembalikan strategi retensi yang aktif (lebih dari satu berarti konflik).
+// Jika kosong, cleanup memakai retensi berbasis days.
+func (o CleanupOptions) RetentionModes() []string {
+	var modes []string
+	if o.Retention.Enabled() {
+		modes = append(modes, RetentionModeGFS)
+	}
+	if o.KeepLast > 0 {
+		modes = append(modes, RetentionModeKeepLast)
+	}
+	if o.MaxTotalBytes > 0 {
+		modes = append(modes, RetentionModeMaxTotal)
+	}
+	return modes
+}
+
+// RetentionPolicy adalah policy GFS (grandfather-father-son) per database.
+// Nilai 0 berarti bucket tersebut tidak dipakai.
+type RetentionPolicy struct {
+	KeepDaily   int
+	KeepWeekly  int
+	KeepMonthly int
+	KeepYearly  int
+}
+
+// Enabled mengembalikan true jika minimal satu bucket GFS diisi.
+func (p RetentionPolicy) Enabled() bool {
+	return p.KeepDaily > 0 || p.KeepWeekly > 0 || p.KeepMonthly > 0 || p.KeepYearly > 0
+}
+
+// Bucket retensi GFS dan alasan file dipertahankan.
+const (
+	RetentionBucketDaily   = "daily"
+	RetentionBucketWeekly  = "weekly"
+	RetentionBucketMonthly = "monthly"
+	RetentionBucketYearly  = "yearly"
+	RetentionKeepNewest    = "newest" // Backup sukses terbaru database (tidak pernah dihapus)
+	RetentionKeepLast      = "last"   // Termasuk N backup sukses terakhir (keep-last)
+	RetentionKeepQuota     = "quota"  // Masih muat dalam kuota max-total
+)
+
+// RetentionCandidate adalah satu file backup yang dievaluasi policy retensi.
+type RetentionCandidate struct {
+	Path          string
+	Database      string
+	Time          time.Time // Waktu backup (metadata -> nama file -> mtime)
+	TimeSource    string    // "metadata", "filename", atau "mtime"
+	Size          int64
+	CompanionSize int64 // Ukuran metadata/grants yang ikut terhapus bersama file ini (mode max-total)
+	Successful    bool  // true hanya jika metadata menyatakan backup sukses
+	HasMetadata   bool  // false jika tidak ada metadata terbaca yang merujuk file ini
+	Held          bool  // Dalam legal hold; mode max-total menghitungnya sebagai ukuran tetap
+	Keep          bool
+	Reasons       []string // Bucket yang dipenuhi file ini (mis. daily 2026-10-18, monthly 2026-10)
 }
 
 // CleanupEntryConfig menyimpan konfigurasi untuk entry point cleanup.
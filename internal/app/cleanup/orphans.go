@@ -0,0 +1,136 @@
+// File : internal/app/cleanup/orphans.go
+// Deskripsi : Laporan file orphan di direktori backup (cleanup orphans)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package cleanup
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// Jenis orphan yang dilaporkan.
+const (
+	orphanMetaWithoutDump   = "metadata tanpa dump"
+	orphanGrantsWithoutDump = "grants tanpa dump"
+	orphanDumpWithoutMeta   = "dump tanpa metadata"
+	orphanEmptyDirectory    = "direktori kosong"
+	orphanUnreadableMeta    = "metadata tidak terbaca"
+)
+
+// orphanEntry adalah satu temuan orphan.
+type orphanEntry struct {
+	Kind   string
+	Path   string
+	Size   int64
+	Detail string
+}
+
+// reportOrphans menampilkan file yang tidak lagi membentuk backup set utuh:
+// metadata tanpa dump, grants tanpa dump, dump tanpa metadata, dan direktori kosong.
+// Tidak ada file yang dihapus.
+func (s *Service) reportOrphans() error {
+	baseDir := s.baseDirectory()
+	s.Log.Infof("Memeriksa orphan di: %s", baseDir)
+
+	idx, err := buildBackupSetIndex(baseDir)
+	if err != nil {
+		return fmt.Errorf("gagal mengindex direktori backup: %w", err)
+	}
+
+	entries := findOrphans(idx)
+	if len(entries) == 0 {
+		s.Log.Info("Tidak ada orphan ditemukan")
+		return nil
+	}
+
+	counts := make(map[string]int)
+	rows := make([][]string, 0, len(entries))
+	for _, e := range entries {
+		counts[e.Kind]++
+		size := "-"
+		if e.Kind != orphanEmptyDirectory {
+			size = text.FormatFileSize(e.Size)
+		}
+		rel, err := filepath.Rel(baseDir, e.Path)
+		if err != nil {
+			rel = e.Path
+		}
+		rows = append(rows, []string{e.Kind, rel, size, e.Detail})
+	}
+
+	print.PrintSubHeader("File Orphan")
+	table.Render([]string{"Jenis", "Path", "Ukuran", "Keterangan"}, rows)
+
+	kinds := []string{orphanMetaWithoutDump, orphanGrantsWithoutDump, orphanDumpWithoutMeta, orphanEmptyDirectory, orphanUnreadableMeta}
+	summary := make([][]string, 0, len(kinds))
+	for _, k := range kinds {
+		summary = append(summary, []string{k, fmt.Sprintf("%d", counts[k])})
+	}
+	table.Render([]string{"Jenis", "Jumlah"}, summary)
+
+	s.Log.Infof("Ditemukan %d orphan di %s", len(entries), baseDir)
+	return nil
+}
+
+// findOrphans mengumpulkan temuan orphan dari index backup set.
+func findOrphans(idx *backupSetIndex) []orphanEntry {
+	var entries []orphanEntry
+
+	for _, g := range idx.Groups {
+		if g.MetaPath == "" || len(g.Refs) > 0 {
+			continue
+		}
+		detail := "dump tidak ditemukan"
+		if len(g.Missing) > 0 {
+			names := make([]string, 0, len(g.Missing))
+			for _, m := range g.Missing {
+				names = append(names, filepath.Base(m))
+			}
+			detail = "dump hilang: " + strings.Join(names, ", ")
+		}
+		for _, f := range g.Files {
+			kind := orphanMetaWithoutDump
+			if f != g.MetaPath {
+				kind = orphanGrantsWithoutDump
+			}
+			entries = append(entries, orphanEntry{Kind: kind, Path: f, Size: idx.fileSizeMap[f], Detail: detail})
+		}
+	}
+
+	for _, grants := range idx.Grants {
+		if !idx.Companions[grants] {
+			entries = append(entries, orphanEntry{Kind: orphanGrantsWithoutDump, Path: grants, Size: idx.fileSizeMap[grants], Detail: "tidak ada dump/metadata yang merujuk"})
+		}
+	}
+
+	for dump := range idx.Dumps {
+		if !idx.Referenced[dump] {
+			entries = append(entries, orphanEntry{Kind: orphanDumpWithoutMeta, Path: dump, Size: idx.fileSizeMap[dump], Detail: "status backup tidak dapat diverifikasi"})
+		}
+	}
+
+	for metaPath, err := range idx.MetaErrors {
+		entries = append(entries, orphanEntry{Kind: orphanUnreadableMeta, Path: metaPath, Size: idx.fileSizeMap[metaPath], Detail: err.Error()})
+	}
+
+	for _, dir := range idx.EmptyDirs {
+		entries = append(entries, orphanEntry{Kind: orphanEmptyDirectory, Path: dir})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Kind != entries[j].Kind {
+			return entries[i].Kind < entries[j].Kind
+		}
+		return entries[i].Path < entries[j].Path
+	})
+	return entries
+}
@@ -0,0 +1,382 @@
+// File : internal/app/cleanup/retention.go
+// Deskripsi : Policy retensi per database untuk cleanup (GFS, keep-last, kuota ukuran)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package cleanup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	backupfile "sfdbtools/internal/app/backup/helpers/file"
+	"sfdbtools/internal/app/backup/model/types_backup"
+	cleanupmodel "sfdbtools/internal/app/cleanup/model"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// cleanupRetention menjalankan cleanup berbasis policy retensi per database (GFS, keep-last, atau max-total).
+// File yang tidak dipertahankan policy akan dihapus.
+func (s *Service) cleanupRetention(dryRun bool, pattern string, mode string) error {
+	baseDir := s.baseDirectory()
+	opts := s.CleanupOptions
+
+	candidates, idx, err := s.collectRetentionCandidates(baseDir, pattern)
+	if err != nil {
+		return fmt.Errorf("gagal memindai file backup: %w", err)
+	}
+	if len(candidates) == 0 {
+		s.Log.Info("Tidak ada file backup yang ditemukan")
+		return nil
+	}
+
+	switch mode {
+	case cleanupmodel.RetentionModeGFS:
+		p := opts.Retention
+		s.Log.Infof("Cleanup policy GFS: daily=%d weekly=%d monthly=%d yearly=%d (per database)",
+			p.KeepDaily, p.KeepWeekly, p.KeepMonthly, p.KeepYearly)
+		ApplyRetentionPolicy(candidates, p)
+	case cleanupmodel.RetentionModeKeepLast:
+		s.Log.Infof("Cleanup policy keep-last: simpan %d backup sukses terakhir per database", opts.KeepLast)
+		ApplyKeepLast(candidates, opts.KeepLast)
+	case cleanupmodel.RetentionModeMaxTotal:
+		total := addCompanionSizes(candidates, idx)
+		// File legal hold tidak akan terhapus, jadi dihitung sebagai ukuran tetap sebelum kuota dievaluasi.
+		if err := s.markHeldCandidates(candidates); err != nil {
+			return err
+		}
+		s.Log.Infof("Cleanup policy max-total: kuota %s, ukuran backup saat ini %s",
+			text.FormatFileSize(opts.MaxTotalBytes), text.FormatFileSize(total))
+		if remaining := ApplySizeQuota(candidates, total, opts.MaxTotalBytes); remaining > opts.MaxTotalBytes {
+			s.Log.Warnf("Kuota tidak dapat dipenuhi tanpa menghapus backup terakhir database atau backup dalam legal hold: sisa %s > kuota %s",
+				text.FormatFileSize(remaining), text.FormatFileSize(opts.MaxTotalBytes))
+		}
+	default:
+		return ErrInvalidCleanupMode
+	}
+
+	var filesToDelete []types_backup.BackupFileInfo
+	for _, c := range candidates {
+		if c.Keep {
+			continue
+		}
+		filesToDelete = append(filesToDelete, types_backup.BackupFileInfo{
+			Path:    c.Path,
+			ModTime: c.Time,
+			Size:    c.Size,
+		})
+	}
+
+	filesToDelete, held, err := s.excludeHeld(filesToDelete)
+	if err != nil {
+		return err
+	}
+	heldPaths := make(map[string]bool, len(held))
+	for _, h := range held {
+		heldPaths[h.File.Path] = true
+	}
+
+	if dryRun {
+		s.displayRetentionPlan(candidates, heldPaths)
+		s.displayHeldBackups(held)
+		if len(filesToDelete) > 0 {
+			s.logDryRunSummary(s.withCompanions(filesToDelete))
+		}
+		return nil
+	}
+
+	s.displayHeldBackups(held)
+	if len(filesToDelete) == 0 {
+		s.Log.Info("Semua file backup masih dipertahankan policy retensi, tidak ada yang dihapus")
+		return nil
+	}
+	s.performDeletion(filesToDelete)
+	return nil
+}
+
+// collectRetentionCandidates menyusun kandidat retensi dari index backup set untuk dump yang cocok pattern.
+// Status dan waktu diambil dari metadata set (termasuk DatabaseDetails mode separated/combined);
+// tanpa metadata terbaca, waktu diambil dari pola nama file lalu mtime dan status dianggap tidak sukses.
+func (s *Service) collectRetentionCandidates(baseDir, pattern string) ([]cleanupmodel.RetentionCandidate, *backupSetIndex, error) {
+	if pattern == "" {
+		pattern = "**/*"
+	}
+
+	paths, err := doublestar.Glob(os.DirFS(baseDir), pattern)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gagal memproses pattern glob %s: %w", pattern, err)
+	}
+	idx, err := buildBackupSetIndex(baseDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gagal mengindex backup set: %w", err)
+	}
+	for metaPath, metaErr := range idx.MetaErrors {
+		s.Log.Warnf("Metadata %s tidak dapat dibaca: %v", metaPath, metaErr)
+	}
+
+	var candidates []cleanupmodel.RetentionCandidate
+	for _, path := range paths {
+		fullPath := filepath.Join(baseDir, path)
+		// Hanya file backup utama; metadata dan grants user bukan anggota seri retensi.
+		if !idx.Dumps[fullPath] {
+			continue
+		}
+
+		c := cleanupmodel.RetentionCandidate{
+			Path:       fullPath,
+			Database:   backupfile.ExtractDatabaseNameFromFile(fullPath),
+			TimeSource: "mtime",
+			Size:       idx.fileSizeMap[fullPath],
+		}
+		if info, err := os.Stat(fullPath); err == nil {
+			c.Time = info.ModTime()
+		}
+		if t, ok := backupfile.ExtractTimestampFromFile(fullPath); ok {
+			c.Time = t
+			c.TimeSource = "filename"
+		}
+		if st, ok := idx.Status[fullPath]; ok {
+			c.HasMetadata = true
+			c.Successful = st.Successful
+			if !st.Time.IsZero() {
+				c.Time = st.Time
+				c.TimeSource = "metadata"
+			}
+			if st.Database != "" {
+				c.Database = st.Database
+			}
+		}
+
+		candidates = append(candidates, c)
+	}
+	return candidates, idx, nil
+}
+
+// ApplyRetentionPolicy menandai file yang dipertahankan (Keep) berdasarkan policy GFS per database.
+// Untuk setiap bucket, backup sukses terbaru di setiap periode (hari/minggu/bulan/tahun) dipertahankan
+// sampai jumlah periode mencapai batas bucket. Backup sukses terbaru setiap database selalu dipertahankan.
+// Backup gagal atau tanpa status tidak mengisi bucket; database tanpa backup sukses menyisakan salinan terbaru.
+func ApplyRetentionPolicy(candidates []cleanupmodel.RetentionCandidate, policy cleanupmodel.RetentionPolicy) {
+	sortCandidatesByDatabase(candidates)
+
+	buckets := []struct {
+		name   string
+		keep   int
+		period func(time.Time) string
+	}{
+		{cleanupmodel.RetentionBucketDaily, policy.KeepDaily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{cleanupmodel.RetentionBucketWeekly, policy.KeepWeekly, func(t time.Time) string {
+			y, w := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", y, w)
+		}},
+		{cleanupmodel.RetentionBucketMonthly, policy.KeepMonthly, func(t time.Time) string { return t.Format("2006-01") }},
+		{cleanupmodel.RetentionBucketYearly, policy.KeepYearly, func(t time.Time) string { return t.Format("2006") }},
+	}
+
+	forEachDatabase(candidates, func(group []cleanupmodel.RetentionCandidate) {
+		markNewestSuccessful(group)
+
+		for _, b := range buckets {
+			if b.keep <= 0 {
+				continue
+			}
+			seen := make(map[string]bool)
+			for i := range group {
+				if len(seen) >= b.keep {
+					break
+				}
+				if !group[i].Successful {
+					continue
+				}
+				key := b.period(group[i].Time)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				group[i].Keep = true
+				group[i].Reasons = append(group[i].Reasons, b.name+" "+key)
+			}
+		}
+	})
+}
+
+// ApplyKeepLast menandai n backup sukses terakhir setiap database sebagai Keep.
+func ApplyKeepLast(candidates []cleanupmodel.RetentionCandidate, n int) {
+	sortCandidatesByDatabase(candidates)
+	forEachDatabase(candidates, func(group []cleanupmodel.RetentionCandidate) {
+		markNewestSuccessful(group)
+		kept := 0
+		for i := range group {
+			if kept >= n {
+				break
+			}
+			if !group[i].Successful {
+				continue
+			}
+			kept++
+			group[i].Keep = true
+			group[i].Reasons = append(group[i].Reasons, fmt.Sprintf("%s #%d", cleanupmodel.RetentionKeepLast, kept))
+		}
+	})
+}
+
+// ApplySizeQuota menghapus (Keep=false) file terlama lintas database sampai total <= maxTotal.
+// total adalah ukuran seluruh kandidat (Size + CompanionSize). Backup sukses terbaru setiap database tidak pernah dihapus.
+// Kandidat Held (legal hold) tidak mengurangi total karena tidak akan terhapus.
+// Mengembalikan perkiraan ukuran backup setelah penghapusan.
+func ApplySizeQuota(candidates []cleanupmodel.RetentionCandidate, total, maxTotal int64) int64 {
+	sortCandidatesByDatabase(candidates)
+	forEachDatabase(candidates, func(group []cleanupmodel.RetentionCandidate) {
+		markNewestSuccessful(group)
+	})
+
+	order := make([]int, 0, len(candidates))
+	for i := range candidates {
+		order = append(order, i)
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return candidates[order[a]].Time.Before(candidates[order[b]].Time)
+	})
+
+	for _, i := range order {
+		c := &candidates[i]
+		if c.Keep || c.Held {
+			continue
+		}
+		if total > maxTotal {
+			total -= c.Size + c.CompanionSize
+			continue
+		}
+		c.Keep = true
+		c.Reasons = append(c.Reasons, cleanupmodel.RetentionKeepQuota)
+	}
+	return total
+}
+
+// sortCandidatesByDatabase mengurutkan kandidat per database, terbaru dulu.
+func sortCandidatesByDatabase(candidates []cleanupmodel.RetentionCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Database != candidates[j].Database {
+			return candidates[i].Database < candidates[j].Database
+		}
+		return candidates[i].Time.After(candidates[j].Time)
+	})
+}
+
+// forEachDatabase memanggil fn untuk setiap kelompok database (kandidat harus sudah diurutkan).
+func forEachDatabase(candidates []cleanupmodel.RetentionCandidate, fn func(group []cleanupmodel.RetentionCandidate)) {
+	for start := 0; start < len(candidates); {
+		end := start
+		for end < len(candidates) && candidates[end].Database == candidates[start].Database {
+			end++
+		}
+		fn(candidates[start:end])
+		start = end
+	}
+}
+
+// markNewestSuccessful mempertahankan backup sukses terbaru dalam satu kelompok database.
+// Database tanpa backup sukses (gagal atau status tidak diketahui) tetap menyisakan satu salinan terakhir.
+func markNewestSuccessful(group []cleanupmodel.RetentionCandidate) {
+	newest := 0
+	for i := range group {
+		if group[i].Successful {
+			newest = i
+			break
+		}
+	}
+	group[newest].Keep = true
+	group[newest].Reasons = append(group[newest].Reasons, cleanupmodel.RetentionKeepNewest)
+}
+
+// markHeldCandidates menandai kandidat yang berada dalam legal hold (Held=true).
+func (s *Service) markHeldCandidates(candidates []cleanupmodel.RetentionCandidate) error {
+	files := make([]types_backup.BackupFileInfo, 0, len(candidates))
+	for _, c := range candidates {
+		files = append(files, types_backup.BackupFileInfo{Path: c.Path, ModTime: c.Time, Size: c.Size})
+	}
+	_, held, err := s.excludeHeld(files)
+	if err != nil {
+		return err
+	}
+	heldPaths := make(map[string]bool, len(held))
+	for _, h := range held {
+		heldPaths[h.File.Path] = true
+	}
+	for i := range candidates {
+		candidates[i].Held = heldPaths[candidates[i].Path]
+	}
+	return nil
+}
+
+// addCompanionSizes mengisi CompanionSize kandidat dengan ukuran companion (metadata, grants) milik dump-nya,
+// karena companion ikut terhapus bersama dump, lalu mengembalikan total ukuran seluruh kandidat.
+// Companion yang merujuk beberapa dump tidak dihitung karena baru terhapus jika semua dump-nya terhapus.
+func addCompanionSizes(candidates []cleanupmodel.RetentionCandidate, idx *backupSetIndex) int64 {
+	companionSize := make(map[string]int64)
+	for _, g := range idx.Groups {
+		if len(g.Refs) != 1 {
+			continue
+		}
+		for _, f := range g.Files {
+			companionSize[g.Refs[0]] += idx.fileSizeMap[f]
+		}
+	}
+	var total int64
+	for i := range candidates {
+		candidates[i].CompanionSize = companionSize[candidates[i].Path]
+		total += candidates[i].Size + candidates[i].CompanionSize
+	}
+	return total
+}
+
+// displayRetentionPlan menampilkan rencana retensi: file mana mengisi bucket mana dan mana yang dihapus.
+// File dalam legal hold ditandai HOLD dan tidak dihapus.
+func (s *Service) displayRetentionPlan(candidates []cleanupmodel.RetentionCandidate, held map[string]bool) {
+	print.PrintSubHeader("Rencana Retensi")
+
+	var keepCount, deleteCount, holdCount int
+	rows := make([][]string, 0, len(candidates))
+	for _, c := range candidates {
+		action := text.ColorText("DELETE", consts.UIColorRed)
+		reason := "-"
+		switch {
+		case !c.Keep && held[c.Path]:
+			holdCount++
+			action = text.ColorText("HOLD", consts.UIColorYellow)
+			reason = "legal hold"
+		case c.Keep:
+			keepCount++
+			action = text.ColorText("KEEP", consts.UIColorGreen)
+			reason = strings.Join(c.Reasons, ", ")
+		default:
+			deleteCount++
+			switch {
+			case !c.HasMetadata:
+				reason = "status tidak diketahui"
+			case !c.Successful:
+				reason = "backup gagal"
+			}
+		}
+		rows = append(rows, []string{
+			c.Database,
+			c.Time.Format(consts.CleanupTimeFormat),
+			c.TimeSource,
+			action,
+			reason,
+			filepath.Base(c.Path),
+		})
+	}
+	table.Render([]string{"Database", "Waktu Backup", "Sumber", "Aksi", "Alasan", "File"}, rows)
+	s.Log.Infof("Rencana retensi: %d file dipertahankan, %d file dalam legal hold, %d file akan dihapus", keepCount, holdCount, deleteCount)
+}
@@ -0,0 +1,368 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"sfdbtools/internal/app/backup/model/types_backup"
+	cleanupmodel "sfdbtools/internal/app/cleanup/model"
+	applog "sfdbtools/internal/services/log"
+)
+
+// retentionFixture membuat kandidat dengan waktu relatif terhadap base (dalam jam).
+type retentionFixture struct {
+	path   string
+	db     string
+	hours  int
+	size   int64
+	failed bool
+	held   bool
+}
+
+var retentionBase = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+func buildCandidates(fx []retentionFixture) []cleanupmodel.RetentionCandidate {
+	out := make([]cleanupmodel.RetentionCandidate, 0, len(fx))
+	for _, f := range fx {
+		out = append(out, cleanupmodel.RetentionCandidate{
+			Path:       f.path,
+			Database:   f.db,
+			Time:       retentionBase.Add(-time.Duration(f.hours) * time.Hour),
+			Size:       f.size,
+			Successful: !f.failed,
+			Held:       f.held,
+		})
+	}
+	return out
+}
+
+func keptPaths(candidates []cleanupmodel.RetentionCandidate) []string {
+	var kept []string
+	for _, c := range candidates {
+		if c.Keep {
+			kept = append(kept, c.Path)
+		}
+	}
+	slices.Sort(kept)
+	return kept
+}
+
+func TestApplyRetentionPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixtures []retentionFixture
+		policy   cleanupmodel.RetentionPolicy
+		want     []string
+	}{
+		{
+			name: "daily keeps newest per day",
+			fixtures: []retentionFixture{
+				{path: "d0-a", db: "app", hours: 0},
+				{path: "d0-b", db: "app", hours: 2},
+				{path: "d1", db: "app", hours: 24},
+				{path: "d2", db: "app", hours: 48},
+				{path: "d3", db: "app", hours: 72},
+			},
+			policy: cleanupmodel.RetentionPolicy{KeepDaily: 2},
+			want:   []string{"d0-a", "d1"},
+		},
+		{
+			name: "failed backups do not fill buckets",
+			fixtures: []retentionFixture{
+				{path: "d0", db: "app", hours: 0, failed: true},
+				{path: "d1", db: "app", hours: 24},
+				{path: "d2", db: "app", hours: 48},
+			},
+			policy: cleanupmodel.RetentionPolicy{KeepDaily: 1},
+			want:   []string{"d1"},
+		},
+		{
+			name: "buckets are evaluated per database",
+			fixtures: []retentionFixture{
+				{path: "app-0", db: "app", hours: 0},
+				{path: "app-1", db: "app", hours: 24},
+				{path: "crm-0", db: "crm", hours: 24 * 40},
+				{path: "crm-1", db: "crm", hours: 24 * 41},
+			},
+			policy: cleanupmodel.RetentionPolicy{KeepDaily: 1},
+			want:   []string{"app-0", "crm-0"},
+		},
+		{
+			name: "monthly spans months",
+			fixtures: []retentionFixture{
+				{path: "oct", db: "app", hours: 0},
+				{path: "sep-a", db: "app", hours: 24 * 20},
+				{path: "sep-b", db: "app", hours: 24 * 25},
+				{path: "aug", db: "app", hours: 24 * 60},
+			},
+			policy: cleanupmodel.RetentionPolicy{KeepMonthly: 2},
+			want:   []string{"oct", "sep-a"},
+		},
+		{
+			name: "database without successful backup keeps newest copy",
+			fixtures: []retentionFixture{
+				{path: "f0", db: "app", hours: 0, failed: true},
+				{path: "f1", db: "app", hours: 24, failed: true},
+			},
+			policy: cleanupmodel.RetentionPolicy{KeepDaily: 7},
+			want:   []string{"f0"},
+		},
+		{
+			name: "empty policy still keeps newest successful",
+			fixtures: []retentionFixture{
+				{path: "new", db: "app", hours: 0, failed: true},
+				{path: "ok", db: "app", hours: 24},
+				{path: "old", db: "app", hours: 48},
+			},
+			want: []string{"ok"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := buildCandidates(tt.fixtures)
+			ApplyRetentionPolicy(candidates, tt.policy)
+			if got := keptPaths(candidates); !slices.Equal(got, tt.want) {
+				t.Fatalf("kept = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyKeepLast(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixtures []retentionFixture
+		n        int
+		want     []string
+	}{
+		{
+			name: "keeps n newest per database",
+			fixtures: []retentionFixture{
+				{path: "app-0", db: "app", hours: 0},
+				{path: "app-1", db: "app", hours: 1},
+				{path: "app-2", db: "app", hours: 2},
+				{path: "crm-0", db: "crm", hours: 5},
+				{path: "crm-1", db: "crm", hours: 6},
+			},
+			n:    2,
+			want: []string{"app-0", "app-1", "crm-0", "crm-1"},
+		},
+		{
+			name: "failed backups are skipped",
+			fixtures: []retentionFixture{
+				{path: "f0", db: "app", hours: 0, failed: true},
+				{path: "s1", db: "app", hours: 1},
+				{path: "f2", db: "app", hours: 2, failed: true},
+				{path: "s3", db: "app", hours: 3},
+				{path: "s4", db: "app", hours: 4},
+			},
+			n:    2,
+			want: []string{"s1", "s3"},
+		},
+		{
+			name: "n larger than backups keeps all successful",
+			fixtures: []retentionFixture{
+				{path: "s0", db: "app", hours: 0},
+				{path: "f1", db: "app", hours: 1, failed: true},
+			},
+			n:    5,
+			want: []string{"s0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := buildCandidates(tt.fixtures)
+			ApplyKeepLast(candidates, tt.n)
+			if got := keptPaths(candidates); !slices.Equal(got, tt.want) {
+				t.Fatalf("kept = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplySizeQuota(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixtures  []retentionFixture
+		companion map[string]int64
+		maxTotal  int64
+		want      []string
+		wantTotal int64
+	}{
+		{
+			name: "deletes oldest until under quota",
+			fixtures: []retentionFixture{
+				{path: "app-0", db: "app", hours: 0, size: 100},
+				{path: "app-1", db: "app", hours: 24, size: 100},
+				{path: "app-2", db: "app", hours: 48, size: 100},
+				{path: "app-3", db: "app", hours: 72, size: 100},
+			},
+			maxTotal:  250,
+			want:      []string{"app-0", "app-1"},
+			wantTotal: 200,
+		},
+		{
+			name: "oldest deleted across databases",
+			fixtures: []retentionFixture{
+				{path: "app-0", db: "app", hours: 0, size: 100},
+				{path: "app-1", db: "app", hours: 10, size: 100},
+				{path: "crm-0", db: "crm", hours: 5, size: 100},
+				{path: "crm-1", db: "crm", hours: 50, size: 100},
+			},
+			maxTotal:  300,
+			want:      []string{"app-0", "app-1", "crm-0"},
+			wantTotal: 300,
+		},
+		{
+			name: "newest successful never deleted even over quota",
+			fixtures: []retentionFixture{
+				{path: "app-0", db: "app", hours: 0, size: 500},
+				{path: "app-1", db: "app", hours: 24, size: 500},
+			},
+			maxTotal:  100,
+			want:      []string{"app-0"},
+			wantTotal: 500,
+		},
+		{
+			name: "database without successful backup keeps newest copy",
+			fixtures: []retentionFixture{
+				{path: "app-0", db: "app", hours: 0, size: 100, failed: true},
+				{path: "app-1", db: "app", hours: 24, size: 100, failed: true},
+			},
+			maxTotal:  50,
+			want:      []string{"app-0"},
+			wantTotal: 100,
+		},
+		{
+			name: "held backups count as fixed bytes",
+			fixtures: []retentionFixture{
+				{path: "app-0", db: "app", hours: 0, size: 100},
+				{path: "app-1", db: "app", hours: 24, size: 100},
+				{path: "app-2", db: "app", hours: 48, size: 100, held: true},
+				{path: "app-3", db: "app", hours: 72, size: 100},
+			},
+			maxTotal:  250,
+			want:      []string{"app-0"},
+			wantTotal: 200,
+		},
+		{
+			name: "companion size counts toward freed space",
+			fixtures: []retentionFixture{
+				{path: "app-0", db: "app", hours: 0, size: 100},
+				{path: "app-1", db: "app", hours: 24, size: 100},
+				{path: "app-2", db: "app", hours: 48, size: 100},
+			},
+			companion: map[string]int64{"app-2": 100},
+			maxTotal:  250,
+			want:      []string{"app-0", "app-1"},
+			wantTotal: 200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := buildCandidates(tt.fixtures)
+			var total int64
+			for i := range candidates {
+				candidates[i].CompanionSize = tt.companion[candidates[i].Path]
+				total += candidates[i].Size + candidates[i].CompanionSize
+			}
+			gotTotal := ApplySizeQuota(candidates, total, tt.maxTotal)
+			if got := keptPaths(candidates); !slices.Equal(got, tt.want) {
+				t.Fatalf("kept = %v, want %v", got, tt.want)
+			}
+			if gotTotal != tt.wantTotal {
+				t.Fatalf("total = %d, want %d", gotTotal, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func writeTestFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeTestMetadata(t *testing.T, path string, meta types_backup.BackupMetadata) {
+	t.Helper()
+	b, err := json.Marshal(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, path, b)
+}
+
+func TestCollectRetentionCandidates(t *testing.T) {
+	dir := t.TempDir()
+	end := retentionBase
+
+	// Set separated: satu metadata untuk dua dump, status gagal.
+	for _, f := range []string{"app_20261018_120000.sql.gz", "crm_20261018_120000.sql.gz", "legacy_20261017_120000.sql.gz", "solo_20261016_120000.sql.gz"} {
+		writeTestFile(t, filepath.Join(dir, f), []byte("dump"))
+	}
+	writeTestMetadata(t, filepath.Join(dir, "separated_20261018_120000.meta.json"), types_backup.BackupMetadata{
+		BackupFile:    "separated_20261018_120000",
+		BackupType:    "separated",
+		BackupStatus:  "failed",
+		BackupEndTime: end,
+		DatabaseDetails: []types_backup.DatabaseBackupDetail{
+			{DatabaseName: "app", BackupFile: "app_20261018_120000.sql.gz"},
+			{DatabaseName: "crm", BackupFile: "crm_20261018_120000.sql.gz"},
+		},
+	})
+	writeTestMetadata(t, filepath.Join(dir, "solo_20261016_120000.sql.gz.meta.json"), types_backup.BackupMetadata{
+		BackupFile:    "solo_20261016_120000.sql.gz",
+		BackupStatus:  "success",
+		BackupEndTime: end.Add(-48 * time.Hour),
+		DatabaseNames: []string{"solo"},
+	})
+
+	s := &Service{Log: applog.NullLogger()}
+	candidates, idx, err := s.collectRetentionCandidates(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idx == nil {
+		t.Fatal("index nil")
+	}
+
+	tests := []struct {
+		file           string
+		wantDB         string
+		wantSuccessful bool
+		wantMetadata   bool
+	}{
+		{file: "app_20261018_120000.sql.gz", wantDB: "app", wantMetadata: true},
+		{file: "crm_20261018_120000.sql.gz", wantDB: "crm", wantMetadata: true},
+		{file: "legacy_20261017_120000.sql.gz", wantDB: "legacy"},
+		{file: "solo_20261016_120000.sql.gz", wantDB: "solo", wantSuccessful: true, wantMetadata: true},
+	}
+	if len(candidates) != len(tests) {
+		t.Fatalf("got %d candidates, want %d", len(candidates), len(tests))
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			i := slices.IndexFunc(candidates, func(c cleanupmodel.RetentionCandidate) bool {
+				return filepath.Base(c.Path) == tt.file
+			})
+			if i < 0 {
+				t.Fatalf("candidate %s tidak ditemukan", tt.file)
+			}
+			c := candidates[i]
+			if c.Database != tt.wantDB || c.Successful != tt.wantSuccessful || c.HasMetadata != tt.wantMetadata {
+				t.Fatalf("candidate = %+v, want db=%s successful=%v metadata=%v", c, tt.wantDB, tt.wantSuccessful, tt.wantMetadata)
+			}
+			if tt.wantMetadata && c.TimeSource != "metadata" {
+				t.Fatalf("time source = %s, want metadata", c.TimeSource)
+			}
+		})
+	}
+}
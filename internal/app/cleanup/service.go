@@ -2,7 +2,7 @@
 // Deskripsi : Service utama implementation untuk cleanup operations
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 5 Januari 2026
+// Last Modified : 18 Oktober 2026
 package cleanup
 
 import (
@@ -56,6 +56,8 @@ func (s *Service) ExecuteCleanupCommand(config cleanupmodel.CleanupEntryConfig)
 			return ErrInvalidCleanupMode
 		}
 		return s.cleanupCore(dryRun, s.CleanupOptions.Pattern)
+	case "orphans":
+		return s.reportOrphans()
 	default:
 		return ErrInvalidCleanupMode
 	}
@@ -2,7 +2,7 @@
 // Deskripsi : Display functions untuk database scanning results
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 5 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package dbscan
 
@@ -28,6 +28,16 @@ func (s *Service) DisplayScanOptions() (bool, error) {
 		{"Display Results", fmt.Sprintf("%v", s.ScanOptions.DisplayResults)},
 	}
 
+	if s.ScanOptions.Deep {
+		data = append(data, []string{"Deep Scan (per tabel)", fmt.Sprintf("true (top %d tabel)", s.ScanOptions.TopTables)})
+	}
+	if s.ScanOptions.ExportPath != "" {
+		data = append(data, []string{"Export", s.ScanOptions.ExportPath})
+	}
+	if s.saveEnabled() {
+		data = append(data, []string{"Simpan ke Inventory", fmt.Sprintf("%s (db: %s)", s.ScanOptions.SaveTo.ProfileInfo.Path, s.ScanOptions.SaveTo.Database)})
+	}
+
 	if s.ScanOptions.Mode == "single" && s.ScanOptions.SourceDatabase != "" {
 		data = append(data, []string{"Source Database", s.ScanOptions.SourceDatabase})
 	}
@@ -53,11 +53,7 @@ func CollectDatabaseDetailsWithOptions(ctx context.Context, client *database.Cli
 	var wg sync.WaitGroup
 	for w := 0; w < maxWorkers; w++ {
 		wg.Add(1)
-		var sizeProvider func(context.Context, string) (int64, error)
-		if opts != nil {
-			sizeProvider = opts.SizeProvider
-		}
-		go databaseDetailWorker(runCtx, client, logger, jobs, results, &wg, jobTimeout, &started, &completed, &failed, total, sizeProvider)
+		go databaseDetailWorker(runCtx, client, logger, jobs, results, &wg, jobTimeout, &started, &completed, &failed, total, opts)
 	}
 
 	go func() {
@@ -110,7 +106,7 @@ func databaseDetailWorker(
 	completed *int32,
 	failed *int32,
 	total int,
-	sizeProvider func(context.Context, string) (int64, error),
+	opts *DetailCollectOptions,
 ) {
 	defer wg.Done()
 
@@ -120,7 +116,7 @@ func databaseDetailWorker(
 		jobStart := time.Now()
 		jobCtx, cancel := context.WithTimeout(ctx, timeout)
 
-		result := collectSingleDatabaseDetail(jobCtx, client, logger, job.DatabaseName, sizeProvider)
+		result := collectSingleDatabaseDetail(jobCtx, client, logger, job.DatabaseName, opts)
 		results <- result
 
 		if result.Error != "" {
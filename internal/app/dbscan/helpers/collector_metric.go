@@ -13,7 +13,7 @@ import (
 	"github.com/dustin/go-humanize"
 )
 
-func collectSingleDatabaseDetail(ctx context.Context, client *database.Client, logger applog.Logger, dbName string, sizeProvider func(context.Context, string) (int64, error)) dbscanmodel.DatabaseDetailInfo {
+func collectSingleDatabaseDetail(ctx context.Context, client *database.Client, logger applog.Logger, dbName string, opts *DetailCollectOptions) dbscanmodel.DatabaseDetailInfo {
 	startTime := time.Now()
 	detail := dbscanmodel.DatabaseDetailInfo{
 		DatabaseName:   dbName,
@@ -26,7 +26,12 @@ func collectSingleDatabaseDetail(ctx context.Context, client *database.Client, l
 		err        error
 	}
 
-	metricChan := make(chan metricResult, 6)
+	var sizeProvider func(context.Context, string) (int64, error)
+	if opts != nil {
+		sizeProvider = opts.SizeProvider
+	}
+
+	metricChan := make(chan metricResult, 7)
 	var metricWg sync.WaitGroup
 
 	metricWg.Add(1)
@@ -79,6 +84,17 @@ func collectSingleDatabaseDetail(ctx context.Context, client *database.Client, l
 		metricChan <- metricResult{"user_grants", int64(count), err}
 	}()
 
+	var tables []dbscanmodel.TableDetailInfo
+	if opts != nil && opts.CollectTables {
+		metricWg.Add(1)
+		go func() {
+			defer metricWg.Done()
+			var err error
+			tables, err = collectTableDetails(ctx, client, dbName)
+			metricChan <- metricResult{"table_details", int64(len(tables)), err}
+		}()
+	}
+
 	go func() {
 		metricWg.Wait()
 		close(metricChan)
@@ -105,6 +121,8 @@ func collectSingleDatabaseDetail(ctx context.Context, client *database.Client, l
 			detail.ViewCount = int(result.value)
 		case "user_grants":
 			detail.UserGrantCount = int(result.value)
+		case "table_details":
+			detail.Tables = tables
 		}
 	}
 
@@ -0,0 +1,114 @@
+// File : internal/app/dbscan/helpers/collector_tables.go
+// Deskripsi : Koleksi metrik per tabel untuk deep scan (db-scan tables / --deep)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package helpers
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/shared/database"
+)
+
+// autoIncrementLimits adalah nilai maksimum (signed, unsigned) per tipe integer.
+var autoIncrementLimits = map[string][2]uint64{
+	"tinyint":   {math.MaxInt8, math.MaxUint8},
+	"smallint":  {math.MaxInt16, math.MaxUint16},
+	"mediumint": {1<<23 - 1, 1<<24 - 1},
+	"int":       {math.MaxInt32, math.MaxUint32},
+	"integer":   {math.MaxInt32, math.MaxUint32},
+	"bigint":    {math.MaxInt64, math.MaxUint64},
+}
+
+// collectTableDetails mengambil metrik per tabel untuk satu database.
+func collectTableDetails(ctx context.Context, client *database.Client, dbName string) ([]dbscanmodel.TableDetailInfo, error) {
+	stats, err := client.GetTableStats(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]dbscanmodel.TableDetailInfo, 0, len(stats))
+	for _, st := range stats {
+		t := dbscanmodel.TableDetailInfo{
+			DatabaseName:  dbName,
+			TableName:     st.TableName,
+			Engine:        st.Engine,
+			RowEstimate:   st.TableRows,
+			DataBytes:     st.DataLength,
+			IndexBytes:    st.IndexLength,
+			TotalBytes:    st.DataLength + st.IndexLength,
+			DataFreeBytes: st.DataFree,
+			Charset:       st.Charset,
+			Collation:     st.Collation,
+		}
+		if allocated := t.TotalBytes + t.DataFreeBytes; allocated > 0 && t.DataFreeBytes > 0 {
+			t.FragmentationPercent = roundPercent(float64(t.DataFreeBytes) / float64(allocated) * 100)
+		}
+		if st.AutoIncrement.Valid && st.AutoIncrementColumnType != "" {
+			t.AutoIncrement, _ = strconv.ParseUint(strings.TrimSpace(st.AutoIncrement.String), 10, 64)
+			t.AutoIncrementMax = AutoIncrementMax(st.AutoIncrementColumnType)
+			if t.AutoIncrementMax > 0 && t.AutoIncrement > 0 {
+				t.AutoIncrementUsage = roundPercent(float64(t.AutoIncrement-1) / float64(t.AutoIncrementMax) * 100)
+			}
+		}
+		if st.CreateTime.Valid {
+			ct := st.CreateTime.Time
+			t.CreateTime = &ct
+		}
+		if st.UpdateTime.Valid {
+			ut := st.UpdateTime.Time
+			t.UpdateTime = &ut
+		}
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+// AutoIncrementMax mengembalikan nilai maksimum untuk COLUMN_TYPE integer (mis. "int(11) unsigned").
+// Mengembalikan 0 untuk tipe yang tidak dikenali.
+func AutoIncrementMax(columnType string) uint64 {
+	ct := strings.ToLower(strings.TrimSpace(columnType))
+	base := ct
+	if i := strings.IndexAny(base, "( "); i >= 0 {
+		base = base[:i]
+	}
+	limits, ok := autoIncrementLimits[base]
+	if !ok {
+		return 0
+	}
+	if strings.Contains(ct, "unsigned") {
+		return limits[1]
+	}
+	return limits[0]
+}
+
+// TopTables mengambil n tabel terbesar (data + index) lintas semua database. n <= 0 berarti semua.
+func TopTables(detailsMap map[string]dbscanmodel.DatabaseDetailInfo, n int) []dbscanmodel.TableDetailInfo {
+	var all []dbscanmodel.TableDetailInfo
+	for _, d := range detailsMap {
+		all = append(all, d.Tables...)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].TotalBytes != all[j].TotalBytes {
+			return all[i].TotalBytes > all[j].TotalBytes
+		}
+		if all[i].DatabaseName != all[j].DatabaseName {
+			return all[i].DatabaseName < all[j].DatabaseName
+		}
+		return all[i].TableName < all[j].TableName
+	})
+	if n > 0 && len(all) > n {
+		all = all[:n]
+	}
+	return all
+}
+
+func roundPercent(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"math"
+	"testing"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+)
+
+func TestAutoIncrementMax(t *testing.T) {
+	tests := []struct {
+		columnType string
+		want       uint64
+	}{
+		{columnType: "tinyint(4)", want: math.MaxInt8},
+		{columnType: "TINYINT(3) UNSIGNED", want: math.MaxUint8},
+		{columnType: "smallint unsigned", want: math.MaxUint16},
+		{columnType: "mediumint(9)", want: 1<<23 - 1},
+		{columnType: "int(11)", want: math.MaxInt32},
+		{columnType: " int(10) unsigned zerofill ", want: math.MaxUint32},
+		{columnType: "integer", want: math.MaxInt32},
+		{columnType: "bigint(20) unsigned", want: math.MaxUint64},
+		{columnType: "bigint", want: math.MaxInt64},
+		{columnType: "decimal(10,0)", want: 0},
+		{columnType: "", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.columnType, func(t *testing.T) {
+			if got := AutoIncrementMax(tt.columnType); got != tt.want {
+				t.Fatalf("AutoIncrementMax(%q) = %d, want %d", tt.columnType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopTables(t *testing.T) {
+	details := map[string]dbscanmodel.DatabaseDetailInfo{
+		"app": {Tables: []dbscanmodel.TableDetailInfo{
+			{DatabaseName: "app", TableName: "orders", TotalBytes: 300},
+			{DatabaseName: "app", TableName: "users", TotalBytes: 100},
+		}},
+		"crm": {Tables: []dbscanmodel.TableDetailInfo{
+			{DatabaseName: "crm", TableName: "leads", TotalBytes: 300},
+			{DatabaseName: "crm", TableName: "notes", TotalBytes: 50},
+		}},
+	}
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{name: "all", n: 0, want: []string{"app.orders", "crm.leads", "app.users", "crm.notes"}},
+		{name: "top two with tie broken by database", n: 2, want: []string{"app.orders", "crm.leads"}},
+		{name: "n larger than tables", n: 10, want: []string{"app.orders", "crm.leads", "app.users", "crm.notes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TopTables(details, tt.n)
+			if len(got) != len(tt.want) {
+				t.Fatalf("len = %d, want %d", len(got), len(tt.want))
+			}
+			for i, tbl := range got {
+				if name := tbl.DatabaseName + "." + tbl.TableName; name != tt.want[i] {
+					t.Fatalf("got[%d] = %s, want %s", i, name, tt.want[i])
+				}
+			}
+		})
+	}
+}
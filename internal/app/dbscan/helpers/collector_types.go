@@ -13,4 +13,7 @@ type DetailCollectOptions struct {
 	// sebagai pengganti query GetDatabaseSize. Kembalikan (size,nil) untuk sukses
 	// atau error untuk menandai kegagalan metrik.
 	SizeProvider func(ctx context.Context, dbName string) (int64, error)
+
+	// CollectTables, bila true, juga mengumpulkan metrik per tabel (deep scan).
+	CollectTables bool
 }
@@ -2,7 +2,7 @@
 // Deskripsi : Helper functions untuk menampilkan hasil scanning (general purpose)
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 5 Januari 2026
+// Last Modified : 18 Oktober 2026
 package helpers
 
 import (
@@ -14,6 +14,8 @@ import (
 	"sfdbtools/internal/ui/print"
 	"sfdbtools/internal/ui/table"
 	"sfdbtools/internal/ui/text"
+
+	"github.com/dustin/go-humanize"
 )
 
 // DisplayScanResult menampilkan hasil scanning ke UI
@@ -26,6 +28,9 @@ func DisplayScanResult(result *dbscanmodel.ScanResult) {
 		{"Gagal", text.ColorText(fmt.Sprintf("%d", result.FailedCount), consts.UIColorRed)},
 		{"Durasi", result.Duration},
 	}
+	if result.ScanID != "" {
+		data = append(data, []string{"Scan ID (Inventory)", result.ScanID})
+	}
 
 	headers := []string{"Metrik", "Nilai"}
 	table.Render(headers, data)
@@ -66,6 +71,49 @@ func DisplayDetailResults(detailsMap map[string]dbscanmodel.DatabaseDetailInfo)
 	table.Render(headers, rows)
 }
 
+// DisplayTopTables menampilkan tabel terbesar hasil deep scan lintas database.
+// Fragmentasi >= 20% dan pemakaian auto-increment >= 80% diberi warna peringatan.
+func DisplayTopTables(tables []dbscanmodel.TableDetailInfo) {
+	print.PrintHeader(fmt.Sprintf("TOP %d TABEL TERBESAR", len(tables)))
+
+	headers := []string{"Database", "Table", "Engine", "Est. Rows", "Data", "Index", "Total", "Free", "Frag %", "AutoInc %", "Collation", "Updated"}
+	var rows [][]string
+	for _, t := range tables {
+		frag := fmt.Sprintf("%.1f", t.FragmentationPercent)
+		if t.FragmentationPercent >= 20 {
+			frag = text.ColorText(frag, consts.UIColorYellow)
+		}
+		autoInc := "-"
+		if t.AutoIncrementMax > 0 {
+			autoInc = fmt.Sprintf("%.2f", t.AutoIncrementUsage)
+			if t.AutoIncrementUsage >= 80 {
+				autoInc = text.ColorText(autoInc, consts.UIColorRed)
+			}
+		}
+		updated := "-"
+		if t.UpdateTime != nil {
+			updated = t.UpdateTime.Format("2006-01-02 15:04")
+		}
+
+		rows = append(rows, []string{
+			t.DatabaseName,
+			t.TableName,
+			t.Engine,
+			humanize.Comma(t.RowEstimate),
+			humanize.Bytes(uint64(t.DataBytes)),
+			humanize.Bytes(uint64(t.IndexBytes)),
+			humanize.Bytes(uint64(t.TotalBytes)),
+			humanize.Bytes(uint64(t.DataFreeBytes)),
+			frag,
+			autoInc,
+			t.Collation,
+			updated,
+		})
+	}
+
+	table.Render(headers, rows)
+}
+
 // LogScanResult menulis hasil scanning ke logger (untuk background mode)
 func LogScanResult(result *dbscanmodel.ScanResult, logger applog.Logger, scanID string) {
 	logger.Infof("[%s] ========================================", scanID)
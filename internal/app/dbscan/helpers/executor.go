@@ -2,7 +2,7 @@
 // Deskripsi : Helper functions untuk eksekusi scanning database (general purpose)
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 5 Januari 2026
+// Last Modified : 18 Oktober 2026
 package helpers
 
 import (
@@ -24,6 +24,12 @@ type ScanExecutorOptions struct {
 	DisplayResult bool
 	Logger        applog.Logger
 	LocalSizes    map[string]int64
+	CollectTables bool // deep scan: kumpulkan metrik per tabel
+
+	// Inventory (opsional): jika diset, setiap detail di-upsert ke database inventory.
+	// Kegagalan simpan dicatat di ScanResult.Errors tanpa menghentikan scanning.
+	Inventory *InventoryStore
+	Run       ScanRunInfo
 }
 
 // ExecuteScanWithSave melakukan scanning database dan menyimpan hasilnya
@@ -67,20 +73,25 @@ func ExecuteScanWithSave(
 	var errors []string
 
 	// Siapkan opsi untuk override size menggunakan hasil local scan (jika ada)
-	var collectOpts *DetailCollectOptions
+	collectOpts := &DetailCollectOptions{CollectTables: opts.CollectTables}
 	if opts.LocalScan && opts.LocalSizes != nil {
-		sizeProvider := func(ctx context.Context, dbName string) (int64, error) {
+		collectOpts.SizeProvider = func(ctx context.Context, dbName string) (int64, error) {
 			if sz, ok := opts.LocalSizes[dbName]; ok {
 				return sz, nil
 			}
 			return 0, nil
 		}
-		collectOpts = &DetailCollectOptions{SizeProvider: sizeProvider}
 	}
 
-	detailsMap, collectErr := CollectDatabaseDetailsWithOptions(ctx, sourceClient, dbNames, opts.Logger, collectOpts, func(detail dbscanmodel.DatabaseDetailInfo) error {
-		detailsMap[detail.DatabaseName] = detail
+	run := opts.Run
+	if run.ServerHost == "" {
+		run.ServerHost = serverHost
+	}
+	if run.ServerPort == 0 {
+		run.ServerPort = serverPort
+	}
 
+	detailsMap, collectErr := CollectDatabaseDetailsWithOptions(ctx, sourceClient, dbNames, opts.Logger, collectOpts, func(detail dbscanmodel.DatabaseDetailInfo) error {
 		// Jika LocalScan aktif dan ada ukuran lokal, pastikan nilai size sudah sesuai
 		if opts.LocalScan && opts.LocalSizes != nil {
 			if sz, ok := opts.LocalSizes[detail.DatabaseName]; ok {
@@ -89,9 +100,15 @@ func ExecuteScanWithSave(
 			}
 		}
 
+		detailsMap[detail.DatabaseName] = detail
 		successCount++
-		_ = serverHost
-		_ = serverPort
+
+		if opts.Inventory != nil {
+			if err := opts.Inventory.UpsertDetail(ctx, run, detail); err != nil {
+				opts.Logger.Warnf("Gagal menyimpan detail %s ke inventory: %v", detail.DatabaseName, err)
+				errors = append(errors, err.Error())
+			}
+		}
 		return nil
 	})
 
@@ -103,6 +120,10 @@ func ExecuteScanWithSave(
 			FailedCount:    failedCount,
 			Duration:       timer.Elapsed().String(),
 			Errors:         errors,
+			ScanID:         run.ScanID,
+			ServerHost:     serverHost,
+			ServerPort:     serverPort,
+			StartedAt:      timer.StartTime(),
 		}, detailsMap, collectErr
 	}
 
@@ -112,6 +133,10 @@ func ExecuteScanWithSave(
 		FailedCount:    failedCount,
 		Duration:       timer.Elapsed().String(),
 		Errors:         errors,
+		ScanID:         run.ScanID,
+		ServerHost:     serverHost,
+		ServerPort:     serverPort,
+		StartedAt:      timer.StartTime(),
 	}
 
 	return result, detailsMap, nil
@@ -0,0 +1,351 @@
+// File : internal/app/dbscan/helpers/export.go
+// Deskripsi : Export hasil scanning ke file XLSX, CSV, atau JSON (--export)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/shared/fsops"
+	"sfdbtools/internal/shared/sheetx"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	exportSheetSummary = "Summary"
+	exportSheetDetails = "Details"
+	exportSheetTables  = "Tables"
+	exportTimeLayout   = "2006-01-02 15:04:05"
+)
+
+// exportDetailHeaders adalah kolom sheet/CSV detail. Kolom size dalam angka agar bisa di-sort.
+var exportDetailHeaders = []string{
+	"Profile", "Server Host", "Server Port", "Scan Time", "Database",
+	"Size (Bytes)", "Size (MB)", "Size", "Tables", "Procedures", "Functions",
+	"Views", "Grants", "Collection Time", "Error",
+}
+
+// exportTableHeaders adalah kolom sheet/CSV metrik per tabel (hanya pada deep scan).
+var exportTableHeaders = []string{
+	"Database", "Table", "Engine", "Rows (Est)", "Data (Bytes)", "Index (Bytes)", "Total (Bytes)",
+	"Total (MB)", "Data Free (Bytes)", "Fragmentation (%)", "Auto Increment", "Auto Increment Max",
+	"Auto Increment Usage (%)", "Charset", "Collation", "Create Time", "Update Time",
+}
+
+// ValidateExportPath memastikan ekstensi file export didukung.
+func ValidateExportPath(path string) error {
+	ext := strings.ToLower(filepath.Ext(strings.TrimSpace(path)))
+	for _, f := range dbscanmodel.ExportFormats {
+		if ext == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("format export tidak didukung: %q (gunakan %s)", path, strings.Join(dbscanmodel.ExportFormats, ", "))
+}
+
+// NewScanReport menyusun ScanReport dari hasil scan; detail diurutkan berdasarkan nama database.
+func NewScanReport(profileName, mode string, result *dbscanmodel.ScanResult, detailsMap map[string]dbscanmodel.DatabaseDetailInfo) dbscanmodel.ScanReport {
+	details := make([]dbscanmodel.DatabaseDetailInfo, 0, len(detailsMap))
+	for _, d := range detailsMap {
+		details = append(details, d)
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].DatabaseName < details[j].DatabaseName })
+
+	if result == nil {
+		result = &dbscanmodel.ScanResult{}
+	}
+	return dbscanmodel.ScanReport{
+		ProfileName: profileName,
+		Mode:        mode,
+		Result:      result,
+		Details:     details,
+	}
+}
+
+// ExportScanReport menulis report ke path sesuai ekstensi (.xlsx, .csv, .json).
+// Untuk CSV, ringkasan ditulis ke file terpisah dengan suffix ".summary.csv"
+// (dan metrik per tabel ke ".tables.csv" bila deep scan aktif).
+// Mengembalikan daftar file yang ditulis.
+func ExportScanReport(path string, report dbscanmodel.ScanReport) ([]string, error) {
+	if err := ValidateExportPath(path); err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xlsx":
+		return []string{path}, exportXLSX(path, report)
+	case ".csv":
+		return exportCSV(path, report)
+	default:
+		return []string{path}, exportJSON(path, report)
+	}
+}
+
+// summaryRows mengembalikan pasangan (metrik, nilai) untuk sheet ringkasan.
+func summaryRows(report dbscanmodel.ScanReport) [][]interface{} {
+	r := report.Result
+	var totalSize int64
+	for _, d := range report.Details {
+		totalSize += d.SizeBytes
+	}
+
+	scanTime := ""
+	if !r.StartedAt.IsZero() {
+		scanTime = r.StartedAt.Format(exportTimeLayout)
+	}
+
+	return [][]interface{}{
+		{"Profile", report.ProfileName},
+		{"Server Host", r.ServerHost},
+		{"Server Port", r.ServerPort},
+		{"Mode", report.Mode},
+		{"Scan Time", scanTime},
+		{"Scan ID", r.ScanID},
+		{"Total Database", r.TotalDatabases},
+		{"Berhasil", r.SuccessCount},
+		{"Gagal", r.FailedCount},
+		{"Total Size (Bytes)", totalSize},
+		{"Total Size (MB)", bytesToMB(totalSize)},
+		{"Durasi", r.Duration},
+		{"Errors", strings.Join(r.Errors, "; ")},
+	}
+}
+
+// detailRow mengembalikan satu baris detail dengan kolom numerik bertipe angka.
+func detailRow(report dbscanmodel.ScanReport, d dbscanmodel.DatabaseDetailInfo) []interface{} {
+	scanTime := ""
+	if !report.Result.StartedAt.IsZero() {
+		scanTime = report.Result.StartedAt.Format(exportTimeLayout)
+	}
+	return []interface{}{
+		report.ProfileName, report.Result.ServerHost, report.Result.ServerPort, scanTime, d.DatabaseName,
+		d.SizeBytes, bytesToMB(d.SizeBytes), d.SizeHuman, d.TableCount, d.ProcedureCount, d.FunctionCount,
+		d.ViewCount, d.UserGrantCount, d.CollectionTime, d.Error,
+	}
+}
+
+// reportTables mengembalikan semua tabel hasil deep scan, diurutkan per database lalu nama tabel.
+func reportTables(report dbscanmodel.ScanReport) []dbscanmodel.TableDetailInfo {
+	var tables []dbscanmodel.TableDetailInfo
+	for _, d := range report.Details {
+		sorted := append([]dbscanmodel.TableDetailInfo(nil), d.Tables...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].TableName < sorted[j].TableName })
+		tables = append(tables, sorted...)
+	}
+	return tables
+}
+
+// tableRow mengembalikan satu baris metrik tabel dengan kolom numerik bertipe angka.
+func tableRow(t dbscanmodel.TableDetailInfo) []interface{} {
+	createTime, updateTime := "", ""
+	if t.CreateTime != nil {
+		createTime = t.CreateTime.Format(exportTimeLayout)
+	}
+	if t.UpdateTime != nil {
+		updateTime = t.UpdateTime.Format(exportTimeLayout)
+	}
+	return []interface{}{
+		t.DatabaseName, t.TableName, t.Engine, t.RowEstimate, t.DataBytes, t.IndexBytes, t.TotalBytes,
+		bytesToMB(t.TotalBytes), t.DataFreeBytes, t.FragmentationPercent, t.AutoIncrement, t.AutoIncrementMax,
+		t.AutoIncrementUsage, t.Charset, t.Collation, createTime, updateTime,
+	}
+}
+
+// detailRows mengembalikan baris detail untuk semua database pada report.
+func detailRows(report dbscanmodel.ScanReport) [][]interface{} {
+	rows := make([][]interface{}, 0, len(report.Details))
+	for _, d := range report.Details {
+		rows = append(rows, detailRow(report, d))
+	}
+	return rows
+}
+
+// tableRows mengembalikan baris metrik untuk daftar tabel.
+func tableRows(tables []dbscanmodel.TableDetailInfo) [][]interface{} {
+	rows := make([][]interface{}, 0, len(tables))
+	for _, t := range tables {
+		rows = append(rows, tableRow(t))
+	}
+	return rows
+}
+
+func bytesToMB(b int64) float64 {
+	return math.Round(float64(b)/1024/1024*100) / 100
+}
+
+func exportXLSX(path string, report dbscanmodel.ScanReport) error {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+
+	if err := f.SetSheetName("Sheet1", exportSheetSummary); err != nil {
+		return fmt.Errorf("gagal membuat sheet %s: %w", exportSheetSummary, err)
+	}
+	if err := sheetx.SetSheetRows(f, exportSheetSummary, []string{"Metrik", "Nilai"}, summaryRows(report)); err != nil {
+		return err
+	}
+	_ = f.SetColWidth(exportSheetSummary, "A", "A", 22)
+	_ = f.SetColWidth(exportSheetSummary, "B", "B", 48)
+
+	if _, err := f.NewSheet(exportSheetDetails); err != nil {
+		return fmt.Errorf("gagal membuat sheet %s: %w", exportSheetDetails, err)
+	}
+	if err := sheetx.SetSheetRows(f, exportSheetDetails, exportDetailHeaders, detailRows(report)); err != nil {
+		return err
+	}
+	_ = f.SetColWidth(exportSheetDetails, "A", "E", 18)
+
+	if tables := reportTables(report); len(tables) > 0 {
+		if err := writeTablesSheet(f, tables); err != nil {
+			return err
+		}
+	}
+	return sheetx.SaveXLSX(path, f, "")
+}
+
+// writeTablesSheet menambahkan sheet metrik per tabel (deep scan) ke workbook.
+func writeTablesSheet(f *excelize.File, tables []dbscanmodel.TableDetailInfo) error {
+	if _, err := f.NewSheet(exportSheetTables); err != nil {
+		return fmt.Errorf("gagal membuat sheet %s: %w", exportSheetTables, err)
+	}
+	if err := sheetx.SetSheetRows(f, exportSheetTables, exportTableHeaders, tableRows(tables)); err != nil {
+		return err
+	}
+	_ = f.SetColWidth(exportSheetTables, "A", "C", 20)
+	return nil
+}
+
+func exportCSV(path string, report dbscanmodel.ScanReport) ([]string, error) {
+	details, err := sheetx.BuildCSV(exportDetailHeaders, detailRows(report))
+	if err != nil {
+		return nil, err
+	}
+	summary, err := sheetx.BuildCSV([]string{"Metrik", "Nilai"}, summaryRows(report))
+	if err != nil {
+		return nil, fmt.Errorf("summary: %w", err)
+	}
+
+	summaryPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".summary.csv"
+	if err := fsops.WriteFile(path, details); err != nil {
+		return nil, fmt.Errorf("gagal menulis file export %s: %w", path, err)
+	}
+	if err := fsops.WriteFile(summaryPath, summary); err != nil {
+		return []string{path}, fmt.Errorf("gagal menulis file export %s: %w", summaryPath, err)
+	}
+	files := []string{path, summaryPath}
+
+	// Deep scan: metrik per tabel ditulis ke <nama>.tables.csv
+	if tables := reportTables(report); len(tables) > 0 {
+		data, err := sheetx.BuildCSV(exportTableHeaders, tableRows(tables))
+		if err != nil {
+			return files, fmt.Errorf("tables: %w", err)
+		}
+		tablesPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".tables.csv"
+		if err := fsops.WriteFile(tablesPath, data); err != nil {
+			return files, fmt.Errorf("gagal menulis file export %s: %w", tablesPath, err)
+		}
+		files = append(files, tablesPath)
+	}
+	return files, nil
+}
+
+// exportJSONSummary adalah bentuk ringkasan pada export JSON.
+type exportJSONSummary struct {
+	Profile        string   `json:"profile"`
+	ServerHost     string   `json:"server_host"`
+	ServerPort     int      `json:"server_port"`
+	Mode           string   `json:"mode"`
+	ScanTime       string   `json:"scan_time"`
+	ScanID         string   `json:"scan_id,omitempty"`
+	TotalDatabases int      `json:"total_databases"`
+	SuccessCount   int      `json:"success_count"`
+	FailedCount    int      `json:"failed_count"`
+	TotalSizeBytes int64    `json:"total_size_bytes"`
+	Duration       string   `json:"duration"`
+	Errors         []string `json:"errors,omitempty"`
+}
+
+func exportJSON(path string, report dbscanmodel.ScanReport) error {
+	r := report.Result
+	summary := exportJSONSummary{
+		Profile:        report.ProfileName,
+		ServerHost:     r.ServerHost,
+		ServerPort:     r.ServerPort,
+		Mode:           report.Mode,
+		ScanID:         r.ScanID,
+		TotalDatabases: r.TotalDatabases,
+		SuccessCount:   r.SuccessCount,
+		FailedCount:    r.FailedCount,
+		Duration:       r.Duration,
+		Errors:         r.Errors,
+	}
+	if !r.StartedAt.IsZero() {
+		summary.ScanTime = r.StartedAt.Format(exportTimeLayout)
+	}
+	for _, d := range report.Details {
+		summary.TotalSizeBytes += d.SizeBytes
+	}
+
+	payload := struct {
+		Summary   exportJSONSummary                `json:"summary"`
+		Databases []dbscanmodel.DatabaseDetailInfo `json:"databases"`
+	}{Summary: summary, Databases: report.Details}
+
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return fmt.Errorf("gagal membentuk JSON: %w", err)
+	}
+	if err := fsops.WriteFile(path, data); err != nil {
+		return fmt.Errorf("gagal menulis file export %s: %w", path, err)
+	}
+	return nil
+}
+
+// ExportTable menulis satu tabel (header + baris) ke .xlsx, .csv, atau .json.
+// Dipakai untuk output yang tidak berbentuk ScanReport (mis. trend/forecast).
+func ExportTable(path, sheetName string, headers []string, rows [][]interface{}) error {
+	if err := ValidateExportPath(path); err != nil {
+		return err
+	}
+
+	var data []byte
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xlsx":
+		return sheetx.WriteXLSX(path, sheetName, headers, rows, "")
+	case ".csv":
+		out, err := sheetx.BuildCSV(headers, rows)
+		if err != nil {
+			return err
+		}
+		data = out
+	default:
+		items := make([]map[string]interface{}, 0, len(rows))
+		for _, row := range rows {
+			item := make(map[string]interface{}, len(headers))
+			for i, h := range headers {
+				if i < len(row) {
+					item[h] = row[i]
+				}
+			}
+			items = append(items, item)
+		}
+		out, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return fmt.Errorf("gagal membentuk JSON: %w", err)
+		}
+		data = out
+	}
+
+	if err := fsops.WriteFile(path, data); err != nil {
+		return fmt.Errorf("gagal menulis file export %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package helpers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func testScanReport() dbscanmodel.ScanReport {
+	result := &dbscanmodel.ScanResult{
+		ServerHost:     "10.0.0.5",
+		ServerPort:     3306,
+		TotalDatabases: 2,
+		SuccessCount:   2,
+		StartedAt:      time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC),
+	}
+	details := map[string]dbscanmodel.DatabaseDetailInfo{
+		"billing": {DatabaseName: "billing", SizeBytes: 3 << 20, TableCount: 4},
+		"app":     {DatabaseName: "app", SizeBytes: 1 << 20, TableCount: 2},
+	}
+	return NewScanReport("prod", "all", result, details)
+}
+
+func TestNewScanReportSortsDetails(t *testing.T) {
+	report := testScanReport()
+	if len(report.Details) != 2 || report.Details[0].DatabaseName != "app" || report.Details[1].DatabaseName != "billing" {
+		t.Fatalf("Details = %+v, want app, billing", report.Details)
+	}
+	if empty := NewScanReport("prod", "all", nil, nil); empty.Result == nil {
+		t.Fatalf("NewScanReport(nil result) Result = nil")
+	}
+}
+
+func TestExportScanReport(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		wantFiles []string
+		wantErr   bool
+		check     func(t *testing.T, dir string)
+	}{
+		{
+			name:      "csv writes details and summary",
+			file:      "scan.csv",
+			wantFiles: []string{"scan.csv", "scan.summary.csv"},
+			check: func(t *testing.T, dir string) {
+				rows := readCSV(t, filepath.Join(dir, "scan.csv"))
+				if len(rows) != 3 || rows[0][0] != "Profile" || !slices.Contains(rows[1], "app") || !slices.Contains(rows[2], "billing") {
+					t.Fatalf("detail CSV = %v", rows)
+				}
+				summary := readCSV(t, filepath.Join(dir, "scan.summary.csv"))
+				if len(summary) < 2 || summary[1][0] != "Profile" || summary[1][1] != "prod" {
+					t.Fatalf("summary CSV = %v", summary)
+				}
+			},
+		},
+		{
+			name:      "json writes summary and databases",
+			file:      "scan.json",
+			wantFiles: []string{"scan.json"},
+			check: func(t *testing.T, dir string) {
+				data, err := os.ReadFile(filepath.Join(dir, "scan.json"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				var payload struct {
+					Summary struct {
+						Profile        string `json:"profile"`
+						TotalSizeBytes int64  `json:"total_size_bytes"`
+					} `json:"summary"`
+					Databases []dbscanmodel.DatabaseDetailInfo `json:"databases"`
+				}
+				if err := json.Unmarshal(data, &payload); err != nil {
+					t.Fatalf("invalid JSON: %v", err)
+				}
+				if payload.Summary.Profile != "prod" || payload.Summary.TotalSizeBytes != 4<<20 || len(payload.Databases) != 2 {
+					t.Fatalf("JSON payload = %+v", payload)
+				}
+			},
+		},
+		{
+			name:      "xlsx writes summary and details sheets",
+			file:      "scan.xlsx",
+			wantFiles: []string{"scan.xlsx"},
+			check: func(t *testing.T, dir string) {
+				f, err := excelize.OpenFile(filepath.Join(dir, "scan.xlsx"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer f.Close()
+				sheets := f.GetSheetList()
+				if !slices.Contains(sheets, exportSheetSummary) || !slices.Contains(sheets, exportSheetDetails) {
+					t.Fatalf("sheets = %v", sheets)
+				}
+				rows, err := f.GetRows(exportSheetDetails)
+				if err != nil || len(rows) != 3 {
+					t.Fatalf("details rows = %d (%v)", len(rows), err)
+				}
+			},
+		},
+		{
+			name:    "unsupported extension",
+			file:    "scan.txt",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files, err := ExportScanReport(filepath.Join(dir, tt.file), testScanReport())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExportScanReport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var names []string
+			for _, f := range files {
+				names = append(names, filepath.Base(f))
+			}
+			if !slices.Equal(names, tt.wantFiles) {
+				t.Fatalf("files = %v, want %v", names, tt.wantFiles)
+			}
+			tt.check(t, dir)
+		})
+	}
+}
+
+func readCSV(t *testing.T, path string) [][]string {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV %s: %v", path, err)
+	}
+	return rows
+}
@@ -0,0 +1,186 @@
+// File : internal/app/dbscan/helpers/history.go
+// Deskripsi : Penyimpanan histori snapshot hasil scan lokal (JSON Lines per profile)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package helpers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/shared/fsops"
+)
+
+// historyFileExt adalah ekstensi file histori per profile.
+const historyFileExt = ".jsonl"
+
+// NewScanSnapshot menyusun snapshot dari hasil scan untuk disimpan ke histori.
+func NewScanSnapshot(profileName, mode string, result *dbscanmodel.ScanResult, detailsMap map[string]dbscanmodel.DatabaseDetailInfo) dbscanmodel.ScanSnapshot {
+	snap := dbscanmodel.ScanSnapshot{
+		ScanTime: time.Now(),
+		Profile:  profileName,
+		Mode:     mode,
+	}
+	if result != nil {
+		snap.ServerHost = result.ServerHost
+		snap.ServerPort = result.ServerPort
+		snap.ScanID = result.ScanID
+		if !result.StartedAt.IsZero() {
+			snap.ScanTime = result.StartedAt
+		}
+	}
+
+	names := make([]string, 0, len(detailsMap))
+	for name := range detailsMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		d := detailsMap[name]
+		// Database yang gagal di-collect tidak dicatat agar tidak terbaca sebagai penyusutan.
+		if d.Error != "" {
+			continue
+		}
+		snap.Databases = append(snap.Databases, dbscanmodel.SnapshotDatabase{
+			Name:       d.DatabaseName,
+			SizeBytes:  d.SizeBytes,
+			TableCount: d.TableCount,
+			ViewCount:  d.ViewCount,
+		})
+		snap.TotalSizeBytes += d.SizeBytes
+	}
+	return snap
+}
+
+// StatVolume mengisi informasi kapasitas volume dari path lokal (mis. datadir).
+func StatVolume(path string) (total, free uint64, err error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, 0, err
+	}
+	return st.Blocks * uint64(st.Bsize), st.Bavail * uint64(st.Bsize), nil
+}
+
+// historyFileName mengembalikan nama file histori yang aman untuk sebuah profile.
+func historyFileName(profile string) string {
+	name := strings.TrimSpace(profile)
+	if name == "" {
+		name = "default"
+	}
+	name = strings.NewReplacer("/", "_", "\\", "_", "..", "_", " ", "_").Replace(name)
+	return name + historyFileExt
+}
+
+// AppendSnapshot menambahkan snapshot ke file histori profile.
+// Jika retentionDays > 0, snapshot yang lebih tua dari batas tersebut dibuang.
+func AppendSnapshot(dir string, snap dbscanmodel.ScanSnapshot, retentionDays int) (string, error) {
+	if strings.TrimSpace(dir) == "" {
+		return "", fmt.Errorf("direktori histori scan kosong")
+	}
+	path := filepath.Join(dir, historyFileName(snap.Profile))
+
+	existing, err := readSnapshotFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	cutoff := time.Time{}
+	if retentionDays > 0 {
+		cutoff = time.Now().AddDate(0, 0, -retentionDays)
+	}
+
+	var buf bytes.Buffer
+	for _, s := range append(existing, snap) {
+		if !cutoff.IsZero() && s.ScanTime.Before(cutoff) {
+			continue
+		}
+		line, err := json.Marshal(s)
+		if err != nil {
+			return "", fmt.Errorf("gagal encode snapshot: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	if err := fsops.WriteFile(path, buf.Bytes()); err != nil {
+		return "", fmt.Errorf("gagal menulis histori scan %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// LoadSnapshots membaca snapshot dari direktori histori.
+// profile kosong berarti semua profile. Snapshot lebih tua dari since diabaikan (since zero = semua).
+// Hasil diurutkan berdasarkan waktu scan.
+func LoadSnapshots(dir, profile string, since time.Time) ([]dbscanmodel.ScanSnapshot, error) {
+	var files []string
+	if strings.TrimSpace(profile) != "" {
+		files = []string{filepath.Join(dir, historyFileName(profile))}
+	} else {
+		matches, err := filepath.Glob(filepath.Join(dir, "*"+historyFileExt))
+		if err != nil {
+			return nil, err
+		}
+		files = matches
+	}
+
+	var out []dbscanmodel.ScanSnapshot
+	for _, f := range files {
+		snaps, err := readSnapshotFile(f)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		for _, s := range snaps {
+			if !since.IsZero() && s.ScanTime.Before(since) {
+				continue
+			}
+			out = append(out, s)
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].ScanTime.Before(out[j].ScanTime) })
+	return out, nil
+}
+
+func readSnapshotFile(path string) ([]dbscanmodel.ScanSnapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var out []dbscanmodel.ScanSnapshot
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var s dbscanmodel.ScanSnapshot
+		if err := json.Unmarshal(line, &s); err != nil {
+			return nil, fmt.Errorf("histori scan %s baris %d tidak valid: %w", path, lineNo, err)
+		}
+		out = append(out, s)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("gagal membaca histori scan %s: %w", path, err)
+	}
+	return out, nil
+}
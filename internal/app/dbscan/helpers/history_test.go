@@ -0,0 +1,124 @@
+package helpers
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+)
+
+func TestHistoryFileName(t *testing.T) {
+	tests := []struct {
+		profile string
+		want    string
+	}{
+		{profile: "prod-db", want: "prod-db.jsonl"},
+		{profile: "  ", want: "default.jsonl"},
+		{profile: "../etc/passwd", want: "__etc_passwd.jsonl"},
+		{profile: "tim a", want: "tim_a.jsonl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.profile, func(t *testing.T) {
+			if got := historyFileName(tt.profile); got != tt.want {
+				t.Fatalf("historyFileName(%q) = %q, want %q", tt.profile, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendAndLoadSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now().UTC().Truncate(time.Second)
+	snaps := []dbscanmodel.ScanSnapshot{
+		{Profile: "prod", Mode: "all", ScanTime: now.AddDate(0, 0, -40), TotalSizeBytes: 100},
+		{Profile: "prod", Mode: "all", ScanTime: now.AddDate(0, 0, -5), TotalSizeBytes: 200},
+		{Profile: "stage", Mode: "all", ScanTime: now.AddDate(0, 0, -3), TotalSizeBytes: 50},
+		{Profile: "prod", Mode: "all", ScanTime: now.AddDate(0, 0, -1), TotalSizeBytes: 300},
+	}
+	for _, s := range snaps {
+		if _, err := AppendSnapshot(dir, s, 30); err != nil {
+			t.Fatalf("AppendSnapshot() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		profile string
+		since   time.Time
+		want    []int64
+	}{
+		{name: "retention drops old snapshot", profile: "prod", want: []int64{200, 300}},
+		{name: "since filter", profile: "prod", since: now.AddDate(0, 0, -2), want: []int64{300}},
+		{name: "all profiles sorted by time", want: []int64{200, 50, 300}},
+		{name: "unknown profile", profile: "missing", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadSnapshots(dir, tt.profile, tt.since)
+			if err != nil {
+				t.Fatalf("LoadSnapshots() error = %v", err)
+			}
+			var sizes []int64
+			for _, s := range got {
+				sizes = append(sizes, s.TotalSizeBytes)
+			}
+			if len(sizes) != len(tt.want) {
+				t.Fatalf("sizes = %v, want %v", sizes, tt.want)
+			}
+			for i := range sizes {
+				if sizes[i] != tt.want[i] {
+					t.Fatalf("sizes = %v, want %v", sizes, tt.want)
+				}
+			}
+		})
+	}
+
+	if _, err := AppendSnapshot(" ", snaps[0], 0); err == nil {
+		t.Fatalf("AppendSnapshot() tanpa direktori harus error")
+	}
+}
+
+func TestGrowthStatsAndAnomalies(t *testing.T) {
+	base := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := func(day int, dbs ...dbscanmodel.SnapshotDatabase) dbscanmodel.ScanSnapshot {
+		return dbscanmodel.ScanSnapshot{Profile: "prod", Mode: "all", ScanTime: base.AddDate(0, 0, day), Databases: dbs}
+	}
+	db := func(name string, size int64) dbscanmodel.SnapshotDatabase {
+		return dbscanmodel.SnapshotDatabase{Name: name, SizeBytes: size}
+	}
+	snaps := []dbscanmodel.ScanSnapshot{
+		snapshot(0, db("billing", 10<<20), db("logs", 10<<20)),
+		snapshot(1, db("billing", 11<<20), db("logs", 40<<20)),
+		snapshot(2, db("billing", 12<<20), db("logs", 4<<20)),
+	}
+
+	series := DatabaseSeries(snaps, "")
+	if len(series) != 2 {
+		t.Fatalf("DatabaseSeries() = %d seri, want 2", len(series))
+	}
+	if only := DatabaseSeries(snaps, "billing"); len(only) != 1 {
+		t.Fatalf("DatabaseSeries(billing) = %d seri, want 1", len(only))
+	}
+
+	stats := GrowthStats(series)
+	if len(stats) != 2 || stats[0].Database != "billing" {
+		t.Fatalf("GrowthStats() = %+v, want billing first", stats)
+	}
+	if math.Abs(stats[0].BytesPerDay-float64(1<<20)) > 1 {
+		t.Fatalf("billing BytesPerDay = %v, want %v", stats[0].BytesPerDay, float64(1<<20))
+	}
+	if math.Abs(stats[0].GrowthPercent-20) > 0.001 {
+		t.Fatalf("billing GrowthPercent = %v, want 20", stats[0].GrowthPercent)
+	}
+
+	anomalies := DetectAnomalies(series, 3)
+	if len(anomalies) != 2 {
+		t.Fatalf("DetectAnomalies() = %+v, want 2 anomali", anomalies)
+	}
+	if anomalies[0].Kind != AnomalyShrink || anomalies[1].Kind != AnomalyJump {
+		t.Fatalf("DetectAnomalies() kinds = %s, %s, want shrink, jump", anomalies[0].Kind, anomalies[1].Kind)
+	}
+}
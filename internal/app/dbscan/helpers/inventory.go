@@ -0,0 +1,316 @@
+// File : internal/app/dbscan/helpers/inventory.go
+// Deskripsi : Penyimpanan hasil scanning ke database inventory pusat (schema, migrasi, upsert)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/database"
+)
+
+// Status scan run di tabel scan_runs.
+const (
+	ScanRunStatusRunning = "running"
+	ScanRunStatusSuccess = "success"
+	ScanRunStatusFailed  = "failed"
+)
+
+// inventoryMigration adalah satu langkah migrasi schema inventory.
+// Versi harus naik berurutan; migrasi yang sudah tercatat tidak dijalankan ulang.
+type inventoryMigration struct {
+	Version     int
+	Description string
+	Statements  []string
+}
+
+// inventoryMigrations berisi seluruh migrasi schema inventory.
+// Tambahkan migrasi baru di akhir slice (jangan ubah migrasi lama).
+var inventoryMigrations = []inventoryMigration{
+	{
+		Version:     1,
+		Description: "create scan_runs dan database_details",
+		Statements: []string{
+			`CREATE TABLE IF NOT EXISTS scan_runs (
+				scan_id VARCHAR(64) NOT NULL,
+				profile_name VARCHAR(255) NOT NULL DEFAULT '',
+				server_host VARCHAR(255) NOT NULL,
+				server_port INT NOT NULL,
+				scan_mode VARCHAR(32) NOT NULL,
+				status VARCHAR(16) NOT NULL,
+				total_databases INT NOT NULL DEFAULT 0,
+				success_count INT NOT NULL DEFAULT 0,
+				failed_count INT NOT NULL DEFAULT 0,
+				started_at DATETIME NOT NULL,
+				finished_at DATETIME NULL,
+				PRIMARY KEY (scan_id),
+				KEY idx_scan_runs_server (server_host, server_port, started_at)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+			`CREATE TABLE IF NOT EXISTS database_details (
+				server_host VARCHAR(255) NOT NULL,
+				server_port INT NOT NULL,
+				database_name VARCHAR(64) NOT NULL,
+				size_bytes BIGINT NOT NULL DEFAULT 0,
+				size_human VARCHAR(32) NOT NULL DEFAULT '',
+				table_count INT NOT NULL DEFAULT 0,
+				procedure_count INT NOT NULL DEFAULT 0,
+				function_count INT NOT NULL DEFAULT 0,
+				view_count INT NOT NULL DEFAULT 0,
+				user_grant_count INT NOT NULL DEFAULT 0,
+				collection_time DATETIME NOT NULL,
+				error_message TEXT NULL,
+				last_scan_id VARCHAR(64) NOT NULL,
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+				PRIMARY KEY (server_host, server_port, database_name),
+				KEY idx_database_details_scan (last_scan_id),
+				KEY idx_database_details_name (database_name)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		},
+	},
+	{
+		Version:     2,
+		Description: "create database_detail_history",
+		Statements: []string{
+			`CREATE TABLE IF NOT EXISTS database_detail_history (
+				scan_id VARCHAR(64) NOT NULL,
+				server_host VARCHAR(255) NOT NULL,
+				server_port INT NOT NULL,
+				database_name VARCHAR(64) NOT NULL,
+				size_bytes BIGINT NOT NULL DEFAULT 0,
+				table_count INT NOT NULL DEFAULT 0,
+				procedure_count INT NOT NULL DEFAULT 0,
+				function_count INT NOT NULL DEFAULT 0,
+				view_count INT NOT NULL DEFAULT 0,
+				user_grant_count INT NOT NULL DEFAULT 0,
+				collection_time DATETIME NOT NULL,
+				error_message TEXT NULL,
+				PRIMARY KEY (scan_id, server_host, server_port, database_name),
+				KEY idx_history_database (server_host, server_port, database_name, collection_time)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		},
+	},
+}
+
+// InventoryStore menyimpan hasil scan ke database inventory pada server pusat.
+type InventoryStore struct {
+	client   *database.Client
+	database string
+}
+
+// ScanRunInfo berisi identitas satu kali eksekusi scan.
+type ScanRunInfo struct {
+	ScanID      string
+	ProfileName string
+	ServerHost  string
+	ServerPort  int
+	Mode        string
+	Total       int
+	StartedAt   time.Time
+}
+
+// NewInventoryStore membuat store inventory. Database belum dibuat sampai EnsureSchema dipanggil.
+func NewInventoryStore(client *database.Client, dbName string) *InventoryStore {
+	return &InventoryStore{client: client, database: dbName}
+}
+
+// Database mengembalikan nama database inventory.
+func (s *InventoryStore) Database() string {
+	return s.database
+}
+
+// table mengembalikan nama tabel yang sudah di-qualify dengan nama database inventory.
+func (s *InventoryStore) table(name string) string {
+	return fmt.Sprintf("`%s`.`%s`", strings.ReplaceAll(s.database, "`", "``"), name)
+}
+
+// EnsureSchema membuat database inventory (jika belum ada) lalu menjalankan migrasi yang belum tercatat.
+// Mengembalikan versi schema setelah migrasi.
+func (s *InventoryStore) EnsureSchema(ctx context.Context) (int, error) {
+	if err := s.client.CreateDatabaseIfNotExists(ctx, s.database); err != nil {
+		return 0, fmt.Errorf("gagal membuat database inventory %s: %w", s.database, err)
+	}
+
+	createMigrations := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		version INT NOT NULL,
+		description VARCHAR(255) NOT NULL,
+		applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (version)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`, s.table("schema_migrations"))
+	if _, err := s.client.ExecContextWithRetry(ctx, createMigrations); err != nil {
+		return 0, fmt.Errorf("gagal membuat tabel schema_migrations: %w", err)
+	}
+
+	var current sql.NullInt64
+	if err := s.client.DB().QueryRowContext(ctx, fmt.Sprintf("SELECT MAX(version) FROM %s", s.table("schema_migrations"))).Scan(&current); err != nil {
+		return 0, fmt.Errorf("gagal membaca versi schema inventory: %w", err)
+	}
+
+	version := int(current.Int64)
+	for _, m := range inventoryMigrations {
+		if m.Version <= version {
+			continue
+		}
+		if err := s.applyMigration(ctx, m); err != nil {
+			return version, err
+		}
+		version = m.Version
+	}
+
+	return version, nil
+}
+
+// applyMigration menjalankan statement migrasi di database inventory lalu mencatat versinya.
+// DDL MySQL/MariaDB bersifat auto-commit, sehingga statement ditulis idempotent (IF NOT EXISTS).
+func (s *InventoryStore) applyMigration(ctx context.Context, m inventoryMigration) error {
+	conn, err := s.client.DB().Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal membuka koneksi migrasi: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("USE `%s`", strings.ReplaceAll(s.database, "`", "``"))); err != nil {
+		return fmt.Errorf("gagal memilih database inventory: %w", err)
+	}
+
+	for _, stmt := range m.Statements {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("migrasi inventory v%d (%s) gagal: %w", m.Version, m.Description, err)
+		}
+	}
+
+	if _, err := conn.ExecContext(ctx, "INSERT INTO schema_migrations (version, description) VALUES (?, ?)", m.Version, m.Description); err != nil {
+		return fmt.Errorf("gagal mencatat migrasi inventory v%d: %w", m.Version, err)
+	}
+	return nil
+}
+
+// StartRun mencatat scan run baru dengan status running.
+func (s *InventoryStore) StartRun(ctx context.Context, run ScanRunInfo) error {
+	query := fmt.Sprintf(`INSERT INTO %s
+		(scan_id, profile_name, server_host, server_port, scan_mode, status, total_databases, started_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, s.table("scan_runs"))
+	_, err := s.client.ExecContextWithRetry(ctx, query,
+		run.ScanID, run.ProfileName, run.ServerHost, run.ServerPort, run.Mode,
+		ScanRunStatusRunning, run.Total, run.StartedAt)
+	if err != nil {
+		return fmt.Errorf("gagal mencatat scan run %s: %w", run.ScanID, err)
+	}
+	return nil
+}
+
+// FinishRun memperbarui status dan ringkasan scan run.
+func (s *InventoryStore) FinishRun(ctx context.Context, scanID string, result *dbscanmodel.ScanResult, scanErr error) error {
+	status := ScanRunStatusSuccess
+	if scanErr != nil {
+		status = ScanRunStatusFailed
+	}
+
+	var total, success, failed int
+	if result != nil {
+		total = result.TotalDatabases
+		success = result.SuccessCount
+		failed = result.FailedCount
+	}
+
+	query := fmt.Sprintf(`UPDATE %s
+		SET status = ?, total_databases = ?, success_count = ?, failed_count = ?, finished_at = ?
+		WHERE scan_id = ?`, s.table("scan_runs"))
+	if _, err := s.client.ExecContextWithRetry(ctx, query, status, total, success, failed, time.Now(), scanID); err != nil {
+		return fmt.Errorf("gagal memperbarui scan run %s: %w", scanID, err)
+	}
+	return nil
+}
+
+// UpsertDetail menyimpan detail satu database (insert atau update per server+database)
+// dan menambahkan baris histori untuk scan run yang sedang berjalan.
+func (s *InventoryStore) UpsertDetail(ctx context.Context, run ScanRunInfo, info dbscanmodel.DatabaseDetailInfo) error {
+	detail := ToDatabaseDetail(info)
+
+	upsert := fmt.Sprintf(`INSERT INTO %s
+		(server_host, server_port, database_name, size_bytes, size_human, table_count, procedure_count,
+		 function_count, view_count, user_grant_count, collection_time, error_message, last_scan_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			size_bytes = VALUES(size_bytes),
+			size_human = VALUES(size_human),
+			table_count = VALUES(table_count),
+			procedure_count = VALUES(procedure_count),
+			function_count = VALUES(function_count),
+			view_count = VALUES(view_count),
+			user_grant_count = VALUES(user_grant_count),
+			collection_time = VALUES(collection_time),
+			error_message = VALUES(error_message),
+			last_scan_id = VALUES(last_scan_id)`, s.table("database_details"))
+	if _, err := s.client.ExecContextWithRetry(ctx, upsert,
+		run.ServerHost, run.ServerPort, detail.DatabaseName, detail.SizeBytes, detail.SizeHuman,
+		detail.TableCount, detail.ProcedureCount, detail.FunctionCount, detail.ViewCount,
+		detail.UserGrantCount, detail.CollectionTime, detail.ErrorMessage, run.ScanID); err != nil {
+		return fmt.Errorf("gagal upsert detail %s: %w", detail.DatabaseName, err)
+	}
+
+	history := fmt.Sprintf(`INSERT INTO %s
+		(scan_id, server_host, server_port, database_name, size_bytes, table_count, procedure_count,
+		 function_count, view_count, user_grant_count, collection_time, error_message)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			size_bytes = VALUES(size_bytes),
+			table_count = VALUES(table_count),
+			procedure_count = VALUES(procedure_count),
+			function_count = VALUES(function_count),
+			view_count = VALUES(view_count),
+			user_grant_count = VALUES(user_grant_count),
+			collection_time = VALUES(collection_time),
+			error_message = VALUES(error_message)`,
+		s.table("database_detail_history"))
+	if _, err := s.client.ExecContextWithRetry(ctx, history,
+		run.ScanID, run.ServerHost, run.ServerPort, detail.DatabaseName, detail.SizeBytes,
+		detail.TableCount, detail.ProcedureCount, detail.FunctionCount, detail.ViewCount,
+		detail.UserGrantCount, detail.CollectionTime, detail.ErrorMessage); err != nil {
+		return fmt.Errorf("gagal menyimpan histori %s: %w", detail.DatabaseName, err)
+	}
+
+	return nil
+}
+
+// ToDatabaseDetail mengonversi hasil collector ke model domain.DatabaseDetail (kolom inventory).
+func ToDatabaseDetail(info dbscanmodel.DatabaseDetailInfo) domain.DatabaseDetail {
+	collected, err := time.ParseInLocation("2006-01-02 15:04:05", info.CollectionTime, time.Local)
+	if err != nil {
+		collected = time.Now()
+	}
+
+	detail := domain.DatabaseDetail{
+		DatabaseName:   info.DatabaseName,
+		SizeBytes:      info.SizeBytes,
+		SizeHuman:      info.SizeHuman,
+		TableCount:     info.TableCount,
+		ProcedureCount: info.ProcedureCount,
+		FunctionCount:  info.FunctionCount,
+		ViewCount:      info.ViewCount,
+		UserGrantCount: info.UserGrantCount,
+		CollectionTime: collected,
+	}
+	if info.Error != "" {
+		msg := info.Error
+		detail.ErrorMessage = &msg
+	}
+	return detail
+}
+
+// NewScanID membuat ID scan run yang unik per server dan waktu mulai.
+func NewScanID(serverHost string, startedAt time.Time) string {
+	host := strings.NewReplacer(".", "-", ":", "-", " ", "-").Replace(strings.TrimSpace(serverHost))
+	if len(host) > 32 {
+		host = host[:32]
+	}
+	return fmt.Sprintf("scan_%s_%s_%03d", host, startedAt.Format("20060102_150405"), startedAt.Nanosecond()/1e6)
+}
@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+)
+
+func TestInventoryMigrationsOrdered(t *testing.T) {
+	for i, m := range inventoryMigrations {
+		if m.Version != i+1 {
+			t.Fatalf("inventoryMigrations[%d].Version = %d, want %d", i, m.Version, i+1)
+		}
+		if len(m.Statements) == 0 {
+			t.Fatalf("inventoryMigrations[%d] tidak punya statement", i)
+		}
+	}
+}
+
+func TestInventoryStoreTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		database string
+		table    string
+		want     string
+	}{
+		{name: "default database", database: "sfdbtools_inventory", table: "scan_runs", want: "`sfdbtools_inventory`.`scan_runs`"},
+		{name: "backtick escaped", database: "inv`x", table: "database_details", want: "`inv``x`.`database_details`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewInventoryStore(nil, tt.database).table(tt.table); got != tt.want {
+				t.Fatalf("table() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewScanID(t *testing.T) {
+	startedAt := time.Date(2026, 10, 18, 9, 30, 15, 42*int(time.Millisecond), time.UTC)
+	tests := []struct {
+		name string
+		host string
+		want string
+	}{
+		{name: "ipv4", host: "10.0.0.5", want: "scan_10-0-0-5_20261018_093015_042"},
+		{name: "host with port separator", host: " db:3306 ", want: "scan_db-3306_20261018_093015_042"},
+		{name: "long host truncated", host: "a-very-long-hostname.example.internal.corp", want: "scan_a-very-long-hostname-example-int_20261018_093015_042"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewScanID(tt.host, startedAt); got != tt.want {
+				t.Fatalf("NewScanID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToDatabaseDetail(t *testing.T) {
+	tests := []struct {
+		name      string
+		info      dbscanmodel.DatabaseDetailInfo
+		wantError bool
+	}{
+		{
+			name: "success",
+			info: dbscanmodel.DatabaseDetailInfo{DatabaseName: "app", SizeBytes: 1024, TableCount: 3, CollectionTime: "2026-10-18 09:30:15"},
+		},
+		{
+			name:      "collector error is kept",
+			info:      dbscanmodel.DatabaseDetailInfo{DatabaseName: "broken", Error: "access denied", CollectionTime: "2026-10-18 09:30:15"},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToDatabaseDetail(tt.info)
+			if got.DatabaseName != tt.info.DatabaseName || got.SizeBytes != tt.info.SizeBytes || got.TableCount != tt.info.TableCount {
+				t.Fatalf("ToDatabaseDetail() = %+v, want fields from %+v", got, tt.info)
+			}
+			if want := time.Date(2026, 10, 18, 9, 30, 15, 0, time.Local); !got.CollectionTime.Equal(want) {
+				t.Fatalf("CollectionTime = %v, want %v", got.CollectionTime, want)
+			}
+			if (got.ErrorMessage != nil) != tt.wantError {
+				t.Fatalf("ErrorMessage = %v, wantError %v", got.ErrorMessage, tt.wantError)
+			}
+			if tt.wantError && *got.ErrorMessage != tt.info.Error {
+				t.Fatalf("ErrorMessage = %q, want %q", *got.ErrorMessage, tt.info.Error)
+			}
+		})
+	}
+}
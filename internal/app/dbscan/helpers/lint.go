@@ -0,0 +1,215 @@
+// File : internal/app/dbscan/helpers/lint.go
+// Deskripsi : Framework rule schema lint (registry, seleksi rule, eksekusi concurrent per database)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	applog "sfdbtools/internal/services/log"
+	"sfdbtools/internal/shared/database"
+)
+
+// LintRule adalah kontrak rule lint. Rule baru cukup mengimplementasikan interface ini
+// lalu didaftarkan dengan RegisterLintRule (lihat lint_rules.go).
+type LintRule interface {
+	// Name adalah identitas rule (dipakai di config dbscan.lint.rules dan flag --rules).
+	Name() string
+	// Description penjelasan singkat rule untuk --list-rules.
+	Description() string
+	// Check menjalankan rule terhadap satu database.
+	Check(ctx context.Context, client *database.Client, dbName string, cfg LintRuleConfig) ([]dbscanmodel.LintFinding, error)
+}
+
+// LintRuleConfig berisi parameter rule yang bisa diatur dari config.
+type LintRuleConfig struct {
+	AutoIncrementWarnPercent  float64
+	AutoIncrementErrorPercent float64
+}
+
+var (
+	lintRegistryMu sync.RWMutex
+	lintRegistry   []LintRule
+)
+
+// RegisterLintRule mendaftarkan rule ke registry. Nama rule harus unik.
+func RegisterLintRule(rule LintRule) {
+	lintRegistryMu.Lock()
+	defer lintRegistryMu.Unlock()
+	for _, r := range lintRegistry {
+		if r.Name() == rule.Name() {
+			panic(fmt.Sprintf("lint rule %q sudah terdaftar", rule.Name()))
+		}
+	}
+	lintRegistry = append(lintRegistry, rule)
+}
+
+// LintRules mengembalikan semua rule terdaftar, diurutkan berdasarkan nama.
+func LintRules() []LintRule {
+	lintRegistryMu.RLock()
+	defer lintRegistryMu.RUnlock()
+	out := append([]LintRule(nil), lintRegistry...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Name() < out[j].Name() })
+	return out
+}
+
+// SelectLintRules memilih rule yang akan dijalankan.
+// Jika only tidak kosong, hanya rule tersebut yang dipakai (mengabaikan config).
+// Selain itu rule aktif kecuali di-set false pada config (enabled).
+func SelectLintRules(enabled map[string]bool, only []string) ([]LintRule, error) {
+	all := LintRules()
+	byName := make(map[string]LintRule, len(all))
+	for _, r := range all {
+		byName[r.Name()] = r
+	}
+
+	for name := range enabled {
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("rule lint tidak dikenal di config: %s", name)
+		}
+	}
+
+	var selected []LintRule
+	if len(only) > 0 {
+		for _, name := range only {
+			r, ok := byName[strings.TrimSpace(name)]
+			if !ok {
+				return nil, fmt.Errorf("rule lint tidak dikenal: %s", name)
+			}
+			selected = append(selected, r)
+		}
+		return selected, nil
+	}
+
+	for _, r := range all {
+		if on, ok := enabled[r.Name()]; ok && !on {
+			continue
+		}
+		selected = append(selected, r)
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("semua rule lint dinonaktifkan di config")
+	}
+	return selected, nil
+}
+
+// lintJobResult adalah hasil lint satu database.
+type lintJobResult struct {
+	findings []dbscanmodel.LintFinding
+	errors   []string
+}
+
+// RunLint menjalankan rule terhadap setiap database secara concurrent (worker pool per database).
+// Error rule tidak menghentikan proses; dikembalikan sebagai daftar pesan.
+func RunLint(ctx context.Context, client *database.Client, dbNames []string, rules []LintRule, cfg LintRuleConfig, logger applog.Logger) ([]dbscanmodel.LintFinding, []string) {
+	const jobTimeout = 120 * time.Second
+
+	if len(dbNames) == 0 || len(rules) == 0 {
+		return nil, nil
+	}
+
+	maxWorkers := runtime.NumCPU()
+	if maxWorkers > len(dbNames) {
+		maxWorkers = len(dbNames)
+	}
+	logger.Infof("Menjalankan %d rule lint pada %d database... workers=%d", len(rules), len(dbNames), maxWorkers)
+
+	jobs := make(chan string)
+	results := make(chan lintJobResult, maxWorkers*2)
+
+	var wg sync.WaitGroup
+	for w := 0; w < maxWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for dbName := range jobs {
+				jobCtx, cancel := context.WithTimeout(ctx, jobTimeout)
+				var res lintJobResult
+				for _, rule := range rules {
+					findings, err := rule.Check(jobCtx, client, dbName, cfg)
+					if err != nil {
+						msg := fmt.Sprintf("%s/%s: %v", dbName, rule.Name(), err)
+						logger.Warnf("Rule lint gagal: %s", msg)
+						res.errors = append(res.errors, msg)
+						continue
+					}
+					res.findings = append(res.findings, findings...)
+				}
+				cancel()
+				results <- res
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, dbName := range dbNames {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- dbName:
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var findings []dbscanmodel.LintFinding
+	var errs []string
+	for res := range results {
+		findings = append(findings, res.findings...)
+		errs = append(errs, res.errors...)
+	}
+
+	SortLintFindings(findings)
+	sort.Strings(errs)
+	return findings, errs
+}
+
+// SortLintFindings mengurutkan temuan: severity tertinggi dulu, lalu database, tabel, rule.
+func SortLintFindings(findings []dbscanmodel.LintFinding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.Database != b.Database {
+			return a.Database < b.Database
+		}
+		if a.Table != b.Table {
+			return a.Table < b.Table
+		}
+		return a.Rule < b.Rule
+	})
+}
+
+// CountLintFindings menghitung jumlah temuan per severity.
+func CountLintFindings(findings []dbscanmodel.LintFinding) map[dbscanmodel.LintSeverity]int {
+	counts := make(map[dbscanmodel.LintSeverity]int)
+	for _, f := range findings {
+		counts[f.Severity]++
+	}
+	return counts
+}
+
+// quoteIdent meng-quote identifier MySQL dengan backtick.
+func quoteIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// qualifiedTable mengembalikan `db`.`table`.
+func qualifiedTable(dbName, table string) string {
+	return quoteIdent(dbName) + "." + quoteIdent(table)
+}
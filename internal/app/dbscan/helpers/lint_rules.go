@@ -0,0 +1,436 @@
+// File : internal/app/dbscan/helpers/lint_rules.go
+// Deskripsi : Rule bawaan db-scan lint (MyISAM, tanpa primary key, utf8mb3, duplicate index, auto-increment overflow)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/shared/database"
+)
+
+// Nama rule bawaan.
+const (
+	LintRuleMyISAM          = "myisam_table"
+	LintRuleMissingPK       = "missing_primary_key"
+	LintRuleUTF8MB3         = "utf8mb3_charset"
+	LintRuleDuplicateIndex  = "duplicate_index"
+	LintRuleAutoIncOverflow = "auto_increment_overflow"
+)
+
+func init() {
+	RegisterLintRule(myisamRule{})
+	RegisterLintRule(missingPKRule{})
+	RegisterLintRule(utf8mb3Rule{})
+	RegisterLintRule(duplicateIndexRule{})
+	RegisterLintRule(autoIncOverflowRule{})
+}
+
+// queryStrings menjalankan query yang mengembalikan kolom string saja.
+func queryStrings(ctx context.Context, client *database.Client, query string, cols int, args ...interface{}) ([][]string, error) {
+	rows, err := client.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out [][]string
+	for rows.Next() {
+		vals := make([]string, cols)
+		ptrs := make([]interface{}, cols)
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		out = append(out, vals)
+	}
+	return out, rows.Err()
+}
+
+// myisamRule: tabel MyISAM tidak transactional dan rawan corrupt saat crash.
+type myisamRule struct{}
+
+func (myisamRule) Name() string { return LintRuleMyISAM }
+func (myisamRule) Description() string {
+	return "Tabel dengan engine MyISAM (non-transactional, table-level lock)"
+}
+
+func (myisamRule) Check(ctx context.Context, client *database.Client, dbName string, _ LintRuleConfig) ([]dbscanmodel.LintFinding, error) {
+	rows, err := queryStrings(ctx, client, `SELECT TABLE_NAME FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = ? AND TABLE_TYPE = 'BASE TABLE' AND ENGINE = 'MyISAM'`, 1, dbName)
+	if err != nil {
+		return nil, err
+	}
+	var out []dbscanmodel.LintFinding
+	for _, r := range rows {
+		out = append(out, dbscanmodel.LintFinding{
+			Rule:         LintRuleMyISAM,
+			Severity:     dbscanmodel.LintSeverityWarning,
+			Database:     dbName,
+			Table:        r[0],
+			Message:      "Tabel memakai engine MyISAM",
+			SuggestedDDL: fmt.Sprintf("ALTER TABLE %s ENGINE=InnoDB;", qualifiedTable(dbName, r[0])),
+		})
+	}
+	return out, nil
+}
+
+// missingPKRule: tabel tanpa primary key menyulitkan replikasi (row-based) dan Galera.
+type missingPKRule struct{}
+
+func (missingPKRule) Name() string { return LintRuleMissingPK }
+func (missingPKRule) Description() string {
+	return "Tabel tanpa PRIMARY KEY (replikasi row-based & Galera lambat/tidak aman)"
+}
+
+func (missingPKRule) Check(ctx context.Context, client *database.Client, dbName string, _ LintRuleConfig) ([]dbscanmodel.LintFinding, error) {
+	rows, err := queryStrings(ctx, client, `SELECT t.TABLE_NAME FROM information_schema.TABLES t
+		LEFT JOIN information_schema.TABLE_CONSTRAINTS c
+			ON c.TABLE_SCHEMA = t.TABLE_SCHEMA AND c.TABLE_NAME = t.TABLE_NAME AND c.CONSTRAINT_TYPE = 'PRIMARY KEY'
+		WHERE t.TABLE_SCHEMA = ? AND t.TABLE_TYPE = 'BASE TABLE' AND c.CONSTRAINT_NAME IS NULL`, 1, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	tables := make([]interface{}, 0, len(rows)+1)
+	tables = append(tables, dbName)
+	for _, r := range rows {
+		tables = append(tables, r[0])
+	}
+	in := strings.TrimSuffix(strings.Repeat("?,", len(rows)), ",")
+
+	colRows, err := queryStrings(ctx, client, `SELECT TABLE_NAME, COLUMN_NAME FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME IN (`+in+`)`, 2, tables...)
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string][]string)
+	for _, r := range colRows {
+		columns[r[0]] = append(columns[r[0]], r[1])
+	}
+
+	// Kolom unique key yang nullable atau berupa prefix membuat key tersebut tidak bisa dijadikan PK.
+	keyRows, err := queryStrings(ctx, client, `SELECT s.TABLE_NAME, s.INDEX_NAME, s.COLUMN_NAME,
+			IF(c.IS_NULLABLE = 'NO' AND s.SUB_PART IS NULL, '1', '0')
+		FROM information_schema.STATISTICS s
+		JOIN information_schema.COLUMNS c
+			ON c.TABLE_SCHEMA = s.TABLE_SCHEMA AND c.TABLE_NAME = s.TABLE_NAME AND c.COLUMN_NAME = s.COLUMN_NAME
+		WHERE s.TABLE_SCHEMA = ? AND s.NON_UNIQUE = 0 AND s.TABLE_NAME IN (`+in+`)
+		ORDER BY s.TABLE_NAME, s.INDEX_NAME, s.SEQ_IN_INDEX`, 4, tables...)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string][]*lintIndex)
+	invalid := make(map[*lintIndex]bool)
+	for _, r := range keyRows {
+		list := keys[r[0]]
+		if len(list) == 0 || list[len(list)-1].name != r[1] {
+			list = append(list, &lintIndex{name: r[1], unique: true})
+		}
+		idx := list[len(list)-1]
+		idx.columns = append(idx.columns, r[2])
+		if r[3] != "1" {
+			invalid[idx] = true
+		}
+		keys[r[0]] = list
+	}
+
+	var out []dbscanmodel.LintFinding
+	for _, r := range rows {
+		var candidates []*lintIndex
+		for _, idx := range keys[r[0]] {
+			if !invalid[idx] {
+				candidates = append(candidates, idx)
+			}
+		}
+		out = append(out, missingPKFinding(dbName, r[0], columns[r[0]], candidates))
+	}
+	return out, nil
+}
+
+// missingPKFinding menyarankan unique key NOT NULL (kolom paling sedikit) sebagai PRIMARY KEY; tanpa kandidat,
+// disarankan kolom auto-increment baru dengan nama yang belum dipakai tabel.
+func missingPKFinding(dbName, table string, columns []string, candidates []*lintIndex) dbscanmodel.LintFinding {
+	f := dbscanmodel.LintFinding{
+		Rule:     LintRuleMissingPK,
+		Severity: dbscanmodel.LintSeverityError,
+		Database: dbName,
+		Table:    table,
+	}
+
+	if len(candidates) > 0 {
+		sort.Slice(candidates, func(i, j int) bool {
+			if len(candidates[i].columns) != len(candidates[j].columns) {
+				return len(candidates[i].columns) < len(candidates[j].columns)
+			}
+			return candidates[i].name < candidates[j].name
+		})
+		key := candidates[0]
+		cols := make([]string, len(key.columns))
+		for i, c := range key.columns {
+			cols[i] = quoteIdent(c)
+		}
+		f.Message = fmt.Sprintf("Tabel tidak memiliki PRIMARY KEY (unique key NOT NULL %s bisa dijadikan PK)", key.name)
+		f.SuggestedDDL = fmt.Sprintf("ALTER TABLE %s DROP INDEX %s, ADD PRIMARY KEY (%s);",
+			qualifiedTable(dbName, table), quoteIdent(key.name), strings.Join(cols, ", "))
+		return f
+	}
+
+	used := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		used[strings.ToLower(c)] = true
+	}
+	name := "id"
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("id_%d", i)
+	}
+	f.Message = "Tabel tidak memiliki PRIMARY KEY (tambahkan kolom auto-increment sebagai PK)"
+	f.SuggestedDDL = fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY FIRST;",
+		qualifiedTable(dbName, table), quoteIdent(name))
+	return f
+}
+
+// utf8mb3Rule: kolom utf8 (alias utf8mb3) tidak bisa menyimpan karakter 4-byte (emoji, dsb).
+type utf8mb3Rule struct{}
+
+func (utf8mb3Rule) Name() string { return LintRuleUTF8MB3 }
+func (utf8mb3Rule) Description() string {
+	return "Kolom dengan charset utf8/utf8mb3 (bukan utf8mb4)"
+}
+
+func (utf8mb3Rule) Check(ctx context.Context, client *database.Client, dbName string, _ LintRuleConfig) ([]dbscanmodel.LintFinding, error) {
+	rows, err := queryStrings(ctx, client, `SELECT c.TABLE_NAME, c.COLUMN_NAME FROM information_schema.COLUMNS c
+		JOIN information_schema.TABLES t ON t.TABLE_SCHEMA = c.TABLE_SCHEMA AND t.TABLE_NAME = c.TABLE_NAME
+		WHERE c.TABLE_SCHEMA = ? AND t.TABLE_TYPE = 'BASE TABLE' AND c.CHARACTER_SET_NAME IN ('utf8', 'utf8mb3')
+		ORDER BY c.TABLE_NAME, c.ORDINAL_POSITION`, 2, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Satu temuan per tabel agar laporan tidak membengkak untuk tabel dengan banyak kolom.
+	columns := make(map[string][]string)
+	var tables []string
+	for _, r := range rows {
+		if _, ok := columns[r[0]]; !ok {
+			tables = append(tables, r[0])
+		}
+		columns[r[0]] = append(columns[r[0]], r[1])
+	}
+
+	var out []dbscanmodel.LintFinding
+	for _, table := range tables {
+		cols := columns[table]
+		out = append(out, dbscanmodel.LintFinding{
+			Rule:     LintRuleUTF8MB3,
+			Severity: dbscanmodel.LintSeverityWarning,
+			Database: dbName,
+			Table:    table,
+			Column:   strings.Join(cols, ","),
+			Message:  fmt.Sprintf("%d kolom memakai charset utf8mb3", len(cols)),
+			SuggestedDDL: fmt.Sprintf("ALTER TABLE %s CONVERT TO CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;",
+				qualifiedTable(dbName, table)),
+		})
+	}
+	return out, nil
+}
+
+// duplicateIndexRule: index dengan kolom identik (duplikat) atau prefix dari index lain (redundan).
+type duplicateIndexRule struct{}
+
+func (duplicateIndexRule) Name() string { return LintRuleDuplicateIndex }
+func (duplicateIndexRule) Description() string {
+	return "Index duplikat atau redundan (prefix kiri dari index lain)"
+}
+
+// lintIndex adalah definisi satu index dari information_schema.STATISTICS.
+type lintIndex struct {
+	name    string
+	unique  bool
+	columns []string
+}
+
+func (duplicateIndexRule) Check(ctx context.Context, client *database.Client, dbName string, _ LintRuleConfig) ([]dbscanmodel.LintFinding, error) {
+	rows, err := queryStrings(ctx, client, `SELECT TABLE_NAME, INDEX_NAME, CAST(NON_UNIQUE AS CHAR),
+			CONCAT(COLUMN_NAME, IFNULL(CONCAT('(', SUB_PART, ')'), ''))
+		FROM information_schema.STATISTICS
+		WHERE TABLE_SCHEMA = ? AND INDEX_TYPE NOT IN ('FULLTEXT', 'SPATIAL') AND COLUMN_NAME IS NOT NULL
+		ORDER BY TABLE_NAME, INDEX_NAME, SEQ_IN_INDEX`, 4, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[string][]*lintIndex)
+	var tableOrder []string
+	for _, r := range rows {
+		table, index := r[0], r[1]
+		list, ok := tables[table]
+		if !ok {
+			tableOrder = append(tableOrder, table)
+		}
+		if len(list) == 0 || list[len(list)-1].name != index {
+			list = append(list, &lintIndex{name: index, unique: r[2] == "0"})
+		}
+		idx := list[len(list)-1]
+		idx.columns = append(idx.columns, r[3])
+		tables[table] = list
+	}
+
+	var out []dbscanmodel.LintFinding
+	for _, table := range tableOrder {
+		out = append(out, findDuplicateIndexes(dbName, table, tables[table])...)
+	}
+	return out, nil
+}
+
+// findDuplicateIndexes mengelompokkan index berdasarkan urutan kolom; setiap kelompok duplikat dilaporkan
+// sekali (satu index dipertahankan), lalu index non-unique yang menjadi prefix kiri index lain dilaporkan redundan.
+func findDuplicateIndexes(dbName, table string, indexes []*lintIndex) []dbscanmodel.LintFinding {
+	groups := make(map[string][]*lintIndex)
+	var signatures []string
+	for _, idx := range indexes {
+		sig := strings.Join(idx.columns, "\x00")
+		if _, ok := groups[sig]; !ok {
+			signatures = append(signatures, sig)
+		}
+		groups[sig] = append(groups[sig], idx)
+	}
+	sort.Strings(signatures)
+
+	var out []dbscanmodel.LintFinding
+	keepers := make([]*lintIndex, 0, len(signatures))
+	for _, sig := range signatures {
+		group := groups[sig]
+		// Pertahankan PRIMARY, lalu index unique, lalu nama yang lebih awal.
+		sort.Slice(group, func(i, j int) bool {
+			a, b := group[i], group[j]
+			if (a.name == "PRIMARY") != (b.name == "PRIMARY") {
+				return a.name == "PRIMARY"
+			}
+			if a.unique != b.unique {
+				return a.unique
+			}
+			return a.name < b.name
+		})
+		keepers = append(keepers, group[0])
+		if len(group) > 1 {
+			out = append(out, duplicateIndexFinding(dbName, table, group[1:], group[0], dbscanmodel.LintSeverityWarning, "duplikat dari"))
+		}
+	}
+
+	for _, a := range keepers {
+		if a.unique {
+			continue
+		}
+		for _, b := range keepers {
+			if len(a.columns) < len(b.columns) && slices.Equal(a.columns, b.columns[:len(a.columns)]) {
+				out = append(out, duplicateIndexFinding(dbName, table, []*lintIndex{a}, b, dbscanmodel.LintSeverityInfo, "redundan (prefix dari)"))
+				break
+			}
+		}
+	}
+	return out
+}
+
+func duplicateIndexFinding(dbName, table string, dups []*lintIndex, keep *lintIndex, severity dbscanmodel.LintSeverity, relation string) dbscanmodel.LintFinding {
+	names := make([]string, len(dups))
+	drops := make([]string, len(dups))
+	for i, d := range dups {
+		names[i] = d.name
+		drops[i] = "DROP INDEX " + quoteIdent(d.name)
+	}
+	return dbscanmodel.LintFinding{
+		Rule:     LintRuleDuplicateIndex,
+		Severity: severity,
+		Database: dbName,
+		Table:    table,
+		Column:   strings.Join(dups[0].columns, ","),
+		Message:  fmt.Sprintf("Index %s %s %s (%s)", strings.Join(names, ", "), relation, keep.name, strings.Join(keep.columns, ",")),
+		SuggestedDDL: fmt.Sprintf("ALTER TABLE %s %s;",
+			qualifiedTable(dbName, table), strings.Join(drops, ", ")),
+	}
+}
+
+// autoIncOverflowRule: nilai auto-increment mendekati batas maksimum tipe kolom.
+type autoIncOverflowRule struct{}
+
+func (autoIncOverflowRule) Name() string { return LintRuleAutoIncOverflow }
+func (autoIncOverflowRule) Description() string {
+	return "Kolom auto-increment mendekati nilai maksimum tipe kolom"
+}
+
+func (autoIncOverflowRule) Check(ctx context.Context, client *database.Client, dbName string, cfg LintRuleConfig) ([]dbscanmodel.LintFinding, error) {
+	rows, err := queryStrings(ctx, client, `SELECT t.TABLE_NAME, c.COLUMN_NAME, c.COLUMN_TYPE, CAST(t.AUTO_INCREMENT AS CHAR)
+		FROM information_schema.TABLES t
+		JOIN information_schema.COLUMNS c
+			ON c.TABLE_SCHEMA = t.TABLE_SCHEMA AND c.TABLE_NAME = t.TABLE_NAME AND c.EXTRA LIKE '%auto_increment%'
+		WHERE t.TABLE_SCHEMA = ? AND t.TABLE_TYPE = 'BASE TABLE' AND t.AUTO_INCREMENT IS NOT NULL`, 4, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []dbscanmodel.LintFinding
+	for _, r := range rows {
+		table, column, columnType := r[0], r[1], r[2]
+		maxVal := AutoIncrementMax(columnType)
+		var next uint64
+		if _, err := fmt.Sscan(r[3], &next); err != nil || maxVal == 0 || next == 0 {
+			continue
+		}
+		usage := roundPercent(float64(next-1) / float64(maxVal) * 100)
+
+		severity := dbscanmodel.LintSeverityNone
+		switch {
+		case usage >= cfg.AutoIncrementErrorPercent:
+			severity = dbscanmodel.LintSeverityError
+		case usage >= cfg.AutoIncrementWarnPercent:
+			severity = dbscanmodel.LintSeverityWarning
+		}
+		if severity == dbscanmodel.LintSeverityNone {
+			continue
+		}
+
+		f := dbscanmodel.LintFinding{
+			Rule:     LintRuleAutoIncOverflow,
+			Severity: severity,
+			Database: dbName,
+			Table:    table,
+			Column:   column,
+			Message:  fmt.Sprintf("Auto-increment %s terpakai %.2f%% (next=%d, max=%d)", columnType, usage, next, maxVal),
+		}
+		if widened := widenIntegerType(columnType); widened != "" {
+			f.SuggestedDDL = fmt.Sprintf("ALTER TABLE %s MODIFY %s %s NOT NULL AUTO_INCREMENT;",
+				qualifiedTable(dbName, table), quoteIdent(column), widened)
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// widenIntegerType mengembalikan tipe integer yang lebih besar untuk kolom auto-increment.
+// Kosong jika sudah BIGINT UNSIGNED (tidak bisa diperbesar).
+func widenIntegerType(columnType string) string {
+	ct := strings.ToLower(columnType)
+	unsigned := strings.Contains(ct, "unsigned")
+	if strings.HasPrefix(ct, "bigint") {
+		if unsigned {
+			return ""
+		}
+		return "BIGINT UNSIGNED"
+	}
+	if unsigned {
+		return "BIGINT UNSIGNED"
+	}
+	return "BIGINT"
+}
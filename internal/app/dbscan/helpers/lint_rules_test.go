@@ -0,0 +1,118 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+)
+
+func TestFindDuplicateIndexes(t *testing.T) {
+	idx := func(name string, unique bool, cols ...string) *lintIndex {
+		return &lintIndex{name: name, unique: unique, columns: cols}
+	}
+
+	tests := []struct {
+		name     string
+		indexes  []*lintIndex
+		messages []string
+		ddls     []string
+	}{
+		{
+			name:    "no duplicates",
+			indexes: []*lintIndex{idx("PRIMARY", true, "id"), idx("ix_a", false, "a")},
+		},
+		{
+			name:     "three identical indexes reported once",
+			indexes:  []*lintIndex{idx("ix_c", false, "a"), idx("ix_a", false, "a"), idx("ix_b", false, "a")},
+			messages: []string{"Index ix_b, ix_c duplikat dari ix_a (a)"},
+			ddls:     []string{"ALTER TABLE `db`.`t` DROP INDEX `ix_b`, DROP INDEX `ix_c`;"},
+		},
+		{
+			name:     "unique index is kept over earlier name",
+			indexes:  []*lintIndex{idx("a_ix", false, "x", "y"), idx("uq_xy", true, "x", "y")},
+			messages: []string{"Index a_ix duplikat dari uq_xy (x,y)"},
+			ddls:     []string{"ALTER TABLE `db`.`t` DROP INDEX `a_ix`;"},
+		},
+		{
+			name:     "primary is never dropped",
+			indexes:  []*lintIndex{idx("ix_id", false, "id"), idx("PRIMARY", true, "id")},
+			messages: []string{"Index ix_id duplikat dari PRIMARY (id)"},
+			ddls:     []string{"ALTER TABLE `db`.`t` DROP INDEX `ix_id`;"},
+		},
+		{
+			name:     "left prefix is redundant once",
+			indexes:  []*lintIndex{idx("ix_a", false, "a"), idx("ix_ab", false, "a", "b"), idx("ix_abc", false, "a", "b", "c")},
+			messages: []string{"Index ix_a redundan (prefix dari) ix_ab (a,b)", "Index ix_ab redundan (prefix dari) ix_abc (a,b,c)"},
+			ddls:     []string{"ALTER TABLE `db`.`t` DROP INDEX `ix_a`;", "ALTER TABLE `db`.`t` DROP INDEX `ix_ab`;"},
+		},
+		{
+			name:    "unique prefix is kept",
+			indexes: []*lintIndex{idx("uq_a", true, "a"), idx("ix_ab", false, "a", "b")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var messages, ddls []string
+			for _, f := range findDuplicateIndexes("db", "t", tt.indexes) {
+				if f.Rule != LintRuleDuplicateIndex {
+					t.Fatalf("finding rule = %q", f.Rule)
+				}
+				if f.Severity != dbscanmodel.LintSeverityWarning && f.Severity != dbscanmodel.LintSeverityInfo {
+					t.Fatalf("finding severity = %v", f.Severity)
+				}
+				messages = append(messages, f.Message)
+				ddls = append(ddls, f.SuggestedDDL)
+			}
+			if !reflect.DeepEqual(messages, tt.messages) {
+				t.Fatalf("messages = %q, want %q", messages, tt.messages)
+			}
+			if !reflect.DeepEqual(ddls, tt.ddls) {
+				t.Fatalf("ddls = %q, want %q", ddls, tt.ddls)
+			}
+		})
+	}
+}
+
+func TestMissingPKFinding(t *testing.T) {
+	key := func(name string, cols ...string) *lintIndex {
+		return &lintIndex{name: name, unique: true, columns: cols}
+	}
+
+	tests := []struct {
+		name       string
+		columns    []string
+		candidates []*lintIndex
+		ddl        string
+	}{
+		{
+			name:    "new id column",
+			columns: []string{"name", "email"},
+			ddl:     "ALTER TABLE `db`.`t` ADD COLUMN `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY FIRST;",
+		},
+		{
+			name:    "existing id column gets a unique name",
+			columns: []string{"ID", "id_2", "name"},
+			ddl:     "ALTER TABLE `db`.`t` ADD COLUMN `id_3` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY FIRST;",
+		},
+		{
+			name:       "narrowest unique not null key is promoted",
+			columns:    []string{"id", "tenant", "code"},
+			candidates: []*lintIndex{key("uq_tenant_code", "tenant", "code"), key("uq_id", "id")},
+			ddl:        "ALTER TABLE `db`.`t` DROP INDEX `uq_id`, ADD PRIMARY KEY (`id`);",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := missingPKFinding("db", "t", tt.columns, tt.candidates)
+			if f.Rule != LintRuleMissingPK || f.Severity != dbscanmodel.LintSeverityError {
+				t.Fatalf("finding = %+v", f)
+			}
+			if f.SuggestedDDL != tt.ddl {
+				t.Fatalf("SuggestedDDL = %q, want %q", f.SuggestedDDL, tt.ddl)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package helpers
+
+import (
+	"slices"
+	"testing"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+)
+
+func lintRuleNames(rules []LintRule) []string {
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.Name()
+	}
+	return names
+}
+
+func TestSelectLintRules(t *testing.T) {
+	all := lintRuleNames(LintRules())
+
+	tests := []struct {
+		name    string
+		enabled map[string]bool
+		only    []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "default runs all rules", want: all},
+		{
+			name:    "config disables a rule",
+			enabled: map[string]bool{LintRuleMyISAM: false, LintRuleMissingPK: true},
+			want:    slices.DeleteFunc(slices.Clone(all), func(n string) bool { return n == LintRuleMyISAM }),
+		},
+		{
+			name:    "--rule overrides config",
+			enabled: map[string]bool{LintRuleMyISAM: false},
+			only:    []string{" " + LintRuleMyISAM, LintRuleDuplicateIndex},
+			want:    []string{LintRuleMyISAM, LintRuleDuplicateIndex},
+		},
+		{name: "unknown rule in config", enabled: map[string]bool{"nope": true}, wantErr: true},
+		{name: "unknown --rule", only: []string{"nope"}, wantErr: true},
+		{
+			name: "all rules disabled",
+			enabled: func() map[string]bool {
+				m := make(map[string]bool)
+				for _, n := range all {
+					m[n] = false
+				}
+				return m
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SelectLintRules(tt.enabled, tt.only)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SelectLintRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(lintRuleNames(got), tt.want) {
+				t.Fatalf("SelectLintRules() = %v, want %v", lintRuleNames(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLintSeverity(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    dbscanmodel.LintSeverity
+		wantErr bool
+	}{
+		{in: "info", want: dbscanmodel.LintSeverityInfo},
+		{in: " WARN ", want: dbscanmodel.LintSeverityWarning},
+		{in: "error", want: dbscanmodel.LintSeverityError},
+		{in: "off", want: dbscanmodel.LintSeverityNone},
+		{in: "fatal", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := dbscanmodel.ParseLintSeverity(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLintSeverity(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseLintSeverity(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortAndCountLintFindings(t *testing.T) {
+	findings := []dbscanmodel.LintFinding{
+		{Rule: LintRuleMyISAM, Severity: dbscanmodel.LintSeverityWarning, Database: "b", Table: "t1"},
+		{Rule: LintRuleMissingPK, Severity: dbscanmodel.LintSeverityError, Database: "b", Table: "t2"},
+		{Rule: LintRuleDuplicateIndex, Severity: dbscanmodel.LintSeverityInfo, Database: "a", Table: "t1"},
+		{Rule: LintRuleMissingPK, Severity: dbscanmodel.LintSeverityWarning, Database: "a", Table: "t9"},
+	}
+	SortLintFindings(findings)
+
+	var order []string
+	for _, f := range findings {
+		order = append(order, f.Database+"."+f.Table)
+	}
+	if want := []string{"b.t2", "a.t9", "b.t1", "a.t1"}; !slices.Equal(order, want) {
+		t.Fatalf("SortLintFindings() order = %v, want %v", order, want)
+	}
+
+	counts := CountLintFindings(findings)
+	if counts[dbscanmodel.LintSeverityError] != 1 || counts[dbscanmodel.LintSeverityWarning] != 2 || counts[dbscanmodel.LintSeverityInfo] != 1 {
+		t.Fatalf("CountLintFindings() = %v", counts)
+	}
+}
+
+func TestWidenIntegerType(t *testing.T) {
+	tests := []struct {
+		columnType string
+		want       string
+	}{
+		{columnType: "int(11)", want: "BIGINT"},
+		{columnType: "int(10) unsigned", want: "BIGINT UNSIGNED"},
+		{columnType: "bigint(20)", want: "BIGINT UNSIGNED"},
+		{columnType: "bigint(20) unsigned", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.columnType, func(t *testing.T) {
+			if got := widenIntegerType(tt.columnType); got != tt.want {
+				t.Fatalf("widenIntegerType(%q) = %q, want %q", tt.columnType, got, tt.want)
+			}
+		})
+	}
+}
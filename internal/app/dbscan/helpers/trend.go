@@ -0,0 +1,221 @@
+// File : internal/app/dbscan/helpers/trend.go
+// Deskripsi : Perhitungan growth rate, deteksi anomali, dan forecast kapasitas dari histori scan
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package helpers
+
+import (
+	"math"
+	"sort"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/shared/consts"
+)
+
+const (
+	// anomalyMinBytes: database di bawah ukuran ini tidak dicek anomalinya (noise dari database kecil).
+	anomalyMinBytes = 1 << 20
+	// shrinkRatio: ukuran turun ke <= 50% dari snapshot sebelumnya dianggap penyusutan tidak wajar.
+	shrinkRatio = 0.5
+	// maxForecastDays: estimasi di atas ~100 tahun tidak diberi tanggal (praktis tidak akan penuh).
+	maxForecastDays = 36500
+)
+
+// Jenis anomali ukuran database.
+const (
+	AnomalyJump   = "jump"
+	AnomalyShrink = "shrink"
+)
+
+// SeriesKey mengidentifikasi seri data per profile + database.
+type SeriesKey struct {
+	Profile  string
+	Database string
+}
+
+// DatabaseSeries mengelompokkan snapshot menjadi seri titik data per profile + database.
+// Jika database tidak kosong, hanya database tersebut yang diambil.
+func DatabaseSeries(snaps []dbscanmodel.ScanSnapshot, database string) map[SeriesKey][]dbscanmodel.TrendPoint {
+	out := make(map[SeriesKey][]dbscanmodel.TrendPoint)
+	for _, s := range snaps {
+		for _, d := range s.Databases {
+			if database != "" && d.Name != database {
+				continue
+			}
+			key := SeriesKey{Profile: s.Profile, Database: d.Name}
+			points := out[key]
+			p := dbscanmodel.TrendPoint{Time: s.ScanTime, SizeBytes: d.SizeBytes, TableCount: d.TableCount}
+			if len(points) > 0 {
+				p.DeltaBytes = d.SizeBytes - points[len(points)-1].SizeBytes
+			}
+			out[key] = append(points, p)
+		}
+	}
+	return out
+}
+
+// GrowthStats menghitung statistik pertumbuhan untuk setiap seri, diurutkan dari pertumbuhan harian tertinggi.
+func GrowthStats(series map[SeriesKey][]dbscanmodel.TrendPoint) []dbscanmodel.GrowthStat {
+	stats := make([]dbscanmodel.GrowthStat, 0, len(series))
+	for key, points := range series {
+		if len(points) == 0 {
+			continue
+		}
+		first, last := points[0], points[len(points)-1]
+		st := dbscanmodel.GrowthStat{
+			Profile:     key.Profile,
+			Database:    key.Database,
+			Samples:     len(points),
+			FirstTime:   first.Time,
+			LastTime:    last.Time,
+			FirstSize:   first.SizeBytes,
+			LastSize:    last.SizeBytes,
+			TableDelta:  last.TableCount - first.TableCount,
+			BytesPerDay: slopePerDay(points),
+		}
+		if first.SizeBytes > 0 {
+			st.GrowthPercent = float64(last.SizeBytes-first.SizeBytes) / float64(first.SizeBytes) * 100
+		}
+		stats = append(stats, st)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].BytesPerDay != stats[j].BytesPerDay {
+			return stats[i].BytesPerDay > stats[j].BytesPerDay
+		}
+		return stats[i].Database < stats[j].Database
+	})
+	return stats
+}
+
+// DetectAnomalies mencari lonjakan (>= factor kali) atau penyusutan tajam antar snapshot berurutan.
+func DetectAnomalies(series map[SeriesKey][]dbscanmodel.TrendPoint, factor float64) []dbscanmodel.SizeAnomaly {
+	var out []dbscanmodel.SizeAnomaly
+	for key, points := range series {
+		for i := 1; i < len(points); i++ {
+			prev, cur := points[i-1].SizeBytes, points[i].SizeBytes
+			if prev < anomalyMinBytes && cur < anomalyMinBytes {
+				continue
+			}
+
+			a := dbscanmodel.SizeAnomaly{Profile: key.Profile, Database: key.Database, Time: points[i].Time, PrevSize: prev, Size: cur}
+			switch {
+			case prev > 0 && float64(cur) >= float64(prev)*factor:
+				a.Kind = AnomalyJump
+				a.Ratio = float64(cur) / float64(prev)
+			case prev >= anomalyMinBytes && float64(cur) <= float64(prev)*shrinkRatio:
+				a.Kind = AnomalyShrink
+				a.Ratio = float64(cur) / float64(prev)
+			default:
+				continue
+			}
+			out = append(out, a)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Time.After(out[j].Time) })
+	return out
+}
+
+// ForecastCapacity mengestimasi hari sampai volume datadir mencapai threshold untuk satu profile.
+// Hanya snapshot mode all/all-local yang dipakai agar total ukuran konsisten antar snapshot.
+// volumeOverride (bytes) dipakai jika kapasitas volume tidak tercatat di snapshot (server remote).
+func ForecastCapacity(profile string, snaps []dbscanmodel.ScanSnapshot, thresholdPercent float64, volumeOverride uint64) dbscanmodel.CapacityForecast {
+	fc := dbscanmodel.CapacityForecast{
+		Profile:          profile,
+		ThresholdPercent: thresholdPercent,
+		DaysToThreshold:  -1,
+	}
+
+	var points []dbscanmodel.TrendPoint
+	var latest *dbscanmodel.ScanSnapshot
+	for i := range snaps {
+		s := &snaps[i]
+		if s.Profile != profile || !isFullScanMode(s.Mode) {
+			continue
+		}
+		points = append(points, dbscanmodel.TrendPoint{Time: s.ScanTime, SizeBytes: s.TotalSizeBytes})
+		latest = s
+	}
+	fc.Samples = len(points)
+	if latest == nil {
+		fc.Note = "tidak ada snapshot scan penuh (all/all-local)"
+		return fc
+	}
+
+	fc.ServerHost = latest.ServerHost
+	fc.Datadir = latest.Datadir
+	fc.LastScan = latest.ScanTime
+	fc.DataSizeBytes = latest.TotalSizeBytes
+	fc.BytesPerDay = slopePerDay(points)
+
+	switch {
+	case volumeOverride > 0:
+		fc.VolumeTotalBytes = volumeOverride
+		if latest.VolumeTotalBytes > 0 {
+			fc.VolumeUsedBytes = latest.VolumeTotalBytes - latest.VolumeFreeBytes
+		} else {
+			// Server remote: statfs datadir tidak tersedia, pemakaian volume diestimasi dari ukuran data
+			// (binlog, redo/undo log, dan file lain di volume yang sama tidak terhitung).
+			fc.VolumeUsedBytes = uint64(max(latest.TotalSizeBytes, 0))
+			fc.VolumeUsedEstim = true
+		}
+	case latest.VolumeTotalBytes > 0:
+		fc.VolumeTotalBytes = latest.VolumeTotalBytes
+		fc.VolumeUsedBytes = latest.VolumeTotalBytes - latest.VolumeFreeBytes
+	default:
+		fc.Note = "kapasitas volume tidak diketahui (gunakan --volume-size untuk server remote)"
+		return fc
+	}
+
+	if len(points) < 2 {
+		fc.Note = "butuh minimal 2 snapshot untuk menghitung growth rate"
+		return fc
+	}
+
+	thresholdBytes := float64(fc.VolumeTotalBytes) * thresholdPercent / 100
+	remaining := thresholdBytes - float64(fc.VolumeUsedBytes)
+	switch {
+	case remaining <= 0:
+		fc.DaysToThreshold = 0
+		fc.EstimatedDate = fc.LastScan
+		fc.Note = "pemakaian volume sudah melewati threshold"
+	case fc.BytesPerDay <= 0:
+		fc.Note = "ukuran data tidak bertumbuh pada periode ini"
+	default:
+		fc.DaysToThreshold = remaining / fc.BytesPerDay
+		if fc.DaysToThreshold < maxForecastDays {
+			fc.EstimatedDate = fc.LastScan.AddDate(0, 0, int(math.Ceil(fc.DaysToThreshold)))
+		}
+	}
+	return fc
+}
+
+// isFullScanMode mengecek apakah snapshot berasal dari scan seluruh database.
+func isFullScanMode(mode string) bool {
+	return mode == consts.ModeAll || mode == "all-local"
+}
+
+// slopePerDay menghitung slope regresi linear (least squares) ukuran terhadap waktu dalam bytes/hari.
+func slopePerDay(points []dbscanmodel.TrendPoint) float64 {
+	if len(points) < 2 {
+		return 0
+	}
+	t0 := points[0].Time
+	var sumX, sumY, sumXY, sumXX float64
+	n := float64(len(points))
+	for _, p := range points {
+		x := p.Time.Sub(t0).Hours() / 24
+		y := float64(p.SizeBytes)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 || math.IsNaN(denom) {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denom
+}
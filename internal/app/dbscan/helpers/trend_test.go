@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+)
+
+func TestForecastCapacityVolumeUsed(t *testing.T) {
+	base := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := func(day int, size int64, total, free uint64) dbscanmodel.ScanSnapshot {
+		return dbscanmodel.ScanSnapshot{
+			Profile:          "prod",
+			Mode:             "all",
+			ScanTime:         base.AddDate(0, 0, day),
+			TotalSizeBytes:   size,
+			VolumeTotalBytes: total,
+			VolumeFreeBytes:  free,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		snaps     []dbscanmodel.ScanSnapshot
+		override  uint64
+		wantTotal uint64
+		wantUsed  uint64
+		wantEstim bool
+	}{
+		{
+			name:      "local statfs",
+			snaps:     []dbscanmodel.ScanSnapshot{snapshot(0, 100, 1000, 700), snapshot(10, 200, 1000, 600)},
+			wantTotal: 1000,
+			wantUsed:  400,
+		},
+		{
+			name:      "override keeps statfs usage",
+			snaps:     []dbscanmodel.ScanSnapshot{snapshot(0, 100, 1000, 700), snapshot(10, 200, 1000, 600)},
+			override:  2000,
+			wantTotal: 2000,
+			wantUsed:  400,
+		},
+		{
+			name:      "override on remote estimates usage from data size",
+			snaps:     []dbscanmodel.ScanSnapshot{snapshot(0, 100, 0, 0), snapshot(10, 200, 0, 0)},
+			override:  2000,
+			wantTotal: 2000,
+			wantUsed:  200,
+			wantEstim: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := ForecastCapacity("prod", tt.snaps, 80, tt.override)
+			if fc.VolumeTotalBytes != tt.wantTotal || fc.VolumeUsedBytes != tt.wantUsed || fc.VolumeUsedEstim != tt.wantEstim {
+				t.Fatalf("ForecastCapacity() total=%d used=%d estim=%v, want total=%d used=%d estim=%v",
+					fc.VolumeTotalBytes, fc.VolumeUsedBytes, fc.VolumeUsedEstim, tt.wantTotal, tt.wantUsed, tt.wantEstim)
+			}
+			if fc.DaysToThreshold <= 0 {
+				t.Fatalf("ForecastCapacity() DaysToThreshold = %v, want > 0", fc.DaysToThreshold)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// File : internal/app/dbscan/inventory.go
+// Deskripsi : Setup koneksi dan schema database inventory untuk opsi --save-to
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package dbscan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sfdbtools/internal/app/dbscan/helpers"
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/loader"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
+)
+
+// saveEnabled mengecek apakah hasil scan perlu disimpan ke database inventory.
+func (s *Service) saveEnabled() bool {
+	return s.ScanOptions.SaveTo.ProfileInfo.Path != ""
+}
+
+// setupInventory me-load profile inventory, membuka koneksi, lalu membuat/migrasi schema.
+// Mengembalikan (nil, nil) jika --save-to tidak digunakan.
+func (s *Service) setupInventory(ctx context.Context) (*helpers.InventoryStore, *database.Client, error) {
+	if !s.saveEnabled() {
+		return nil, nil, nil
+	}
+
+	profile, err := loader.ResolveAndLoadProfile(loader.ProfileLoadOptions{
+		ConfigDir:      s.Config.ConfigDir.DatabaseProfile,
+		ProfilePath:    s.ScanOptions.SaveTo.ProfileInfo.Path,
+		ProfileKey:     s.ScanOptions.SaveTo.ProfileInfo.EncryptionKey,
+		EnvProfileKey:  consts.ENV_TARGET_PROFILE_KEY,
+		RequireProfile: true,
+		ProfilePurpose: "inventory",
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("gagal load profile inventory: %w", err)
+	}
+	s.ScanOptions.SaveTo.ProfileInfo = *profile
+
+	client, err := profileconn.ConnectWithProfile(s.Config, profile, consts.DefaultInitialDatabase)
+	if err != nil {
+		return nil, nil, fmt.Errorf("koneksi ke server inventory gagal: %w", err)
+	}
+
+	store := helpers.NewInventoryStore(client, s.ScanOptions.SaveTo.Database)
+	version, err := store.EnsureSchema(ctx)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+
+	s.Log.Infof("Inventory siap: %s@%s:%d/%s (schema v%d)",
+		profile.DBInfo.User, profile.DBInfo.Host, profile.DBInfo.Port, store.Database(), version)
+	return store, client, nil
+}
+
+// startInventoryRun mencatat scan run baru di inventory dan mengembalikan identitasnya.
+func (s *Service) startInventoryRun(ctx context.Context, store *helpers.InventoryStore, serverHost string, serverPort int, total int) (helpers.ScanRunInfo, error) {
+	startedAt := time.Now()
+	run := helpers.ScanRunInfo{
+		ScanID:      helpers.NewScanID(serverHost, startedAt),
+		ProfileName: s.ScanOptions.ProfileInfo.Name,
+		ServerHost:  serverHost,
+		ServerPort:  serverPort,
+		Mode:        s.ScanOptions.Mode,
+		Total:       total,
+		StartedAt:   startedAt,
+	}
+	if err := store.StartRun(ctx, run); err != nil {
+		return run, err
+	}
+	s.Log.Infof("Scan run inventory: %s", run.ScanID)
+	return run, nil
+}
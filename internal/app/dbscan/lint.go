@@ -0,0 +1,170 @@
+// File : internal/app/dbscan/lint.go
+// Deskripsi : Perintah db-scan lint (schema advisor) dengan gating berdasarkan severity
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package dbscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sfdbtools/internal/app/dbscan/helpers"
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// ErrLintThresholdExceeded dikembalikan jika ada temuan dengan severity >= fail-on.
+var ErrLintThresholdExceeded = errors.New("temuan lint melewati batas severity")
+
+// ListLintRules menampilkan rule lint yang tersedia beserta status aktif dari config.
+func (s *Service) ListLintRules() {
+	print.PrintHeader("RULE DB-SCAN LINT")
+	rows := [][]string{}
+	for _, r := range helpers.LintRules() {
+		status := text.ColorText("aktif", consts.UIColorGreen)
+		if on, ok := s.Config.DBScan.Lint.Rules[r.Name()]; ok && !on {
+			status = text.ColorText("nonaktif", consts.UIColorYellow)
+		}
+		rows = append(rows, []string{r.Name(), status, r.Description()})
+	}
+	table.Render([]string{"Rule", "Status", "Deskripsi"}, rows)
+}
+
+// ExecuteLint menjalankan rule lint terhadap database terpilih.
+// Mengembalikan ErrLintThresholdExceeded jika ada temuan >= fail-on (untuk gating deployment).
+func (s *Service) ExecuteLint(opts dbscanmodel.LintOptions) error {
+	if opts.ListRules {
+		s.ListLintRules()
+		return nil
+	}
+	if err := validateExportPath(opts.ExportPath); err != nil {
+		return err
+	}
+
+	lintCfg := s.Config.DBScan.Lint
+	failOnRaw := lintCfg.FailOn
+	if opts.FailOn != "" {
+		failOnRaw = opts.FailOn
+	}
+	failOn, err := dbscanmodel.ParseLintSeverity(failOnRaw)
+	if err != nil {
+		return fmt.Errorf("fail-on: %w", err)
+	}
+
+	rules, err := helpers.SelectLintRules(lintCfg.Rules, opts.Rules)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	s.ScanOptions.Mode = "lint"
+	client, dbNames, err := s.prepareScanSession(ctx, "Database Scanning - Schema Lint", s.ScanOptions.ShowOptions)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ruleNames := make([]string, 0, len(rules))
+	for _, r := range rules {
+		ruleNames = append(ruleNames, r.Name())
+	}
+	s.Log.Infof("Rule lint aktif: %s (fail-on: %s)", strings.Join(ruleNames, ", "), failOn)
+
+	findings, ruleErrors := helpers.RunLint(ctx, client, dbNames, rules, helpers.LintRuleConfig{
+		AutoIncrementWarnPercent:  lintCfg.AutoIncrementWarnPercent,
+		AutoIncrementErrorPercent: lintCfg.AutoIncrementErrorPercent,
+	}, s.Log)
+
+	counts := helpers.CountLintFindings(findings)
+	s.displayLintFindings(len(dbNames), findings, counts, opts.ShowDDL)
+
+	if len(ruleErrors) > 0 {
+		print.PrintWarn(fmt.Sprintf("Terdapat %d rule yang gagal dijalankan:", len(ruleErrors)))
+		for _, msg := range ruleErrors {
+			fmt.Printf("  • %s\n", msg)
+		}
+	}
+
+	if opts.ExportPath != "" {
+		if err := s.exportLintFindings(opts.ExportPath, findings); err != nil {
+			return fmt.Errorf("export lint gagal: %w", err)
+		}
+		print.PrintSuccess(fmt.Sprintf("Hasil lint diekspor ke: %s", opts.ExportPath))
+	}
+
+	if failOn != dbscanmodel.LintSeverityNone {
+		var blocking int
+		for sev, n := range counts {
+			if sev >= failOn {
+				blocking += n
+			}
+		}
+		if blocking > 0 {
+			return fmt.Errorf("%w: %d temuan dengan severity >= %s", ErrLintThresholdExceeded, blocking, failOn)
+		}
+	}
+	if len(ruleErrors) > 0 {
+		return fmt.Errorf("%d rule lint gagal dijalankan", len(ruleErrors))
+	}
+
+	print.PrintSuccess("Schema lint selesai tanpa temuan yang melewati batas.")
+	return nil
+}
+
+// displayLintFindings menampilkan ringkasan dan daftar temuan lint.
+func (s *Service) displayLintFindings(totalDB int, findings []dbscanmodel.LintFinding, counts map[dbscanmodel.LintSeverity]int, showDDL bool) {
+	print.PrintHeader("HASIL SCHEMA LINT")
+	table.Render([]string{"Metrik", "Nilai"}, [][]string{
+		{"Database diperiksa", fmt.Sprintf("%d", totalDB)},
+		{"Error", text.ColorText(fmt.Sprintf("%d", counts[dbscanmodel.LintSeverityError]), consts.UIColorRed)},
+		{"Warning", text.ColorText(fmt.Sprintf("%d", counts[dbscanmodel.LintSeverityWarning]), consts.UIColorYellow)},
+		{"Info", fmt.Sprintf("%d", counts[dbscanmodel.LintSeverityInfo])},
+	})
+
+	if len(findings) == 0 {
+		return
+	}
+
+	rows := make([][]string, 0, len(findings))
+	for _, f := range findings {
+		rows = append(rows, []string{lintSeverityLabel(f.Severity), f.Rule, f.Database, f.Table, f.Message})
+	}
+	table.Render([]string{"Severity", "Rule", "Database", "Table", "Temuan"}, rows)
+
+	if showDDL {
+		print.PrintSubHeader("Suggested DDL")
+		for _, f := range findings {
+			if f.SuggestedDDL != "" {
+				fmt.Printf("-- [%s] %s\n%s\n", f.Severity, f.Rule, f.SuggestedDDL)
+			}
+		}
+	}
+}
+
+// exportLintFindings menulis temuan lint ke file (.xlsx/.csv/.json).
+func (s *Service) exportLintFindings(path string, findings []dbscanmodel.LintFinding) error {
+	headers := []string{"Severity", "Rule", "Database", "Table", "Column", "Message", "Suggested DDL"}
+	rows := make([][]interface{}, 0, len(findings))
+	for _, f := range findings {
+		rows = append(rows, []interface{}{f.Severity.String(), f.Rule, f.Database, f.Table, f.Column, f.Message, f.SuggestedDDL})
+	}
+	return helpers.ExportTable(path, "Lint", headers, rows)
+}
+
+func lintSeverityLabel(sev dbscanmodel.LintSeverity) string {
+	switch sev {
+	case dbscanmodel.LintSeverityError:
+		return text.ColorText("ERROR", consts.UIColorRed)
+	case dbscanmodel.LintSeverityWarning:
+		return text.ColorText("WARNING", consts.UIColorYellow)
+	default:
+		return "INFO"
+	}
+}
@@ -1,6 +1,12 @@
 package types
 
-import "sfdbtools/internal/domain"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"sfdbtools/internal/domain"
+)
 
 // ScanEntryConfig untuk konfigurasi scan entry point
 type ScanEntryConfig struct {
@@ -18,6 +24,21 @@ type ScanResult struct {
 	FailedCount    int
 	Duration       string
 	Errors         []string
+	ScanID         string // ID scan run (terisi jika hasil disimpan ke inventory)
+	ServerHost     string
+	ServerPort     int
+	StartedAt      time.Time
+}
+
+// ExportFormats adalah ekstensi file yang didukung untuk --export.
+var ExportFormats = []string{".xlsx", ".csv", ".json"}
+
+// ScanReport berisi data lengkap satu kali scan untuk keperluan export (--export).
+type ScanReport struct {
+	ProfileName string
+	Mode        string
+	Result      *ScanResult
+	Details     []DatabaseDetailInfo // diurutkan berdasarkan nama database
 }
 
 // ScanOptions berisi opsi untuk database scan
@@ -49,6 +70,20 @@ type ScanOptions struct {
 	// Output Options
 	DisplayResults bool
 
+	// Export hasil scan ke file (.xlsx/.csv/.json), kosong = tidak export
+	ExportPath string
+
+	// Deep scan: kumpulkan metrik per tabel (--deep / db-scan tables)
+	Deep bool
+	// TopTables: jumlah tabel terbesar lintas database yang ditampilkan pada deep scan
+	TopTables int
+
+	// Inventory: simpan hasil scan ke database pusat (opsional)
+	SaveTo struct {
+		ProfileInfo domain.ProfileInfo // Profile server inventory (--save-to, --save-to-key)
+		Database    string             // Nama database inventory (--save-db)
+	}
+
 	// Internal use only
 	Mode        string // "all" atau "selection" atau "single"
 	ShowOptions bool   // Tampilkan opsi scanning sebelum eksekusi
@@ -85,4 +120,176 @@ type DatabaseDetailInfo struct {
 	UserGrantCount int    `json:"user_grant_count"`
 	CollectionTime string `json:"collection_time"`
 	Error          string `json:"error,omitempty"` // jika ada error saat collect
+
+	// Tables hanya terisi pada mode deep (db-scan tables / --deep)
+	Tables []TableDetailInfo `json:"tables,omitempty"`
+}
+
+// TableDetailInfo adalah metrik per tabel hasil deep scan (information_schema.TABLES).
+type TableDetailInfo struct {
+	DatabaseName         string     `json:"database_name"`
+	TableName            string     `json:"table_name"`
+	Engine               string     `json:"engine"`
+	RowEstimate          int64      `json:"row_estimate"`
+	DataBytes            int64      `json:"data_bytes"`
+	IndexBytes           int64      `json:"index_bytes"`
+	TotalBytes           int64      `json:"total_bytes"`
+	DataFreeBytes        int64      `json:"data_free_bytes"`
+	FragmentationPercent float64    `json:"fragmentation_percent"` // data_free / (data + index + data_free)
+	AutoIncrement        uint64     `json:"auto_increment,omitempty"`
+	AutoIncrementMax     uint64     `json:"auto_increment_max,omitempty"`
+	AutoIncrementUsage   float64    `json:"auto_increment_usage_percent,omitempty"` // persentase nilai max tipe kolom yang sudah terpakai
+	Charset              string     `json:"charset"`
+	Collation            string     `json:"collation"`
+	CreateTime           *time.Time `json:"create_time,omitempty"`
+	UpdateTime           *time.Time `json:"update_time,omitempty"`
+}
+
+// ScanSnapshot adalah satu baris histori scan lokal (dipakai db-scan trend/forecast).
+type ScanSnapshot struct {
+	ScanTime         time.Time          `json:"scan_time"`
+	Profile          string             `json:"profile"`
+	ServerHost       string             `json:"server_host"`
+	ServerPort       int                `json:"server_port"`
+	Mode             string             `json:"mode"`
+	ScanID           string             `json:"scan_id,omitempty"`
+	Datadir          string             `json:"datadir,omitempty"`
+	VolumeTotalBytes uint64             `json:"volume_total_bytes,omitempty"` // hanya terisi jika datadir bisa di-stat lokal
+	VolumeFreeBytes  uint64             `json:"volume_free_bytes,omitempty"`
+	TotalSizeBytes   int64              `json:"total_size_bytes"`
+	Databases        []SnapshotDatabase `json:"databases"`
+}
+
+// SnapshotDatabase adalah metrik satu database di dalam snapshot.
+type SnapshotDatabase struct {
+	Name       string `json:"name"`
+	SizeBytes  int64  `json:"size_bytes"`
+	TableCount int    `json:"table_count"`
+	ViewCount  int    `json:"view_count"`
+}
+
+// TrendOptions berisi opsi untuk perintah db-scan trend dan db-scan forecast.
+type TrendOptions struct {
+	Profile          string  // nama profile di histori (kosong = semua profile)
+	Database         string  // trend: database tertentu (kosong = ringkasan semua database)
+	Days             int     // jendela histori yang dianalisis
+	Top              int     // trend: jumlah database dengan pertumbuhan tertinggi yang ditampilkan
+	ThresholdPercent float64 // forecast: batas pemakaian volume
+	VolumeSizeBytes  uint64  // forecast: override kapasitas volume datadir (untuk server remote)
+	ExportPath       string
+}
+
+// TrendPoint adalah satu titik data pertumbuhan database.
+type TrendPoint struct {
+	Time       time.Time
+	SizeBytes  int64
+	TableCount int
+	DeltaBytes int64
+}
+
+// GrowthStat adalah ringkasan pertumbuhan satu database (atau total satu profile).
+type GrowthStat struct {
+	Profile       string
+	Database      string
+	Samples       int
+	FirstTime     time.Time
+	LastTime      time.Time
+	FirstSize     int64
+	LastSize      int64
+	TableDelta    int
+	BytesPerDay   float64 // slope regresi linear ukuran terhadap waktu
+	GrowthPercent float64 // (last-first)/first * 100
+}
+
+// SizeAnomaly menandai perubahan ukuran tidak wajar antar dua snapshot berurutan.
+type SizeAnomaly struct {
+	Profile  string
+	Database string
+	Time     time.Time
+	PrevSize int64
+	Size     int64
+	Kind     string // "jump" atau "shrink"
+	Ratio    float64
+}
+
+// CapacityForecast adalah hasil estimasi kapasitas volume datadir satu profile.
+type CapacityForecast struct {
+	Profile          string
+	ServerHost       string
+	Datadir          string
+	LastScan         time.Time
+	Samples          int
+	DataSizeBytes    int64
+	VolumeTotalBytes uint64
+	VolumeUsedBytes  uint64
+	VolumeUsedEstim  bool // true jika VolumeUsedBytes diambil dari ukuran data (tanpa statfs), bukan pemakaian volume sebenarnya
+	ThresholdPercent float64
+	BytesPerDay      float64
+	DaysToThreshold  float64 // -1 jika tidak dapat diestimasi
+	EstimatedDate    time.Time
+	Note             string
+}
+
+// LintSeverity adalah tingkat keparahan temuan db-scan lint (semakin besar semakin parah).
+type LintSeverity int
+
+const (
+	LintSeverityNone LintSeverity = iota // dipakai sebagai fail-on "none" (tidak pernah gagal)
+	LintSeverityInfo
+	LintSeverityWarning
+	LintSeverityError
+)
+
+// String mengembalikan nama severity dalam huruf kecil.
+func (s LintSeverity) String() string {
+	switch s {
+	case LintSeverityInfo:
+		return "info"
+	case LintSeverityWarning:
+		return "warning"
+	case LintSeverityError:
+		return "error"
+	default:
+		return "none"
+	}
+}
+
+// MarshalText agar severity ditulis sebagai string pada export JSON.
+func (s LintSeverity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ParseLintSeverity mengubah string (info|warning|error|none) menjadi LintSeverity.
+func ParseLintSeverity(v string) (LintSeverity, error) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "info":
+		return LintSeverityInfo, nil
+	case "warning", "warn":
+		return LintSeverityWarning, nil
+	case "error":
+		return LintSeverityError, nil
+	case "none", "off":
+		return LintSeverityNone, nil
+	}
+	return LintSeverityNone, fmt.Errorf("severity tidak valid: %q (gunakan info, warning, error, atau none)", v)
+}
+
+// LintFinding adalah satu temuan rule lint pada sebuah database/tabel.
+type LintFinding struct {
+	Rule         string       `json:"rule"`
+	Severity     LintSeverity `json:"severity"`
+	Database     string       `json:"database"`
+	Table        string       `json:"table,omitempty"`
+	Column       string       `json:"column,omitempty"`
+	Message      string       `json:"message"`
+	SuggestedDDL string       `json:"suggested_ddl,omitempty"`
+}
+
+// LintOptions berisi opsi perintah db-scan lint (koneksi & filter database memakai ScanOptions).
+type LintOptions struct {
+	Rules      []string // hanya jalankan rule ini (override config), kosong = sesuai config
+	FailOn     string   // override dbscan.lint.fail_on
+	ShowDDL    bool     // tampilkan suggested DDL sebagai script SQL
+	ListRules  bool     // hanya tampilkan daftar rule
+	ExportPath string
 }
@@ -2,7 +2,7 @@
 // Deskripsi : Service utama implementation untuk database scanning operations
 // Author : Hadiyatna Muflihun
 // Tanggal : 15 Oktober 2025
-// Last Modified : 5 Januari 2026
+// Last Modified : 18 Oktober 2026
 package dbscan
 
 import (
@@ -57,26 +57,49 @@ func (s *Service) ExecuteScan(config dbscanmodel.ScanEntryConfig) error {
 	s.ScanOptions.Mode = config.Mode
 	s.ScanOptions.LocalScan = (config.Mode == "all-local")
 
+	if err := validateExportPath(s.ScanOptions.ExportPath); err != nil {
+		return err
+	}
+
 	// Setup connections
-	sourceClient, dbFiltered, cleanup, err := s.setupScanConnections(ctx, config.HeaderTitle, config.ShowOptions)
+	sourceClient, dbFiltered, inventory, cleanup, err := s.setupScanConnections(ctx, config.HeaderTitle, config.ShowOptions)
 	if err != nil {
 		return err
 	}
 	defer cleanup()
 
 	// Lakukan scanning dengan UI output
-	result, detailsMap, err := s.executeScanWithClients(ctx, sourceClient, dbFiltered)
+	result, detailsMap, err := s.executeScanWithClients(ctx, sourceClient, dbFiltered, inventory)
 	if err != nil {
 		s.Log.Error(config.LogPrefix + " gagal: " + err.Error())
 		return err
 	}
 
+	// Simpan snapshot ke histori lokal untuk db-scan trend/forecast
+	s.recordSnapshot(ctx, sourceClient, result, detailsMap)
+
 	// Tampilkan hasil
 	if s.ScanOptions.DisplayResults {
 		helpers.DisplayScanResult(result)
 		if len(detailsMap) > 0 {
 			helpers.DisplayDetailResults(detailsMap)
 		}
+		if s.ScanOptions.Deep {
+			helpers.DisplayTopTables(helpers.TopTables(detailsMap, s.ScanOptions.TopTables))
+		}
+	}
+
+	// Export hasil scan ke file (jika --export digunakan)
+	if s.ScanOptions.ExportPath != "" {
+		report := helpers.NewScanReport(s.ScanOptions.ProfileInfo.Name, s.ScanOptions.Mode, result, detailsMap)
+		files, err := helpers.ExportScanReport(s.ScanOptions.ExportPath, report)
+		if err != nil {
+			return fmt.Errorf("export hasil scan gagal: %w", err)
+		}
+		for _, f := range files {
+			s.Log.Infof("Hasil scan diekspor ke: %s", f)
+			print.PrintSuccess(fmt.Sprintf("Hasil scan diekspor ke: %s", f))
+		}
 	}
 
 	// Print success message jika ada
@@ -92,6 +115,7 @@ func (s *Service) executeScanWithClients(
 	ctx context.Context,
 	sourceClient *database.Client,
 	dbNames []string,
+	inventory *helpers.InventoryStore,
 ) (*dbscanmodel.ScanResult, map[string]dbscanmodel.DatabaseDetailInfo, error) {
 	print.PrintSubHeader("Memulai Proses Scanning Database")
 
@@ -127,6 +151,17 @@ func (s *Service) executeScanWithClients(
 		DisplayResult: s.ScanOptions.DisplayResults,
 		Logger:        s.Log,
 		LocalSizes:    localSizes,
+		CollectTables: s.ScanOptions.Deep,
+	}
+
+	// Catat scan run di inventory (jika --save-to digunakan)
+	if inventory != nil {
+		run, err := s.startInventoryRun(ctx, inventory, serverHost, serverPort, len(dbNames))
+		if err != nil {
+			return nil, nil, err
+		}
+		opts.Inventory = inventory
+		opts.Run = run
 	}
 
 	result, detailsMap, err := helpers.ExecuteScanWithSave(
@@ -134,6 +169,15 @@ func (s *Service) executeScanWithClients(
 		serverHost, serverPort, opts,
 	)
 
+	if inventory != nil {
+		if ferr := inventory.FinishRun(ctx, opts.Run.ScanID, result, err); ferr != nil {
+			s.Log.Warnf("Gagal memperbarui status scan run: %v", ferr)
+			if result != nil {
+				result.Errors = append(result.Errors, ferr.Error())
+			}
+		}
+	}
+
 	if err != nil && s.ErrorLog != nil {
 		logFile := s.ErrorLog.Log(map[string]interface{}{
 			"type": "scan_execution",
@@ -159,3 +203,15 @@ func (s *Service) getDataDir(ctx context.Context, sourceClient *database.Client)
 	}
 	return datadir, nil
 }
+
+// validateExportPath memastikan format --export didukung sebelum proses berjalan,
+// agar kesalahan ekstensi tidak baru terdeteksi setelah scan selesai.
+func validateExportPath(path string) error {
+	if path == "" {
+		return nil
+	}
+	if err := helpers.ValidateExportPath(path); err != nil {
+		return fmt.Errorf("--export: %w", err)
+	}
+	return nil
+}
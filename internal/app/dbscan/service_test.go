@@ -0,0 +1,27 @@
+package dbscan
+
+import "testing"
+
+func TestValidateExportPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "empty means no export", path: "", wantErr: false},
+		{name: "xlsx", path: "/tmp/scan.xlsx", wantErr: false},
+		{name: "csv uppercase", path: "scan.CSV", wantErr: false},
+		{name: "json", path: "scan.json", wantErr: false},
+		{name: "unsupported extension", path: "scan.txt", wantErr: true},
+		{name: "no extension", path: "scan", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateExportPath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateExportPath(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 // Deskripsi : Setup connection, configuration loading, dan session preparation
 // Author : Hadiyatna Muflihun
 // Tanggal : 17 Desember 2025
-// Last Modified : 14 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package dbscan
 
@@ -47,8 +47,9 @@ func ResolveScanLists(opts *dbscanmodel.ScanOptions) error {
 	opts.IncludeList = listx.ListUnique(opts.IncludeList)
 	opts.ExcludeList = listx.ListUnique(opts.ExcludeList)
 
-	// Validation: minimal ada kriteria filter
-	if len(opts.IncludeList) == 0 && len(opts.ExcludeList) == 0 {
+	// Validation: minimal ada kriteria filter (mode tables/lint boleh tanpa filter = semua database)
+	unfilteredAllowed := opts.Mode == "tables" || opts.Mode == "lint"
+	if !unfilteredAllowed && len(opts.IncludeList) == 0 && len(opts.ExcludeList) == 0 {
 		return fmt.Errorf("minimal salah satu flag harus digunakan: gunakan --db/--db-file untuk include atau --exclude-db/--exclude-file untuk exclude")
 	}
 
@@ -63,14 +64,20 @@ func ResolveScanLists(opts *dbscanmodel.ScanOptions) error {
 	return nil
 }
 
-// setupScanConnections mengorkestrasi setup koneksi source database.
-// Catatan: sfdbtools tidak menyimpan hasil scan ke database, jadi tidak ada koneksi target.
-// Returns: sourceClient, dbFiltered, cleanupFunc, error
-func (s *Service) setupScanConnections(ctx context.Context, headerTitle string, showOptions bool) (*database.Client, []string, func(), error) {
+// setupScanConnections mengorkestrasi setup koneksi source database dan (opsional) database inventory.
+// Koneksi inventory dibuka sebelum scanning agar kesalahan profile/schema terdeteksi lebih awal.
+// Returns: sourceClient, dbFiltered, inventoryStore (nil jika --save-to tidak dipakai), cleanupFunc, error
+func (s *Service) setupScanConnections(ctx context.Context, headerTitle string, showOptions bool) (*database.Client, []string, *helpers.InventoryStore, func(), error) {
 	// Mode Normal: setup standar
 	sourceClient, dbFiltered, err := s.prepareScanSession(ctx, headerTitle, showOptions)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
+	}
+
+	inventory, inventoryClient, err := s.setupInventory(ctx)
+	if err != nil {
+		sourceClient.Close()
+		return nil, nil, nil, nil, err
 	}
 
 	// Cleanup function untuk menutup semua koneksi
@@ -78,9 +85,12 @@ func (s *Service) setupScanConnections(ctx context.Context, headerTitle string,
 		if sourceClient != nil {
 			sourceClient.Close()
 		}
+		if inventoryClient != nil {
+			inventoryClient.Close()
+		}
 	}
 
-	return sourceClient, dbFiltered, cleanup, nil
+	return sourceClient, dbFiltered, inventory, cleanup, nil
 }
 
 // prepareScanSession mempersiapkan session untuk scanning normal.
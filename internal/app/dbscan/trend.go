@@ -0,0 +1,313 @@
+// File : internal/app/dbscan/trend.go
+// Deskripsi : Pencatatan histori scan serta perintah trend dan forecast kapasitas
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package dbscan
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"sfdbtools/internal/app/dbscan/helpers"
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+
+	"github.com/dustin/go-humanize"
+)
+
+// recordSnapshot menyimpan snapshot hasil scan ke histori lokal untuk analisis trend.
+// Kegagalan hanya dicatat sebagai warning agar tidak menggagalkan proses scan.
+func (s *Service) recordSnapshot(ctx context.Context, client *database.Client, result *dbscanmodel.ScanResult, detailsMap map[string]dbscanmodel.DatabaseDetailInfo) {
+	histCfg := s.Config.DBScan.History
+	if histCfg.Disabled || len(detailsMap) == 0 {
+		return
+	}
+
+	snap := helpers.NewScanSnapshot(s.ScanOptions.ProfileInfo.Name, s.ScanOptions.Mode, result, detailsMap)
+	if datadir, err := s.getDataDir(ctx, client); err == nil {
+		snap.Datadir = datadir
+		// Kapasitas volume hanya bisa dibaca jika datadir berada di mesin yang sama.
+		if s.isLocalServer() {
+			if total, free, err := helpers.StatVolume(datadir); err == nil {
+				snap.VolumeTotalBytes = total
+				snap.VolumeFreeBytes = free
+			} else {
+				s.Log.Debugf("Gagal membaca kapasitas volume %s: %v", datadir, err)
+			}
+		}
+	}
+
+	path, err := helpers.AppendSnapshot(histCfg.Dir, snap, histCfg.RetentionDays)
+	if err != nil {
+		s.Log.Warnf("Gagal menyimpan histori scan: %v", err)
+		return
+	}
+	s.Log.Infof("Snapshot scan tersimpan di histori: %s", path)
+}
+
+// isLocalServer mengecek apakah server yang di-scan berjalan di mesin lokal (tanpa SSH tunnel).
+func (s *Service) isLocalServer() bool {
+	if s.ScanOptions.ProfileInfo.SSHTunnel.Enabled {
+		return false
+	}
+	switch s.ScanOptions.ProfileInfo.DBInfo.Host {
+	case "localhost", "127.0.0.1", "::1", "":
+		return true
+	}
+	return false
+}
+
+// loadHistory membaca snapshot histori sesuai opsi trend/forecast.
+func (s *Service) loadHistory(opts dbscanmodel.TrendOptions) ([]dbscanmodel.ScanSnapshot, error) {
+	since := time.Time{}
+	if opts.Days > 0 {
+		since = time.Now().AddDate(0, 0, -opts.Days)
+	}
+	snaps, err := helpers.LoadSnapshots(s.Config.DBScan.History.Dir, opts.Profile, since)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca histori scan: %w", err)
+	}
+	if len(snaps) == 0 {
+		return nil, fmt.Errorf("histori scan kosong di %s (jalankan db-scan all terlebih dahulu)", s.Config.DBScan.History.Dir)
+	}
+	return snaps, nil
+}
+
+// ExecuteTrend menampilkan pertumbuhan ukuran database dari histori scan.
+func (s *Service) ExecuteTrend(opts dbscanmodel.TrendOptions) error {
+	if err := validateExportPath(opts.ExportPath); err != nil {
+		return err
+	}
+	print.PrintAppHeader("Database Scanning - Growth Trend")
+
+	snaps, err := s.loadHistory(opts)
+	if err != nil {
+		return err
+	}
+	s.Log.Infof("Menganalisis %d snapshot histori scan", len(snaps))
+
+	series := helpers.DatabaseSeries(snaps, opts.Database)
+	if len(series) == 0 {
+		return fmt.Errorf("database %s tidak ditemukan di histori scan", opts.Database)
+	}
+
+	var headers []string
+	var rows [][]interface{}
+	if opts.Database != "" {
+		headers, rows = s.displayTrendPoints(series)
+	} else {
+		headers, rows = s.displayGrowthStats(helpers.GrowthStats(series), opts.Top)
+	}
+
+	s.displayAnomalies(helpers.DetectAnomalies(series, s.Config.DBScan.Forecast.AnomalyFactor))
+
+	if opts.ExportPath != "" {
+		if err := helpers.ExportTable(opts.ExportPath, "Trend", headers, rows); err != nil {
+			return fmt.Errorf("export trend gagal: %w", err)
+		}
+		print.PrintSuccess(fmt.Sprintf("Trend diekspor ke: %s", opts.ExportPath))
+	}
+	return nil
+}
+
+// ExecuteForecast mengestimasi kapan volume datadir setiap profile mencapai threshold.
+func (s *Service) ExecuteForecast(opts dbscanmodel.TrendOptions) error {
+	if err := validateExportPath(opts.ExportPath); err != nil {
+		return err
+	}
+	print.PrintAppHeader("Database Scanning - Capacity Forecast")
+
+	snaps, err := s.loadHistory(opts)
+	if err != nil {
+		return err
+	}
+
+	profiles := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, snap := range snaps {
+		if !seen[snap.Profile] {
+			seen[snap.Profile] = true
+			profiles = append(profiles, snap.Profile)
+		}
+	}
+	sort.Strings(profiles)
+
+	threshold := opts.ThresholdPercent
+	if threshold <= 0 {
+		threshold = s.Config.DBScan.Forecast.ThresholdPercent
+	}
+
+	print.PrintHeader(fmt.Sprintf("FORECAST KAPASITAS (threshold %.0f%%)", threshold))
+	headers := []string{"Profile", "Host", "Snapshot", "Data Size", "Volume Used", "Volume Total", "Growth/Day", "Days to Threshold", "Estimated Date", "Note"}
+	var rows [][]string
+	var exportRows [][]interface{}
+	estimated := false
+	for _, profile := range profiles {
+		fc := helpers.ForecastCapacity(profile, snaps, threshold, opts.VolumeSizeBytes)
+
+		days, date := "-", "-"
+		if fc.DaysToThreshold >= 0 {
+			days = fmt.Sprintf("%.0f", fc.DaysToThreshold)
+			if !fc.EstimatedDate.IsZero() {
+				date = fc.EstimatedDate.Format("2006-01-02")
+			}
+			if fc.DaysToThreshold < 30 {
+				days = text.ColorText(days, consts.UIColorRed)
+			}
+		}
+
+		used := humanize.Bytes(fc.VolumeUsedBytes)
+		if fc.VolumeUsedEstim {
+			used = "~" + used + " (estimasi)"
+			estimated = true
+		}
+
+		rows = append(rows, []string{
+			fc.Profile,
+			fc.ServerHost,
+			fmt.Sprintf("%d", fc.Samples),
+			humanize.Bytes(uint64(max(fc.DataSizeBytes, 0))),
+			used,
+			humanize.Bytes(fc.VolumeTotalBytes),
+			formatSignedBytes(fc.BytesPerDay),
+			days,
+			date,
+			fc.Note,
+		})
+
+		var estimatedDate interface{} = ""
+		if !fc.EstimatedDate.IsZero() {
+			estimatedDate = fc.EstimatedDate.Format("2006-01-02")
+		}
+		exportRows = append(exportRows, []interface{}{
+			fc.Profile, fc.ServerHost, fc.Datadir, fc.Samples, fc.DataSizeBytes,
+			fc.VolumeUsedBytes, fc.VolumeUsedEstim, fc.VolumeTotalBytes, fc.ThresholdPercent,
+			int64(fc.BytesPerDay), fc.DaysToThreshold, estimatedDate, fc.Note,
+		})
+	}
+	table.Render(headers, rows)
+	if estimated {
+		print.PrintWarning("Volume Used bertanda ~ adalah estimasi dari ukuran data (datadir remote tidak bisa di-statfs); " +
+			"binlog, redo/undo log, dan file lain di volume yang sama tidak terhitung sehingga forecast cenderung terlalu optimis.")
+	}
+
+	s.displayAnomalies(helpers.DetectAnomalies(helpers.DatabaseSeries(snaps, ""), s.Config.DBScan.Forecast.AnomalyFactor))
+
+	if opts.ExportPath != "" {
+		exportHeaders := []string{"Profile", "Host", "Datadir", "Snapshots", "Data Size (Bytes)", "Volume Used (Bytes)",
+			"Volume Used Estimated", "Volume Total (Bytes)", "Threshold (%)", "Growth/Day (Bytes)", "Days to Threshold", "Estimated Date", "Note"}
+		if err := helpers.ExportTable(opts.ExportPath, "Forecast", exportHeaders, exportRows); err != nil {
+			return fmt.Errorf("export forecast gagal: %w", err)
+		}
+		print.PrintSuccess(fmt.Sprintf("Forecast diekspor ke: %s", opts.ExportPath))
+	}
+	return nil
+}
+
+// displayGrowthStats menampilkan top-N database dengan pertumbuhan tertinggi dan mengembalikan baris export.
+func (s *Service) displayGrowthStats(stats []dbscanmodel.GrowthStat, top int) ([]string, [][]interface{}) {
+	if top > 0 && len(stats) > top {
+		stats = stats[:top]
+	}
+
+	print.PrintHeader(fmt.Sprintf("TOP %d PERTUMBUHAN DATABASE", len(stats)))
+	headers := []string{"Profile", "Database", "Snapshot", "First Size", "Last Size", "Growth/Day", "Growth %", "Tables +/-"}
+	var rows [][]string
+	var exportRows [][]interface{}
+	for _, st := range stats {
+		rows = append(rows, []string{
+			st.Profile,
+			st.Database,
+			fmt.Sprintf("%d", st.Samples),
+			humanize.Bytes(uint64(max(st.FirstSize, 0))),
+			humanize.Bytes(uint64(max(st.LastSize, 0))),
+			formatSignedBytes(st.BytesPerDay),
+			fmt.Sprintf("%.1f%%", st.GrowthPercent),
+			fmt.Sprintf("%+d", st.TableDelta),
+		})
+		exportRows = append(exportRows, []interface{}{
+			st.Profile, st.Database, st.Samples, st.FirstTime.Format(time.RFC3339), st.LastTime.Format(time.RFC3339),
+			st.FirstSize, st.LastSize, int64(st.BytesPerDay), st.GrowthPercent, st.TableDelta,
+		})
+	}
+	table.Render(headers, rows)
+
+	exportHeaders := []string{"Profile", "Database", "Snapshots", "First Scan", "Last Scan", "First Size (Bytes)",
+		"Last Size (Bytes)", "Growth/Day (Bytes)", "Growth (%)", "Table Delta"}
+	return exportHeaders, exportRows
+}
+
+// displayTrendPoints menampilkan titik data per snapshot untuk database tertentu dan mengembalikan baris export.
+func (s *Service) displayTrendPoints(series map[helpers.SeriesKey][]dbscanmodel.TrendPoint) ([]string, [][]interface{}) {
+	keys := make([]helpers.SeriesKey, 0, len(series))
+	for k := range series {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Profile < keys[j].Profile })
+
+	headers := []string{"Scan Time", "Size", "Delta", "Tables"}
+	exportHeaders := []string{"Profile", "Database", "Scan Time", "Size (Bytes)", "Delta (Bytes)", "Tables"}
+	var exportRows [][]interface{}
+	for _, key := range keys {
+		print.PrintHeader(fmt.Sprintf("TREND %s (%s)", key.Database, key.Profile))
+		var rows [][]string
+		for _, p := range series[key] {
+			rows = append(rows, []string{
+				p.Time.Format("2006-01-02 15:04"),
+				humanize.Bytes(uint64(max(p.SizeBytes, 0))),
+				formatSignedBytes(float64(p.DeltaBytes)),
+				fmt.Sprintf("%d", p.TableCount),
+			})
+			exportRows = append(exportRows, []interface{}{
+				key.Profile, key.Database, p.Time.Format(time.RFC3339), p.SizeBytes, p.DeltaBytes, p.TableCount,
+			})
+		}
+		table.Render(headers, rows)
+	}
+	return exportHeaders, exportRows
+}
+
+// displayAnomalies menampilkan lonjakan/penyusutan ukuran yang tidak wajar.
+func (s *Service) displayAnomalies(anomalies []dbscanmodel.SizeAnomaly) {
+	if len(anomalies) == 0 {
+		print.PrintSuccess("Tidak ada anomali ukuran database pada periode ini.")
+		return
+	}
+
+	print.PrintWarn(fmt.Sprintf("Terdeteksi %d anomali ukuran database:", len(anomalies)))
+	headers := []string{"Waktu", "Profile", "Database", "Jenis", "Sebelum", "Sesudah", "Rasio"}
+	var rows [][]string
+	for _, a := range anomalies {
+		kind := text.ColorText("Lonjakan", consts.UIColorYellow)
+		if a.Kind == helpers.AnomalyShrink {
+			kind = text.ColorText("Penyusutan", consts.UIColorRed)
+		}
+		rows = append(rows, []string{
+			a.Time.Format("2006-01-02 15:04"),
+			a.Profile,
+			a.Database,
+			kind,
+			humanize.Bytes(uint64(max(a.PrevSize, 0))),
+			humanize.Bytes(uint64(max(a.Size, 0))),
+			fmt.Sprintf("%.2fx", a.Ratio),
+		})
+		s.Log.Warnf("Anomali ukuran %s/%s (%s): %d -> %d bytes", a.Profile, a.Database, a.Kind, a.PrevSize, a.Size)
+	}
+	table.Render(headers, rows)
+}
+
+// formatSignedBytes memformat nilai bytes bertanda (mis. +1.2 MB, -300 kB).
+func formatSignedBytes(v float64) string {
+	if v < 0 {
+		return "-" + humanize.Bytes(uint64(-v))
+	}
+	return "+" + humanize.Bytes(uint64(v))
+}
@@ -0,0 +1,46 @@
+// File : internal/app/fleet/child.go
+// Deskripsi : Helper sisi proses child fleet (deteksi mode child dan exit code berbasis log error)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package fleet
+
+import (
+	"os"
+	"sync/atomic"
+
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ExitChildFailed adalah exit code child jika command mencatat error tapi tidak keluar non-zero sendiri.
+const ExitChildFailed = 3
+
+// IsChild true jika proses ini dijalankan oleh fleet runner.
+func IsChild() bool {
+	return os.Getenv(consts.ENV_FLEET_CHILD) == "1"
+}
+
+// ErrorCounterHook menghitung log level error ke atas. Banyak command hanya me-log error tanpa
+// exit non-zero; di mode child, hook ini dipakai untuk menentukan exit code agar parent tahu hasilnya.
+type ErrorCounterHook struct {
+	count atomic.Int64
+}
+
+// Levels mengembalikan level yang dihitung.
+func (h *ErrorCounterHook) Levels() []logrus.Level {
+	return []logrus.Level{logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel}
+}
+
+// Fire menambah counter.
+func (h *ErrorCounterHook) Fire(*logrus.Entry) error {
+	h.count.Add(1)
+	return nil
+}
+
+// Count mengembalikan jumlah error yang tercatat.
+func (h *ErrorCounterHook) Count() int64 {
+	return h.count.Load()
+}
@@ -0,0 +1,151 @@
+// File : internal/app/fleet/command.go
+// Deskripsi : Entry point eksekusi fleet: seleksi profile, jalankan per profile, ringkasan agregat
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	fleetmodel "sfdbtools/internal/app/fleet/model"
+	"sfdbtools/internal/app/profile/helpers/tags"
+	"sfdbtools/internal/crypto"
+	appconfig "sfdbtools/internal/services/config"
+	applog "sfdbtools/internal/services/log"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/fsops"
+)
+
+// Execute menjalankan inv ke setiap profile yang cocok dengan opts.Selector.
+// commandPath dipakai untuk label ringkasan dan nama folder run (mis. "sfdbtools db-backup all").
+// Error dikembalikan jika ada profile yang gagal; hasil per profile tetap dicatat di summary.json.
+func Execute(cfg *appconfig.Config, log applog.Logger, opts *fleetmodel.Options, inv ChildInvocation, commandPath string, interactive bool) error {
+	sel, err := tags.ParseSelector(opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	key := strings.TrimSpace(opts.ProfileKey)
+	if key == "" && (sel.NeedsMetadata() || inv.PassProfile) {
+		key, _, err = crypto.ResolveKey("", consts.ENV_SOURCE_PROFILE_KEY, interactive)
+		if err != nil {
+			return fmt.Errorf("kunci profile dibutuhkan untuk --profiles: %w", err)
+		}
+	}
+	inv.ProfileKey = key
+
+	targets, unreadable, err := SelectProfiles(cfg.ConfigDir.DatabaseProfile, sel, key, log)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		if len(unreadable) > 0 {
+			return fmt.Errorf("tidak ada profile yang cocok dengan selector %q di %s; %d profile gagal didekripsi", sel.String(), cfg.ConfigDir.DatabaseProfile, len(unreadable))
+		}
+		return fmt.Errorf("tidak ada profile yang cocok dengan selector %q di %s", sel.String(), cfg.ConfigDir.DatabaseProfile)
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = cfg.Profile.Fleet.Concurrency
+	}
+	if concurrency > len(targets) {
+		concurrency = len(targets)
+	}
+
+	names := make([]string, 0, len(targets))
+	for _, t := range targets {
+		names = append(names, t.Name)
+	}
+	log.Infof("Fleet %q: %d profile terpilih (%s), concurrency %d", sel.String(), len(targets), strings.Join(names, ", "), concurrency)
+	if opts.DryRun {
+		displayTargets(targets)
+		if len(unreadable) > 0 {
+			return fmt.Errorf("%d profile gagal didekripsi sehingga tidak dievaluasi selector", len(unreadable))
+		}
+		return nil
+	}
+
+	logDir := opts.LogDir
+	if logDir == "" {
+		logDir = cfg.Profile.Fleet.LogDir
+	}
+	started := time.Now()
+	runDir := filepath.Join(logDir, started.Format("20060102_150405")+"_"+runLabel(commandPath))
+	if err := os.MkdirAll(runDir, 0700); err != nil {
+		return fmt.Errorf("gagal membuat folder log fleet %s: %w", runDir, err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	results := Run(ctx, inv, targets, concurrency, runDir, func(done, total int, res fleetmodel.Result) {
+		if res.Status == fleetmodel.StatusOK {
+			log.Infof("[%d/%d] %s: %s (%s)", done, total, res.Profile, res.Status, res.Duration.Round(time.Millisecond))
+			return
+		}
+		log.Warnf("[%d/%d] %s: %s (%s) - %s", done, total, res.Profile, res.Status, res.Duration.Round(time.Millisecond), res.Detail)
+	})
+
+	summary := buildSummary(commandPath, sel.String(), started, runDir, append(results, unreadable...))
+	if err := writeSummary(summary); err != nil {
+		log.Warnf("Gagal menulis ringkasan fleet: %v", err)
+	}
+	displaySummary(summary)
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("fleet selesai: %d dari %d profile gagal (detail: %s)", summary.Failed, summary.Total, runDir)
+	}
+	log.Infof("Fleet selesai: %d profile berhasil (log: %s)", summary.Succeeded, runDir)
+	return nil
+}
+
+func buildSummary(commandPath, selector string, started time.Time, runDir string, results []fleetmodel.Result) *fleetmodel.Summary {
+	s := &fleetmodel.Summary{
+		Command:   commandPath,
+		Selector:  selector,
+		StartedAt: started,
+		Duration:  time.Since(started).Round(time.Millisecond).String(),
+		Total:     len(results),
+		RunDir:    runDir,
+		Results:   results,
+	}
+	for _, r := range results {
+		switch r.Status {
+		case fleetmodel.StatusOK:
+			s.Succeeded++
+		case fleetmodel.StatusFailed:
+			s.Failed++
+		default:
+			s.Skipped++
+		}
+	}
+	return s
+}
+
+func writeSummary(s *fleetmodel.Summary) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fsops.WriteFile(filepath.Join(s.RunDir, "summary.json"), data)
+}
+
+// runLabel membuat label folder dari command path, mis. "sfdbtools db-backup all" -> "db-backup_all".
+func runLabel(commandPath string) string {
+	fields := strings.Fields(commandPath)
+	if len(fields) > 1 {
+		fields = fields[1:]
+	}
+	return strings.Join(fields, "_")
+}
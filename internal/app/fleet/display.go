@@ -0,0 +1,58 @@
+// File : internal/app/fleet/display.go
+// Deskripsi : Tampilan daftar target dan ringkasan agregat fleet
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package fleet
+
+import (
+	"fmt"
+	"time"
+
+	fleetmodel "sfdbtools/internal/app/fleet/model"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+func displayTargets(targets []fleetmodel.Target) {
+	rows := make([][]string, 0, len(targets))
+	for i, t := range targets {
+		rows = append(rows, []string{fmt.Sprintf("%d", i+1), t.Name, t.Path})
+	}
+	print.PrintSubHeader("Profile Terpilih (dry-run)")
+	table.Render([]string{"No", "Profile", "Path"}, rows)
+}
+
+func displaySummary(s *fleetmodel.Summary) {
+	rows := make([][]string, 0, len(s.Results))
+	for i, r := range s.Results {
+		status := r.Status
+		switch r.Status {
+		case fleetmodel.StatusOK:
+			status = text.ColorText(status, consts.UIColorGreen)
+		case fleetmodel.StatusFailed:
+			status = text.ColorText(status, consts.UIColorRed)
+		default:
+			status = text.ColorText(status, consts.UIColorYellow)
+		}
+		duration, exit, detail := "-", "-", r.Detail
+		if r.Duration > 0 {
+			duration = r.Duration.Round(time.Millisecond).String()
+		}
+		if r.Status != fleetmodel.StatusSkipped {
+			exit = fmt.Sprintf("%d", r.ExitCode)
+		}
+		if detail == "" {
+			detail = "-"
+		}
+		rows = append(rows, []string{fmt.Sprintf("%d", i+1), r.Profile, status, duration, exit, detail})
+	}
+
+	print.PrintSubHeader("Ringkasan Fleet")
+	table.Render([]string{"No", "Profile", "Status", "Durasi", "Exit", "Keterangan"}, rows)
+	fmt.Printf("Berhasil: %d | Gagal: %d | Dilewati: %d | Total durasi: %s\n", s.Succeeded, s.Failed, s.Skipped, s.Duration)
+	fmt.Printf("Log per profile: %s\n", s.RunDir)
+}
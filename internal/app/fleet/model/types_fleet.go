@@ -0,0 +1,56 @@
+// File : internal/app/fleet/model/types_fleet.go
+// Deskripsi : Tipe data untuk eksekusi command ke banyak profile (fleet)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package types
+
+import "time"
+
+// Status hasil per profile.
+const (
+	StatusOK      = "OK"
+	StatusFailed  = "GAGAL"
+	StatusSkipped = "SKIP"
+)
+
+// Options adalah opsi fleet hasil parsing flag.
+type Options struct {
+	Selector    string // Ekspresi --profiles (mis. tag:env=prod)
+	Concurrency int    // Jumlah profile yang diproses paralel
+	ProfileKey  string // Kunci untuk membaca tag profile (juga diteruskan ke child via env)
+	LogDir      string // Folder induk output per profile
+	DryRun      bool   // Hanya tampilkan profile yang terpilih
+}
+
+// Target adalah satu profile yang akan diproses.
+type Target struct {
+	Name string
+	Path string
+}
+
+// Result adalah hasil eksekusi untuk satu profile.
+type Result struct {
+	Profile  string        `json:"profile"`
+	Path     string        `json:"path"`
+	Status   string        `json:"status"`
+	ExitCode int           `json:"exit_code"`
+	Duration time.Duration `json:"duration_ns"`
+	LogFile  string        `json:"log_file,omitempty"`
+	Detail   string        `json:"detail,omitempty"`
+}
+
+// Summary adalah ringkasan agregat satu run fleet (juga ditulis ke summary.json).
+type Summary struct {
+	Command   string    `json:"command"`
+	Selector  string    `json:"selector"`
+	StartedAt time.Time `json:"started_at"`
+	Duration  string    `json:"duration"`
+	Total     int       `json:"total"`
+	Succeeded int       `json:"succeeded"`
+	Failed    int       `json:"failed"`
+	Skipped   int       `json:"skipped"`
+	RunDir    string    `json:"run_dir"`
+	Results   []Result  `json:"results"`
+}
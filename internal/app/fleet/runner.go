@@ -0,0 +1,186 @@
+// File : internal/app/fleet/runner.go
+// Deskripsi : Menjalankan ulang command sfdbtools sebagai proses terpisah per profile dengan concurrency terbatas
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package fleet
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	fleetmodel "sfdbtools/internal/app/fleet/model"
+	"sfdbtools/internal/shared/consts"
+)
+
+// ChildInvocation mendeskripsikan cara menjalankan child per profile.
+type ChildInvocation struct {
+	Executable  string   // Path binary sfdbtools
+	Args        []string // Argumen command tanpa --profiles/--concurrency
+	PassProfile bool     // Tambahkan --profile <path> (jika command punya flag --profile)
+	ProfileKey  string   // Diteruskan via env SFDB_SOURCE_PROFILE_KEY (tidak lewat argv)
+}
+
+// ProgressFunc dipanggil setiap kali satu profile selesai.
+type ProgressFunc func(done, total int, res fleetmodel.Result)
+
+var ansiRe = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// Run menjalankan inv untuk setiap target dengan maksimal concurrency proses paralel.
+// Output setiap child ditulis ke <runDir>/<profile>.log; hasil dikembalikan sesuai urutan targets.
+func Run(ctx context.Context, inv ChildInvocation, targets []fleetmodel.Target, concurrency int, runDir string, progress ProgressFunc) []fleetmodel.Result {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	results := make([]fleetmodel.Result, len(targets))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	done := 0
+
+	for i, t := range targets {
+		wg.Add(1)
+		go func(i int, t fleetmodel.Target) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i] = fleetmodel.Result{Profile: t.Name, Path: t.Path, Status: fleetmodel.StatusSkipped, ExitCode: -1, Detail: "dibatalkan"}
+				return
+			}
+			defer func() { <-sem }()
+
+			res := runChild(ctx, inv, t, runDir)
+			results[i] = res
+
+			mu.Lock()
+			done++
+			if progress != nil {
+				progress(done, len(targets), res)
+			}
+			mu.Unlock()
+		}(i, t)
+	}
+	wg.Wait()
+	return results
+}
+
+// runChild menjalankan satu proses child untuk target.
+func runChild(ctx context.Context, inv ChildInvocation, t fleetmodel.Target, runDir string) fleetmodel.Result {
+	res := fleetmodel.Result{Profile: t.Name, Path: t.Path, LogFile: filepath.Join(runDir, t.Name+".log")}
+	start := time.Now()
+
+	logFile, err := os.OpenFile(res.LogFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		res.Status, res.ExitCode, res.Detail = fleetmodel.StatusFailed, -1, "gagal membuat file log: "+err.Error()
+		return res
+	}
+	defer logFile.Close()
+
+	extra := []string{"--quiet"}
+	if inv.PassProfile {
+		extra = append(extra, "--profile", t.Path)
+	}
+	args := insertBeforeDoubleDash(inv.Args, extra)
+
+	cmd := exec.CommandContext(ctx, inv.Executable, args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.Env = append(os.Environ(),
+		consts.ENV_FLEET_CHILD+"=1",
+		consts.ENV_FLEET_PROFILE+"="+t.Name,
+		consts.ENV_FLEET_PROFILE_PATH+"="+t.Path,
+		consts.ENV_NO_AUTO_UPDATE+"=1",
+	)
+	if inv.ProfileKey != "" {
+		cmd.Env = append(cmd.Env, consts.ENV_SOURCE_PROFILE_KEY+"="+inv.ProfileKey)
+	}
+
+	err = cmd.Run()
+	res.Duration = time.Since(start)
+	if err == nil {
+		res.Status = fleetmodel.StatusOK
+		return res
+	}
+
+	res.Status = fleetmodel.StatusFailed
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		res.ExitCode = exitErr.ExitCode()
+	} else {
+		res.ExitCode = -1
+	}
+	if ctx.Err() != nil {
+		res.Detail = "dibatalkan"
+		return res
+	}
+	res.Detail = lastErrorLine(res.LogFile)
+	if res.Detail == "" {
+		res.Detail = fmt.Sprintf("exit code %d", res.ExitCode)
+	}
+	return res
+}
+
+// lastErrorLine mengambil baris error terakhir dari log child (fallback: baris non-kosong terakhir).
+func lastErrorLine(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	var lastErr, last string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(ansiRe.ReplaceAllString(scanner.Text(), ""))
+		if line == "" {
+			continue
+		}
+		last = line
+		upper := strings.ToUpper(line)
+		if strings.Contains(upper, "ERROR") || strings.Contains(upper, "FATAL") || strings.Contains(upper, "GAGAL") {
+			lastErr = line
+		}
+	}
+	if lastErr == "" {
+		lastErr = last
+	}
+	// Buang prefix log "[waktu][LEVEL][file] - " agar ringkasan hanya berisi pesan.
+	if strings.HasPrefix(lastErr, "[") {
+		if i := strings.Index(lastErr, "] - "); i >= 0 {
+			lastErr = lastErr[i+len("] - "):]
+		}
+	}
+	return truncate(lastErr, 160)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-3] + "..."
+}
+
+// insertBeforeDoubleDash menyisipkan flag sebelum "--" agar tidak ikut diteruskan sebagai argumen script.
+func insertBeforeDoubleDash(args []string, extra []string) []string {
+	out := make([]string, 0, len(args)+len(extra))
+	for i, a := range args {
+		if a == "--" {
+			out = append(out, extra...)
+			return append(out, args[i:]...)
+		}
+		out = append(out, a)
+	}
+	return append(out, extra...)
+}
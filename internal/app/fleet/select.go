@@ -0,0 +1,58 @@
+// File : internal/app/fleet/select.go
+// Deskripsi : Seleksi profile berdasarkan selector --profiles (tag/grup/nama)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package fleet
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	fleetmodel "sfdbtools/internal/app/fleet/model"
+	"sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/parser"
+	"sfdbtools/internal/app/profile/helpers/tags"
+	"sfdbtools/internal/domain"
+	applog "sfdbtools/internal/services/log"
+	"sfdbtools/internal/shared/fsops"
+	"sfdbtools/internal/shared/validation"
+)
+
+// SelectProfiles membaca semua profile di configDir dan mengembalikan yang cocok dengan selector.
+// Jika selector memakai tag/grup, setiap profile didekripsi dengan key; profile yang gagal dibaca
+// dikembalikan sebagai hasil FAILED karena tidak bisa dievaluasi (fleet berakhir dengan error).
+func SelectProfiles(configDir string, sel *tags.Selector, key string, log applog.Logger) ([]fleetmodel.Target, []fleetmodel.Result, error) {
+	files, err := fsops.ReadDirFiles(configDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gagal membaca direktori profile '%s': %w", configDir, err)
+	}
+	sort.Strings(files)
+
+	var targets []fleetmodel.Target
+	var unreadable []fleetmodel.Result
+	for _, f := range files {
+		if validation.ProfileExt(f) != f {
+			continue
+		}
+		path := filepath.Join(configDir, f)
+		info := &domain.ProfileInfo{Name: connection.TrimProfileSuffix(f)}
+
+		if sel.NeedsMetadata() {
+			loaded, err := parser.LoadAndParseProfile(path, key)
+			if err != nil {
+				log.Warnf("Fleet: profile %s tidak dapat dievaluasi selector: %v", info.Name, err)
+				unreadable = append(unreadable, fleetmodel.Result{Profile: info.Name, Path: path, Status: fleetmodel.StatusFailed, ExitCode: -1, Detail: "gagal dekripsi saat seleksi (kunci salah?)"})
+				continue
+			}
+			loaded.Name = info.Name
+			info = loaded
+		}
+		if sel.Match(info) {
+			targets = append(targets, fleetmodel.Target{Name: info.Name, Path: path})
+		}
+	}
+	return targets, unreadable, nil
+}
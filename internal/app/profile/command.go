@@ -2,7 +2,7 @@
 // Deskripsi : Command execution functions untuk cmd layer
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 25 Januari 2026
+// Last Modified : 18 Oktober 2026
 package profile
 
 import (
@@ -61,6 +61,54 @@ var profileExecutionConfigs = map[string]profilemodel.ProfileEntryConfig{
 		SuccessMsg:  consts.ProfileSuccessImported,
 		LogPrefix:   consts.ProfileLogPrefixImport,
 	},
+	consts.ProfileModeRekey: {
+		HeaderTitle: consts.ProfileUIHeaderRekey,
+		Mode:        consts.ProfileModeRekey,
+		SuccessMsg:  consts.ProfileSuccessRekeyed,
+		LogPrefix:   consts.ProfileLogPrefixRekey,
+	},
+	consts.ProfileModeExport: {
+		HeaderTitle: consts.ProfileUIHeaderExport,
+		Mode:        consts.ProfileModeExport,
+		SuccessMsg:  consts.ProfileSuccessExported,
+		LogPrefix:   consts.ProfileLogPrefixExport,
+	},
+	consts.ProfileModeTest: {
+		HeaderTitle: consts.ProfileUIHeaderTest,
+		Mode:        consts.ProfileModeTest,
+		SuccessMsg:  consts.ProfileSuccessTested,
+		LogPrefix:   consts.ProfileLogPrefixTest,
+	},
+	consts.ProfileModeSSHTrust: {
+		HeaderTitle: consts.ProfileUIHeaderSSHTrust,
+		Mode:        consts.ProfileModeSSHTrust,
+		SuccessMsg:  consts.ProfileSuccessSSHTrust,
+		LogPrefix:   consts.ProfileLogPrefixSSHTrust,
+	},
+	consts.ProfileModeHistory: {
+		HeaderTitle: consts.ProfileUIHeaderHistory,
+		Mode:        consts.ProfileModeHistory,
+		SuccessMsg:  "",
+		LogPrefix:   consts.ProfileLogPrefixHistory,
+	},
+	consts.ProfileModeDiff: {
+		HeaderTitle: consts.ProfileUIHeaderDiff,
+		Mode:        consts.ProfileModeDiff,
+		SuccessMsg:  "",
+		LogPrefix:   consts.ProfileLogPrefixDiff,
+	},
+	consts.ProfileModeRollback: {
+		HeaderTitle: consts.ProfileUIHeaderRollback,
+		Mode:        consts.ProfileModeRollback,
+		SuccessMsg:  consts.ProfileSuccessRollback,
+		LogPrefix:   consts.ProfileLogPrefixRollback,
+	},
+	consts.ProfileModeSync: {
+		HeaderTitle: consts.ProfileUIHeaderSync,
+		Mode:        consts.ProfileModeSync,
+		SuccessMsg:  consts.ProfileSuccessSynced,
+		LogPrefix:   consts.ProfileLogPrefixSync,
+	},
 }
 
 // =============================================================================
@@ -210,6 +258,102 @@ func (c *importProfileCommand) Execute() error {
 	})
 }
 
+type rekeyProfileCommand struct {
+	cmd    *cobra.Command
+	deps   *appdeps.Dependencies
+	config profilemodel.ProfileEntryConfig
+}
+
+func (c *rekeyProfileCommand) Execute() error {
+	return executeProfileCommon(c.cmd, c.deps, c.config, func() (interface{}, error) {
+		return profileparsing.ParsingRekeyProfile(c.cmd)
+	})
+}
+
+type exportProfileCommand struct {
+	cmd    *cobra.Command
+	deps   *appdeps.Dependencies
+	config profilemodel.ProfileEntryConfig
+}
+
+func (c *exportProfileCommand) Execute() error {
+	return executeProfileCommon(c.cmd, c.deps, c.config, func() (interface{}, error) {
+		return profileparsing.ParsingExportProfile(c.cmd)
+	})
+}
+
+type testProfileCommand struct {
+	cmd    *cobra.Command
+	deps   *appdeps.Dependencies
+	config profilemodel.ProfileEntryConfig
+}
+
+func (c *testProfileCommand) Execute() error {
+	return executeProfileCommon(c.cmd, c.deps, c.config, func() (interface{}, error) {
+		return profileparsing.ParsingTestProfile(c.cmd)
+	})
+}
+
+type sshTrustProfileCommand struct {
+	cmd    *cobra.Command
+	deps   *appdeps.Dependencies
+	config profilemodel.ProfileEntryConfig
+}
+
+func (c *sshTrustProfileCommand) Execute() error {
+	return executeProfileCommon(c.cmd, c.deps, c.config, func() (interface{}, error) {
+		return profileparsing.ParsingSSHTrustProfile(c.cmd)
+	})
+}
+
+type historyProfileCommand struct {
+	cmd    *cobra.Command
+	deps   *appdeps.Dependencies
+	config profilemodel.ProfileEntryConfig
+}
+
+func (c *historyProfileCommand) Execute() error {
+	return executeProfileCommon(c.cmd, c.deps, c.config, func() (interface{}, error) {
+		return profileparsing.ParsingHistoryProfile(c.cmd)
+	})
+}
+
+type diffProfileCommand struct {
+	cmd    *cobra.Command
+	deps   *appdeps.Dependencies
+	config profilemodel.ProfileEntryConfig
+}
+
+func (c *diffProfileCommand) Execute() error {
+	return executeProfileCommon(c.cmd, c.deps, c.config, func() (interface{}, error) {
+		return profileparsing.ParsingDiffProfile(c.cmd)
+	})
+}
+
+type rollbackProfileCommand struct {
+	cmd    *cobra.Command
+	deps   *appdeps.Dependencies
+	config profilemodel.ProfileEntryConfig
+}
+
+func (c *rollbackProfileCommand) Execute() error {
+	return executeProfileCommon(c.cmd, c.deps, c.config, func() (interface{}, error) {
+		return profileparsing.ParsingRollbackProfile(c.cmd)
+	})
+}
+
+type syncProfileCommand struct {
+	cmd    *cobra.Command
+	deps   *appdeps.Dependencies
+	config profilemodel.ProfileEntryConfig
+}
+
+func (c *syncProfileCommand) Execute() error {
+	return executeProfileCommon(c.cmd, c.deps, c.config, func() (interface{}, error) {
+		return profileparsing.ParsingSyncProfile(c.cmd)
+	})
+}
+
 // NewProfileCommand membuat command executor untuk mode tertentu.
 func NewProfileCommand(mode string, cmd *cobra.Command, deps *appdeps.Dependencies, config profilemodel.ProfileEntryConfig) (ProfileCommand, error) {
 	switch mode {
@@ -225,6 +369,22 @@ func NewProfileCommand(mode string, cmd *cobra.Command, deps *appdeps.Dependenci
 		return &cloneProfileCommand{cmd: cmd, deps: deps, config: config}, nil
 	case consts.ProfileModeImport:
 		return &importProfileCommand{cmd: cmd, deps: deps, config: config}, nil
+	case consts.ProfileModeRekey:
+		return &rekeyProfileCommand{cmd: cmd, deps: deps, config: config}, nil
+	case consts.ProfileModeExport:
+		return &exportProfileCommand{cmd: cmd, deps: deps, config: config}, nil
+	case consts.ProfileModeTest:
+		return &testProfileCommand{cmd: cmd, deps: deps, config: config}, nil
+	case consts.ProfileModeSSHTrust:
+		return &sshTrustProfileCommand{cmd: cmd, deps: deps, config: config}, nil
+	case consts.ProfileModeHistory:
+		return &historyProfileCommand{cmd: cmd, deps: deps, config: config}, nil
+	case consts.ProfileModeDiff:
+		return &diffProfileCommand{cmd: cmd, deps: deps, config: config}, nil
+	case consts.ProfileModeRollback:
+		return &rollbackProfileCommand{cmd: cmd, deps: deps, config: config}, nil
+	case consts.ProfileModeSync:
+		return &syncProfileCommand{cmd: cmd, deps: deps, config: config}, nil
 	default:
 		return nil, profileerrors.ErrInvalidProfileMode
 	}
@@ -0,0 +1,397 @@
+// File : internal/app/profile/connection/capability.go
+// Deskripsi : Probe kapabilitas server (flavor, read_only, role replikasi, privilege backup/restore)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package connection
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"sfdbtools/internal/shared/database"
+)
+
+// Role replikasi server.
+const (
+	ReplicationRolePrimary    = "primary"
+	ReplicationRoleReplica    = "replica"
+	ReplicationRoleStandalone = "standalone"
+	ReplicationRoleUnknown    = "unknown"
+)
+
+// backupPrivileges adalah privilege global yang dibutuhkan mysqldump (default args) + pencatatan GTID.
+var backupPrivileges = []string{"SELECT", "SHOW VIEW", "TRIGGER", "LOCK TABLES", "EVENT", "REPLICATION CLIENT"}
+
+// restorePrivileges adalah privilege global yang dibutuhkan untuk restore dump lengkap (tabel, view, routine, event).
+var restorePrivileges = []string{
+	"CREATE", "DROP", "ALTER", "INSERT", "UPDATE", "DELETE", "INDEX",
+	"CREATE VIEW", "CREATE ROUTINE", "ALTER ROUTINE", "TRIGGER", "EVENT", "LOCK TABLES",
+}
+
+// privilegeAliases memetakan nama privilege baru ke nama yang dipakai pada daftar di atas.
+// MariaDB 10.5+ menampilkan REPLICATION CLIENT sebagai BINLOG MONITOR.
+var privilegeAliases = map[string]string{
+	"BINLOG MONITOR": "REPLICATION CLIENT",
+}
+
+var grantRe = regexp.MustCompile(`(?is)^GRANT\s+(.+?)\s+ON\s+(\S+)\s+TO\s`)
+
+// roleGrantRe cocok dengan baris grant role ("GRANT `r`@`%` TO ..."); baris privilege selalu memakai ON.
+var roleGrantRe = regexp.MustCompile(`(?is)^GRANT\s+(.+?)\s+TO\s`)
+
+// maxRoleDepth membatasi jumlah role yang diekspansi (role bisa berisi role lain).
+const maxRoleDepth = 32
+
+// PrivilegeCheck adalah hasil pengecekan privilege untuk satu jenis operasi.
+// Partial berarti sebagian grant role tidak bisa dibaca, sehingga Missing mungkin sebenarnya dipenuhi role.
+type PrivilegeCheck struct {
+	Checked bool     `json:"checked"`
+	Partial bool     `json:"partial,omitempty"`
+	Missing []string `json:"missing,omitempty"`
+}
+
+// OK bernilai true jika privilege berhasil dicek dan tidak ada yang kurang.
+func (p PrivilegeCheck) OK() bool { return p.Checked && len(p.Missing) == 0 }
+
+// Display mengembalikan ringkasan singkat untuk tabel.
+func (p PrivilegeCheck) Display() string {
+	switch {
+	case !p.Checked:
+		return "-"
+	case len(p.Missing) == 0:
+		return "OK"
+	case p.Partial:
+		return "Belum terverifikasi (grant role tidak terbaca): " + strings.Join(p.Missing, ", ")
+	default:
+		return "Kurang: " + strings.Join(p.Missing, ", ")
+	}
+}
+
+// ServerCapabilities berisi informasi server yang relevan untuk backup/restore.
+// Field yang gagal diambil dibiarkan kosong/unknown; error tidak menggagalkan test koneksi.
+type ServerCapabilities struct {
+	Flavor            string         `json:"flavor"`
+	ReadOnly          *bool          `json:"read_only,omitempty"`
+	SuperReadOnly     *bool          `json:"super_read_only,omitempty"`
+	ReplicationRole   string         `json:"replication_role"`
+	ReplicationDetail string         `json:"replication_detail,omitempty"`
+	ReplicationLag    *int64         `json:"replication_lag_seconds,omitempty"`
+	Backup            PrivilegeCheck `json:"backup_privileges"`
+	Restore           PrivilegeCheck `json:"restore_privileges"`
+	Warnings          []string       `json:"warnings,omitempty"`
+}
+
+// ReadOnlyDisplay mengembalikan status read_only untuk tabel (ON/OFF/SUPER/-).
+func (c *ServerCapabilities) ReadOnlyDisplay() string {
+	if c == nil || c.ReadOnly == nil {
+		return "-"
+	}
+	if c.SuperReadOnly != nil && *c.SuperReadOnly {
+		return "ON (super)"
+	}
+	if *c.ReadOnly {
+		return "ON"
+	}
+	return "OFF"
+}
+
+// RoleDisplay mengembalikan role replikasi beserta detail singkat (lag/status thread).
+func (c *ServerCapabilities) RoleDisplay() string {
+	if c == nil || c.ReplicationRole == "" {
+		return "-"
+	}
+	if c.ReplicationDetail != "" {
+		return c.ReplicationRole + " (" + c.ReplicationDetail + ")"
+	}
+	return c.ReplicationRole
+}
+
+// ProbeCapabilities membaca flavor, read_only, role replikasi dan privilege user dari koneksi aktif.
+// version adalah hasil SELECT VERSION() yang sudah diambil sebelumnya (boleh kosong).
+func ProbeCapabilities(ctx context.Context, client *database.Client, version string) *ServerCapabilities {
+	caps := &ServerCapabilities{ReplicationRole: ReplicationRoleUnknown}
+	db := client.DB()
+
+	caps.Flavor = detectFlavor(ctx, db, version)
+	mariadb := caps.Flavor == "MariaDB"
+
+	if v, err := queryBoolVar(ctx, db, "SELECT @@global.read_only"); err != nil {
+		caps.Warnings = append(caps.Warnings, "read_only: "+err.Error())
+	} else {
+		caps.ReadOnly = &v
+	}
+	if !mariadb {
+		// super_read_only hanya ada di MySQL/Percona.
+		if v, err := queryBoolVar(ctx, db, "SELECT @@global.super_read_only"); err == nil {
+			caps.SuperReadOnly = &v
+		}
+	}
+
+	probeReplication(ctx, db, mariadb, caps)
+
+	grants, roleErrs, err := queryEffectiveGrants(ctx, db)
+	if err != nil {
+		caps.Warnings = append(caps.Warnings, "SHOW GRANTS: "+err.Error())
+	} else {
+		for _, e := range roleErrs {
+			caps.Warnings = append(caps.Warnings, "SHOW GRANTS role: "+e.Error())
+		}
+		global := globalPrivileges(grants)
+		caps.Backup = checkPrivileges(global, backupPrivileges)
+		caps.Restore = checkPrivileges(global, restorePrivileges)
+		caps.Backup.Partial = len(roleErrs) > 0 && !caps.Backup.OK()
+		caps.Restore.Partial = len(roleErrs) > 0 && !caps.Restore.OK()
+	}
+	return caps
+}
+
+// detectFlavor menentukan flavor server dari VERSION() dan @@version_comment.
+func detectFlavor(ctx context.Context, db *sql.DB, version string) string {
+	if strings.Contains(strings.ToLower(version), "mariadb") {
+		return "MariaDB"
+	}
+	var comment sql.NullString
+	if err := db.QueryRowContext(ctx, "SELECT @@version_comment").Scan(&comment); err == nil {
+		c := strings.ToLower(comment.String)
+		switch {
+		case strings.Contains(c, "mariadb"):
+			return "MariaDB"
+		case strings.Contains(c, "percona"):
+			return "Percona Server"
+		}
+	}
+	return "MySQL"
+}
+
+func queryBoolVar(ctx context.Context, db *sql.DB, query string) (bool, error) {
+	var raw sql.NullString
+	if err := db.QueryRowContext(ctx, query).Scan(&raw); err != nil {
+		return false, err
+	}
+	switch strings.ToUpper(strings.TrimSpace(raw.String)) {
+	case "1", "ON", "TRUE":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// probeReplication mengisi role replikasi:
+// - replica jika status replica mengembalikan baris,
+// - primary jika ada thread Binlog Dump (replica terhubung),
+// - standalone jika keduanya tidak ada.
+func probeReplication(ctx context.Context, db *sql.DB, mariadb bool, caps *ServerCapabilities) {
+	queries := []string{"SHOW REPLICA STATUS", "SHOW SLAVE STATUS"}
+	if mariadb {
+		// Multi-source: tampilkan semua koneksi replikasi.
+		queries = []string{"SHOW ALL SLAVES STATUS", "SHOW SLAVE STATUS"}
+	}
+
+	var rows []map[string]string
+	var lastErr error
+	for _, q := range queries {
+		rows, lastErr = queryMaps(ctx, db, q)
+		if lastErr == nil {
+			break
+		}
+	}
+	if lastErr != nil {
+		caps.Warnings = append(caps.Warnings, "status replikasi: "+lastErr.Error())
+		return
+	}
+
+	if len(rows) > 0 {
+		caps.ReplicationRole = ReplicationRoleReplica
+		stopped := 0
+		var maxLag *int64
+		for _, r := range rows {
+			io := firstNonEmpty(r["Replica_IO_Running"], r["Slave_IO_Running"])
+			sqlRun := firstNonEmpty(r["Replica_SQL_Running"], r["Slave_SQL_Running"])
+			if !strings.EqualFold(io, "Yes") || !strings.EqualFold(sqlRun, "Yes") {
+				stopped++
+			}
+			lagStr := firstNonEmpty(r["Seconds_Behind_Source"], r["Seconds_Behind_Master"])
+			if lag, err := strconv.ParseInt(lagStr, 10, 64); err == nil && (maxLag == nil || lag > *maxLag) {
+				maxLag = &lag
+			}
+		}
+		caps.ReplicationLag = maxLag
+		switch {
+		case stopped > 0:
+			caps.ReplicationDetail = fmt.Sprintf("%d/%d thread berhenti", stopped, len(rows))
+		case maxLag != nil:
+			caps.ReplicationDetail = fmt.Sprintf("lag %ds", *maxLag)
+		default:
+			caps.ReplicationDetail = "lag unknown"
+		}
+		return
+	}
+
+	// Butuh privilege PROCESS untuk melihat thread user lain; tanpa itu hasilnya standalone.
+	var dumps int
+	err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM information_schema.PROCESSLIST WHERE COMMAND LIKE 'Binlog Dump%'").Scan(&dumps)
+	switch {
+	case err != nil:
+		caps.Warnings = append(caps.Warnings, "processlist: "+err.Error())
+		caps.ReplicationRole = ReplicationRoleStandalone
+	case dumps > 0:
+		caps.ReplicationRole = ReplicationRolePrimary
+		caps.ReplicationDetail = fmt.Sprintf("%d replica", dumps)
+	default:
+		caps.ReplicationRole = ReplicationRoleStandalone
+	}
+}
+
+// queryMaps menjalankan query dan mengembalikan setiap baris sebagai map kolom -> nilai string.
+func queryMaps(ctx context.Context, db *sql.DB, query string) ([]map[string]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var out []map[string]string
+	for rows.Next() {
+		values := make([]sql.RawBytes, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		m := make(map[string]string, len(cols))
+		for i, c := range cols {
+			m[c] = string(values[i])
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+// queryEffectiveGrants mengembalikan grant user beserta grant semua role yang diberikan kepadanya
+// (rekursif). SHOW GRANTS FOR CURRENT_USER() tidak mengekspansi privilege dari role, sehingga setiap
+// role dibaca dengan SHOW GRANTS FOR <role>. Role yang gagal dibaca dikembalikan sebagai roleErrs.
+func queryEffectiveGrants(ctx context.Context, db *sql.DB) ([]string, []error, error) {
+	grants, err := queryGrants(ctx, db, "CURRENT_USER()")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roleErrs []error
+	seen := make(map[string]bool)
+	queue := grantedRoles(grants)
+	for len(queue) > 0 && len(seen) < maxRoleDepth {
+		role := queue[0]
+		queue = queue[1:]
+		if seen[role] {
+			continue
+		}
+		seen[role] = true
+		roleGrants, err := queryGrants(ctx, db, role)
+		if err != nil {
+			roleErrs = append(roleErrs, fmt.Errorf("%s: %w", role, err))
+			continue
+		}
+		grants = append(grants, roleGrants...)
+		queue = append(queue, grantedRoles(roleGrants)...)
+	}
+	return grants, roleErrs, nil
+}
+
+// grantedRoles mengambil nama role (apa adanya, sudah di-quote server) dari baris "GRANT <role> TO ...".
+func grantedRoles(grants []string) []string {
+	var roles []string
+	for _, g := range grants {
+		g = strings.TrimSpace(g)
+		if grantRe.MatchString(g) {
+			continue
+		}
+		m := roleGrantRe.FindStringSubmatch(g)
+		if m == nil {
+			continue
+		}
+		for _, r := range strings.Split(m[1], ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				roles = append(roles, r)
+			}
+		}
+	}
+	return roles
+}
+
+func queryGrants(ctx context.Context, db *sql.DB, grantee string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SHOW GRANTS FOR "+grantee)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grants []string
+	for rows.Next() {
+		var g string
+		if err := rows.Scan(&g); err != nil {
+			return nil, err
+		}
+		grants = append(grants, g)
+	}
+	return grants, rows.Err()
+}
+
+// globalPrivileges mengumpulkan privilege yang diberikan ON *.* dari output SHOW GRANTS.
+// Grant level database/tabel diabaikan karena backup/restore berjalan lintas database.
+// Key "ALL" berarti ALL PRIVILEGES.
+func globalPrivileges(grants []string) map[string]bool {
+	privs := make(map[string]bool)
+	for _, g := range grants {
+		m := grantRe.FindStringSubmatch(strings.TrimSpace(g))
+		if m == nil || strings.ReplaceAll(m[2], "`", "") != "*.*" {
+			continue
+		}
+		for _, p := range strings.Split(m[1], ",") {
+			p = strings.ToUpper(strings.Join(strings.Fields(p), " "))
+			switch p {
+			case "ALL", "ALL PRIVILEGES":
+				privs["ALL"] = true
+			default:
+				if alias, ok := privilegeAliases[p]; ok {
+					p = alias
+				}
+				privs[p] = true
+			}
+		}
+	}
+	return privs
+}
+
+func checkPrivileges(have map[string]bool, required []string) PrivilegeCheck {
+	res := PrivilegeCheck{Checked: true}
+	if have["ALL"] {
+		return res
+	}
+	for _, p := range required {
+		if !have[p] {
+			res.Missing = append(res.Missing, p)
+		}
+	}
+	return res
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+package connection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGrantedRoles(t *testing.T) {
+	tests := []struct {
+		name   string
+		grants []string
+		want   []string
+	}{
+		{
+			name:   "privilege lines are not roles",
+			grants: []string{"GRANT SELECT, RELOAD ON *.* TO `u`@`%`", "GRANT ALL PRIVILEGES ON `db`.* TO `u`@`%`"},
+			want:   nil,
+		},
+		{
+			name:   "mysql role grant with host",
+			grants: []string{"GRANT USAGE ON *.* TO `u`@`%`", "GRANT `r_backup`@`%`,`r_restore`@`%` TO `u`@`%`"},
+			want:   []string{"`r_backup`@`%`", "`r_restore`@`%`"},
+		},
+		{
+			name:   "mariadb role grant",
+			grants: []string{"GRANT `r_backup` TO `u`@`%` WITH ADMIN OPTION", "SET DEFAULT ROLE `r_backup` FOR `u`@`%`"},
+			want:   []string{"`r_backup`"},
+		},
+		{
+			name:   "proxy grant uses ON",
+			grants: []string{"GRANT PROXY ON ''@'%' TO 'u'@'%' WITH GRANT OPTION"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grantedRoles(tt.grants); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("grantedRoles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrivilegeCheckFromGrants(t *testing.T) {
+	required := []string{"SELECT", "RELOAD", "SHOW VIEW"}
+	tests := []struct {
+		name    string
+		grants  []string
+		missing []string
+	}{
+		{
+			name:    "all privileges",
+			grants:  []string{"GRANT ALL PRIVILEGES ON *.* TO `u`@`%`"},
+			missing: nil,
+		},
+		{
+			name:    "database scoped grant does not count",
+			grants:  []string{"GRANT SELECT, RELOAD, SHOW VIEW ON `db`.* TO `u`@`%`"},
+			missing: []string{"SELECT", "RELOAD", "SHOW VIEW"},
+		},
+		{
+			name: "role grants are unioned with user grants",
+			grants: []string{
+				"GRANT SELECT ON *.* TO `u`@`%`",
+				"GRANT RELOAD, SHOW VIEW ON *.* TO `r_backup`@`%`",
+			},
+			missing: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkPrivileges(globalPrivileges(tt.grants), required)
+			if !got.Checked {
+				t.Fatalf("checkPrivileges() Checked = false")
+			}
+			if !reflect.DeepEqual(got.Missing, tt.missing) {
+				t.Fatalf("checkPrivileges() Missing = %v, want %v", got.Missing, tt.missing)
+			}
+		})
+	}
+}
+
+func TestPrivilegeCheckDisplay(t *testing.T) {
+	tests := []struct {
+		name  string
+		check PrivilegeCheck
+		want  string
+	}{
+		{name: "not checked", check: PrivilegeCheck{}, want: "-"},
+		{name: "ok", check: PrivilegeCheck{Checked: true}, want: "OK"},
+		{name: "missing", check: PrivilegeCheck{Checked: true, Missing: []string{"RELOAD"}}, want: "Kurang: RELOAD"},
+		{name: "partial", check: PrivilegeCheck{Checked: true, Partial: true, Missing: []string{"RELOAD"}}, want: "Belum terverifikasi (grant role tidak terbaca): RELOAD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.check.Display(); got != tt.want {
+				t.Fatalf("Display() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
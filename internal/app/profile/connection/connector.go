@@ -4,14 +4,13 @@ package connection
 // Deskripsi : Koneksi database berbasis ProfileInfo
 // Author : Hadiyatna Muflihun
 // Tanggal : 14 Januari 2026
-// Last Modified : 14 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 import (
 	"context"
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	profileerrors "sfdbtools/internal/app/profile/errors"
 	"sfdbtools/internal/app/profile/process"
@@ -50,8 +49,24 @@ func ConnectWithProfile(cfg interface{}, profile *domain.ProfileInfo, initialDB
 	}
 
 	modeText := "melalui koneksi langsung"
-	if profile.SSHTunnel.Enabled {
+	switch {
+	case profile.SSHTunnel.Enabled:
 		modeText = "melalui SSH Tunnel"
+	case strings.TrimSpace(profile.DBInfo.Socket) != "":
+		modeText = "melalui unix socket"
+	}
+
+	// Opsi tunnel disusun sebelum spinner karena bisa meminta passphrase identity file.
+	var tunnelOpts process.SSHTunnelOptions
+	if profile.SSHTunnel.Enabled {
+		if strings.TrimSpace(profile.SSHTunnel.Host) == "" {
+			return nil, fmt.Errorf("ssh tunnel aktif tetapi ssh-host kosong")
+		}
+		opts, err := BuildSSHTunnelOptions(profile, ProfileConnectTimeout(cfg))
+		if err != nil {
+			return nil, err
+		}
+		tunnelOpts = opts
 	}
 
 	var spin *progress.Spinner
@@ -64,34 +79,16 @@ func ConnectWithProfile(cfg interface{}, profile *domain.ProfileInfo, initialDB
 	// SSH tunnel mode: start tunnel dan arahkan koneksi ke localhost.
 	var tunnel *process.SSHTunnel
 	if profile.SSHTunnel.Enabled {
-		sshHost := strings.TrimSpace(profile.SSHTunnel.Host)
-		if sshHost == "" {
-			return nil, fmt.Errorf("ssh tunnel aktif tetapi ssh-host kosong")
-		}
-
 		ctx, cancel := context.WithTimeout(context.Background(), ProfileConnectTimeout(cfg))
 		defer cancel()
 
-		t, err := process.StartSSHTunnel(ctx, process.SSHTunnelOptions{
-			SSHHost:        sshHost,
-			SSHPort:        profile.SSHTunnel.Port,
-			SSHUser:        profile.SSHTunnel.User,
-			Password:       profile.SSHTunnel.Password,
-			IdentityFile:   profile.SSHTunnel.IdentityFile,
-			LocalPort:      profile.SSHTunnel.LocalPort,
-			RemoteHost:     profile.DBInfo.Host,
-			RemotePort:     profile.DBInfo.Port,
-			ConnectTimeout: ProfileConnectTimeout(cfg),
-			ServerAlive:    30 * time.Second,
-			ExitOnFailure:  true,
-			BatchMode:      true,
-		})
+		t, err := process.StartSSHTunnel(ctx, tunnelOpts)
 		if err != nil {
 			sshPort := profile.SSHTunnel.Port
 			if sshPort == 0 {
 				sshPort = 22
 			}
-			return nil, fmt.Errorf("gagal membuat SSH tunnel ke %s:%d: %w", sshHost, sshPort, err)
+			return nil, fmt.Errorf("gagal membuat SSH tunnel ke %s:%d: %w", tunnelOpts.SSHHost, sshPort, err)
 		}
 		tunnel = t
 		profile.SSHTunnel.ResolvedLocalPort = tunnel.LocalPort
@@ -108,6 +105,9 @@ func ConnectWithProfile(cfg interface{}, profile *domain.ProfileInfo, initialDB
 		Database:             initialDB,
 		ReadTimeout:          0,
 		WriteTimeout:         0,
+		TLS:                  info.TLS,
+		TLSServerName:        profile.DBInfo.Host,
+		Socket:               info.Socket,
 	}
 
 	client, err := database.NewClient(context.Background(), dbCfg, ProfileConnectTimeout(cfg), 10, 5, 0)
@@ -115,8 +115,8 @@ func ConnectWithProfile(cfg interface{}, profile *domain.ProfileInfo, initialDB
 		if tunnel != nil {
 			_ = tunnel.Stop(context.Background())
 		}
-		return nil, fmt.Errorf("gagal koneksi ke %s@%s:%d: %w",
-			profile.DBInfo.User, profile.DBInfo.Host, profile.DBInfo.Port, err)
+		return nil, fmt.Errorf("gagal koneksi ke %s@%s: %w",
+			profile.DBInfo.User, database.DBInfoAddr(profile.DBInfo), err)
 	}
 
 	if tunnel != nil {
@@ -2,7 +2,7 @@
 // Deskripsi : Helper untuk merangkum error koneksi DB/SSH dengan hint yang actionable
 // Author : Hadiyatna Muflihun
 // Tanggal : 9 Januari 2026
-// Last Modified : 14 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package connection
 
@@ -130,7 +130,17 @@ func classifyHints(lower string) []string {
 	}
 	if strings.Contains(lower, "gagal parse identity file") {
 		hints = append(hints,
-			"Private key tidak valid/unsupported atau butuh passphrase; coba gunakan key tanpa passphrase atau pakai ssh-agent.",
+			"Private key tidak valid/unsupported; cek format key atau pakai ssh-agent (--ssh-agent).",
+		)
+	}
+	if strings.Contains(lower, "membutuhkan passphrase") || strings.Contains(lower, "passphrase identity file") {
+		hints = append(hints,
+			"Identity file terenkripsi; set ENV SFDB_SSH_KEY_PASSPHRASE atau jalankan secara interaktif agar passphrase ditanyakan.",
+		)
+	}
+	if strings.Contains(lower, "ssh_auth_sock") || strings.Contains(lower, "ssh-agent") {
+		hints = append(hints,
+			"Pastikan ssh-agent berjalan, SSH_AUTH_SOCK diset, dan key sudah ditambahkan (ssh-add).",
 		)
 	}
 	if strings.Contains(lower, "unprotected private key file") || strings.Contains(lower, "bad permissions") {
@@ -140,12 +150,12 @@ func classifyHints(lower string) []string {
 	}
 	if strings.Contains(lower, "host key verification failed") {
 		hints = append(hints,
-			"Verifikasi host key gagal; pastikan host benar dan update entry di known_hosts yang dipakai sfdbtools.",
+			"Verifikasi host key gagal; pastikan host benar lalu jalankan 'sfdbtools profile ssh trust' untuk pin host key.",
 		)
 	}
 	if strings.Contains(lower, "known_hosts key mismatch") {
 		hints = append(hints,
-			"Host key SSH berubah/mismatch; pastikan host benar lalu update entry di file known_hosts yang disebut.",
+			"Host key SSH berubah/mismatch; verifikasi fingerprint ke admin server lalu jalankan 'sfdbtools profile ssh trust'.",
 		)
 	}
 	if strings.Contains(lower, "unable to authenticate") || strings.Contains(lower, "no supported methods remain") || strings.Contains(lower, "permission denied") {
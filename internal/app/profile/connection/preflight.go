@@ -2,7 +2,7 @@
 // Deskripsi : Preflight validation untuk koneksi DB dan SSH tunnel
 // Author : Hadiyatna Muflihun
 // Tanggal : 9 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package connection
 
@@ -14,8 +14,10 @@ import (
 	"time"
 
 	profileerrors "sfdbtools/internal/app/profile/errors"
+	"sfdbtools/internal/app/profile/process"
 	"sfdbtools/internal/domain"
 	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
 )
 
 func ValidateConnectPreflight(profile *domain.ProfileInfo) error {
@@ -26,15 +28,27 @@ func ValidateConnectPreflight(profile *domain.ProfileInfo) error {
 	host := strings.TrimSpace(profile.DBInfo.Host)
 	user := strings.TrimSpace(profile.DBInfo.User)
 	port := profile.DBInfo.Port
+	if user == "" {
+		return profileerrors.ErrDBUserEmpty
+	}
+	if err := database.ValidateTLSConfig(profile.DBInfo.TLS); err != nil {
+		return err
+	}
+
+	// Koneksi unix socket: host/port tidak dipakai dan tidak bisa digabung dengan SSH tunnel.
+	if socket := strings.TrimSpace(profile.DBInfo.Socket); socket != "" {
+		if profile.SSHTunnel.Enabled {
+			return fmt.Errorf("socket database tidak bisa dipakai bersama SSH tunnel")
+		}
+		return database.ValidateSocketPath(socket)
+	}
+
 	if host == "" {
 		return profileerrors.ErrDBHostEmpty
 	}
 	if port <= 0 || port > 65535 {
 		return fmt.Errorf("port database tidak valid: %d", port)
 	}
-	if user == "" {
-		return profileerrors.ErrDBUserEmpty
-	}
 
 	if !profile.SSHTunnel.Enabled {
 		return nil
@@ -57,6 +71,9 @@ func ValidateConnectPreflight(profile *domain.ProfileInfo) error {
 	if localPort < 0 || localPort > 65535 {
 		return fmt.Errorf("ssh local port tidak valid: %d", localPort)
 	}
+	if err := process.ValidateJumpHosts(profile.SSHTunnel.JumpHosts); err != nil {
+		return err
+	}
 
 	if identity != "" {
 		p := identity
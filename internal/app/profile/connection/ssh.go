@@ -0,0 +1,97 @@
+// File : internal/app/profile/connection/ssh.go
+// Deskripsi : Penyusunan opsi SSH tunnel dari profile (secret, passphrase, jump host)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package connection
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"sfdbtools/internal/app/profile/process"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/runtimecfg"
+	"sfdbtools/internal/ui/prompt"
+
+	"github.com/mattn/go-isatty"
+)
+
+// BuildSSHTunnelOptions menyusun opsi tunnel dari profile.
+// Password SSH harus sudah di-resolve saat profile dimuat (loader.ResolveProfileSecrets). Passphrase identity
+// file terenkripsi diambil dari ENV SFDB_SSH_KEY_PASSPHRASE, atau ditanyakan jika terminal interaktif.
+func BuildSSHTunnelOptions(profile *domain.ProfileInfo, timeout time.Duration) (process.SSHTunnelOptions, error) {
+	passphrase, err := resolveIdentityPassphrase(profile.SSHTunnel.IdentityFile)
+	if err != nil {
+		return process.SSHTunnelOptions{}, err
+	}
+
+	return process.SSHTunnelOptions{
+		SSHHost:        strings.TrimSpace(profile.SSHTunnel.Host),
+		SSHPort:        profile.SSHTunnel.Port,
+		SSHUser:        profile.SSHTunnel.User,
+		Password:       profile.SSHTunnel.Password,
+		IdentityFile:   profile.SSHTunnel.IdentityFile,
+		Passphrase:     passphrase,
+		JumpHosts:      profile.SSHTunnel.JumpHosts,
+		UseAgent:       profile.SSHTunnel.UseAgent,
+		LocalPort:      profile.SSHTunnel.LocalPort,
+		RemoteHost:     profile.DBInfo.Host,
+		RemotePort:     profile.DBInfo.Port,
+		ConnectTimeout: timeout,
+		ServerAlive:    30 * time.Second,
+		ExitOnFailure:  true,
+		BatchMode:      true,
+	}, nil
+}
+
+// SSHEntryHost mengembalikan host:port pertama yang dihubungi secara TCP (jump host pertama atau bastion).
+func SSHEntryHost(profile *domain.ProfileInfo) (string, int) {
+	for _, spec := range profile.SSHTunnel.JumpHosts {
+		if strings.TrimSpace(spec) == "" {
+			continue
+		}
+		if hop, err := process.ParseJumpHost(spec, profile.SSHTunnel.User); err == nil {
+			return hop.Host, hop.Port
+		}
+		break
+	}
+	port := profile.SSHTunnel.Port
+	if port == 0 {
+		port = 22
+	}
+	return strings.TrimSpace(profile.SSHTunnel.Host), port
+}
+
+// passphraseMu menyerialkan prompt passphrase saat banyak profile dites paralel.
+var passphraseMu sync.Mutex
+
+func resolveIdentityPassphrase(identityFile string) (string, error) {
+	if strings.TrimSpace(identityFile) == "" {
+		return "", nil
+	}
+	needs, err := process.IdentityNeedsPassphrase(identityFile)
+	if err != nil || !needs {
+		// Error baca file dilaporkan oleh tunnel agar pesan konsisten.
+		return "", nil
+	}
+	if v := os.Getenv(consts.ENV_SSH_KEY_PASSPHRASE); v != "" {
+		return v, nil
+	}
+	interactive := !runtimecfg.IsQuiet() && isatty.IsTerminal(os.Stdin.Fd()) && isatty.IsTerminal(os.Stdout.Fd())
+	if !interactive {
+		return "", fmt.Errorf("%w: %s (set %s untuk mode non-interaktif)", process.ErrIdentityPassphraseRequired, identityFile, consts.ENV_SSH_KEY_PASSPHRASE)
+	}
+	passphraseMu.Lock()
+	defer passphraseMu.Unlock()
+	passphrase, err := prompt.PromptPassword(fmt.Sprintf("Passphrase untuk %s", identityFile))
+	if err != nil {
+		return "", fmt.Errorf("gagal membaca passphrase identity file: %w", err)
+	}
+	return passphrase, nil
+}
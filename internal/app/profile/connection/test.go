@@ -2,7 +2,7 @@
 // Deskripsi : Connection test detail untuk profile (DNS/TCP/SSH/DB)
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package connection
 
@@ -69,6 +69,9 @@ type ConnectionTestReport struct {
 	TotalLatency   time.Duration
 	Healthy        bool
 
+	// Capabilities hanya terisi oleh TestConnectionWithCapabilities saat autentikasi berhasil.
+	Capabilities *ServerCapabilities
+
 	// Err berisi error utama yang paling relevan untuk hint.
 	Err error
 }
@@ -77,8 +80,19 @@ type ConnectionTestReport struct {
 // Catatan:
 // - Untuk SSH tunnel: DNS/TCP test diarahkan ke SSH host.
 // - Untuk direct: DNS/TCP test diarahkan ke DB host.
+// - Untuk unix socket: DNS dilewati dan step TCP melakukan dial ke socket.
 // - Fungsi ini tidak menampilkan spinner; cocok untuk dipanggil dari UI summary/table.
 func TestConnection(cfg interface{}, profile *domain.ProfileInfo, initialDB string) *ConnectionTestReport {
+	return testConnection(cfg, profile, initialDB, false)
+}
+
+// TestConnectionWithCapabilities sama seperti TestConnection, ditambah probe kapabilitas server
+// (flavor, read_only, role replikasi, privilege backup/restore) selama koneksi masih terbuka.
+func TestConnectionWithCapabilities(cfg interface{}, profile *domain.ProfileInfo, initialDB string) *ConnectionTestReport {
+	return testConnection(cfg, profile, initialDB, true)
+}
+
+func testConnection(cfg interface{}, profile *domain.ProfileInfo, initialDB string, withCapabilities bool) *ConnectionTestReport {
 	report := &ConnectionTestReport{}
 	start := time.Now()
 	defer func() {
@@ -110,25 +124,26 @@ func TestConnection(cfg interface{}, profile *domain.ProfileInfo, initialDB stri
 		initialDB = consts.DefaultInitialDatabase
 	}
 
-	// Tentukan target DNS/TCP: SSH host (jika tunnel), else DB host.
+	// Tentukan target DNS/TCP: hop SSH pertama (jump host/bastion) jika tunnel, else DB host.
 	dnsHost := strings.TrimSpace(profile.DBInfo.Host)
 	tcpHost := dnsHost
 	tcpPort := profile.DBInfo.Port
 	if profile.SSHTunnel.Enabled {
-		sshHost := strings.TrimSpace(profile.SSHTunnel.Host)
+		sshHost, sshPort := SSHEntryHost(profile)
 		if sshHost != "" {
 			dnsHost = sshHost
 			tcpHost = sshHost
 		}
-		sshPort := profile.SSHTunnel.Port
-		if sshPort == 0 {
-			sshPort = 22
-		}
 		tcpPort = sshPort
 	}
 
-	// Step 1: DNS resolution
-	report.DNSResolution = testDNS(timeout, dnsHost)
+	// Step 1: DNS resolution (tidak relevan untuk unix socket)
+	socket := strings.TrimSpace(profile.DBInfo.Socket)
+	if socket != "" {
+		report.DNSResolution = StepResult{Status: StepStatusSkipped, Detail: "unix socket"}
+	} else {
+		report.DNSResolution = testDNS(timeout, dnsHost)
+	}
 	if report.DNSResolution.Status == StepStatusFailed {
 		report.Err = report.DNSResolution.Err
 		report.TCPConnection = StepResult{Status: StepStatusDisabled}
@@ -137,8 +152,12 @@ func TestConnection(cfg interface{}, profile *domain.ProfileInfo, initialDB stri
 		return report
 	}
 
-	// Step 2: TCP connection
-	report.TCPConnection = testTCP(timeout, tcpHost, tcpPort)
+	// Step 2: TCP connection (atau dial unix socket)
+	if socket != "" {
+		report.TCPConnection = testSocket(timeout, socket)
+	} else {
+		report.TCPConnection = testTCP(timeout, tcpHost, tcpPort)
+	}
 	if report.TCPConnection.Status == StepStatusFailed {
 		report.Err = report.TCPConnection.Err
 		report.SSHTunnel = StepResult{Status: StepStatusDisabled}
@@ -153,20 +172,15 @@ func TestConnection(cfg interface{}, profile *domain.ProfileInfo, initialDB stri
 		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
-		t, err := process.StartSSHTunnel(ctx, process.SSHTunnelOptions{
-			SSHHost:        strings.TrimSpace(profile.SSHTunnel.Host),
-			SSHPort:        profile.SSHTunnel.Port,
-			SSHUser:        profile.SSHTunnel.User,
-			Password:       profile.SSHTunnel.Password,
-			IdentityFile:   profile.SSHTunnel.IdentityFile,
-			LocalPort:      profile.SSHTunnel.LocalPort,
-			RemoteHost:     profile.DBInfo.Host,
-			RemotePort:     profile.DBInfo.Port,
-			ConnectTimeout: timeout,
-			ServerAlive:    30 * time.Second,
-			ExitOnFailure:  true,
-			BatchMode:      true,
-		})
+		tunnelOpts, err := BuildSSHTunnelOptions(profile, timeout)
+		if err != nil {
+			report.SSHTunnel = StepResult{Status: StepStatusFailed, Duration: time.Since(tunnelStart), Detail: "ssh secret", Err: err}
+			report.Err = err
+			report.Authentication = StepResult{Status: StepStatusDisabled}
+			return report
+		}
+
+		t, err := process.StartSSHTunnel(ctx, tunnelOpts)
 		if err != nil {
 			report.SSHTunnel = StepResult{Status: StepStatusFailed, Duration: time.Since(tunnelStart), Detail: "ssh tunnel", Err: err}
 			report.Err = fmt.Errorf("ssh tunnel gagal: %w", err)
@@ -193,6 +207,9 @@ func TestConnection(cfg interface{}, profile *domain.ProfileInfo, initialDB stri
 		Database:             initialDB,
 		ReadTimeout:          0,
 		WriteTimeout:         0,
+		TLS:                  info.TLS,
+		TLSServerName:        profile.DBInfo.Host,
+		Socket:               info.Socket,
 	}
 
 	client, err := database.NewClient(context.Background(), dbCfg, timeout, 2, 1, 0)
@@ -226,6 +243,13 @@ func TestConnection(cfg interface{}, profile *domain.ProfileInfo, initialDB stri
 	}
 	report.DBVersion = ver
 
+	// Step 6: kapabilitas server (optional, tidak mempengaruhi health)
+	if withCapabilities {
+		capCtx, capCancel := context.WithTimeout(context.Background(), timeout)
+		defer capCancel()
+		report.Capabilities = ProbeCapabilities(capCtx, client, ver)
+	}
+
 	return report
 }
 
@@ -274,6 +298,16 @@ func testTCP(timeout time.Duration, host string, port int) StepResult {
 	return StepResult{Status: StepStatusSuccess, Duration: time.Since(start)}
 }
 
+func testSocket(timeout time.Duration, path string) StepResult {
+	start := time.Now()
+	conn, err := net.DialTimeout("unix", path, timeout)
+	if err != nil {
+		return StepResult{Status: StepStatusFailed, Duration: time.Since(start), Detail: "socket", Err: err}
+	}
+	_ = conn.Close()
+	return StepResult{Status: StepStatusSuccess, Duration: time.Since(start), Detail: "unix socket"}
+}
+
 func formatDurationMs(d time.Duration) string {
 	if d <= 0 {
 		return "0ms"
@@ -2,7 +2,7 @@
 // Deskripsi : Tampilan detail profil (show/create/edit summary)
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package display
 
@@ -30,6 +30,14 @@ func displayStateSetOrNotSet(value string) string {
 	return consts.ProfileDisplayStateSet
 }
 
+// displayYesNo mengembalikan label Yes/No untuk nilai boolean.
+func displayYesNo(v bool) string {
+	if v {
+		return consts.ProfileDisplayValueYes
+	}
+	return consts.ProfileDisplayValueNo
+}
+
 type Displayer struct {
 	ConfigDir string
 	State     *profilemodel.ProfileState // Shared state pointer
@@ -2,7 +2,7 @@
 // Deskripsi : Formatter untuk tampilan show profile
 // Author : Hadiyatna Muflihun
 // Tanggal : 14 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package display
 
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/loader"
+	"sfdbtools/internal/app/profile/helpers/tags"
 	"sfdbtools/internal/shared/consts"
 	"sfdbtools/internal/ui/print"
 	"sfdbtools/internal/ui/table"
@@ -31,7 +33,16 @@ func (d *Displayer) printShowDetails() {
 	// Jalankan test koneksi (hanya untuk mode interaktif), tapi tetap tampilkan barisnya.
 	var report *profileconn.ConnectionTestReport
 	if isInteractive {
-		report = profileconn.TestConnection(nil, orig, consts.DefaultInitialDatabase)
+		// Profile show menyimpan referensi secret apa adanya; koneksi memakai salinan yang sudah di-resolve.
+		if resolved, err := loader.WithResolvedSecrets(orig); err != nil {
+			report = &profileconn.ConnectionTestReport{
+				Authentication: profileconn.StepResult{Status: profileconn.StepStatusFailed, Detail: "db secret", Err: err},
+				DBVersion:      "-",
+				Err:            err,
+			}
+		} else {
+			report = profileconn.TestConnection(nil, resolved, consts.DefaultInitialDatabase)
+		}
 	} else {
 		report = &profileconn.ConnectionTestReport{
 			DNSResolution:  profileconn.StepResult{Status: profileconn.StepStatusSkipped, Detail: "non-interaktif"},
@@ -78,6 +89,8 @@ func (d *Displayer) printShowDetails() {
 		{consts.ProfileDisplayFieldName, orig.Name},
 		{consts.ProfileDisplayFieldFilePath, orig.Path},
 		{consts.ProfileDisplayFieldLastModified, fmt.Sprintf("%v", orig.LastModified)},
+		{consts.ProfileDisplayFieldTags, displayValueOrNotSet(tags.FormatTags(orig.Tags))},
+		{consts.ProfileDisplayFieldGroups, displayValueOrNotSet(strings.Join(orig.Groups, ","))},
 	})
 
 	addGroup(consts.ProfileDisplayCategoryDBInfo, [][2]string{
@@ -85,6 +98,11 @@ func (d *Displayer) printShowDetails() {
 		{consts.ProfileDisplayFieldPort, fmt.Sprintf("%d", orig.DBInfo.Port)},
 		{consts.ProfileDisplayFieldUser, orig.DBInfo.User},
 		{consts.ProfileDisplayFieldPassword, displayStateSetOrNotSet(orig.DBInfo.Password)},
+		{consts.ProfileLabelDBSocket, displayValueOrNotSet(orig.DBInfo.Socket)},
+		{consts.ProfileLabelSSLMode, displayValueOrNotSet(orig.DBInfo.TLS.Mode)},
+		{consts.ProfileLabelSSLCA, displayValueOrNotSet(orig.DBInfo.TLS.CA)},
+		{consts.ProfileLabelSSLCert, displayValueOrNotSet(orig.DBInfo.TLS.Cert)},
+		{consts.ProfileLabelSSLKey, displayValueOrNotSet(orig.DBInfo.TLS.Key)},
 	})
 
 	addGroup(consts.ProfileDisplayCategorySSHTunnel, [][2]string{
@@ -94,6 +112,8 @@ func (d *Displayer) printShowDetails() {
 		{consts.ProfileLabelSSHUser, displayValueOrNotSet(orig.SSHTunnel.User)},
 		{consts.ProfileLabelSSHPassword, displayStateSetOrNotSet(orig.SSHTunnel.Password)},
 		{consts.ProfileLabelSSHIdentityFile, sshIdentity},
+		{consts.ProfileLabelSSHJumpHosts, displayValueOrNotSet(strings.Join(orig.SSHTunnel.JumpHosts, ", "))},
+		{consts.ProfileLabelSSHAgent, displayYesNo(orig.SSHTunnel.UseAgent)},
 	})
 
 	addGroup(consts.ProfileDisplayCategoryTestResult, [][2]string{
@@ -2,13 +2,15 @@
 // Deskripsi : Summary untuk create/edit profile
 // Author : Hadiyatna Muflihun
 // Tanggal : 14 Januari 2026
-// Last Modified : 14 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package display
 
 import (
 	"fmt"
+	"strings"
 
+	"sfdbtools/internal/app/profile/helpers/tags"
 	"sfdbtools/internal/shared/consts"
 	"sfdbtools/internal/ui/print"
 	"sfdbtools/internal/ui/table"
@@ -28,17 +30,34 @@ func (d *Displayer) printCreateSummary() {
 
 	pwState := displayStateSetOrNotSet(d.State.ProfileInfo.DBInfo.Password)
 	rows = append(rows, []string{"5", consts.ProfileDisplayFieldPassword, pwState})
+	if socket := d.State.ProfileInfo.DBInfo.Socket; socket != "" {
+		rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileLabelDBSocket, socket})
+	}
+
+	if tlsCfg := d.State.ProfileInfo.DBInfo.TLS; tlsCfg.Mode != "" {
+		rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileLabelSSLMode, tlsCfg.Mode})
+		if tlsCfg.CA != "" {
+			rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileLabelSSLCA, tlsCfg.CA})
+		}
+		if tlsCfg.Cert != "" {
+			rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileLabelSSLCert, tlsCfg.Cert})
+		}
+	}
 
 	sshState := consts.ProfileDisplaySSHDisabled
 	if d.State.ProfileInfo.SSHTunnel.Enabled {
 		sshState = consts.ProfileDisplaySSHEnabled
 	}
-	rows = append(rows, []string{"6", consts.ProfileDisplayFieldSSHTunnel, sshState})
+	rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileDisplayFieldSSHTunnel, sshState})
 
 	if d.State.ProfileInfo.SSHTunnel.Enabled {
-		rows = append(rows, []string{"7", consts.ProfileLabelSSHHost, d.State.ProfileInfo.SSHTunnel.Host})
+		rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileLabelSSHHost, d.State.ProfileInfo.SSHTunnel.Host})
 		sshPwState := displayStateSetOrNotSet(d.State.ProfileInfo.SSHTunnel.Password)
-		rows = append(rows, []string{"8", consts.ProfileLabelSSHPassword, sshPwState})
+		rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileLabelSSHPassword, sshPwState})
+	}
+	if len(d.State.ProfileInfo.Tags) > 0 || len(d.State.ProfileInfo.Groups) > 0 {
+		rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileDisplayFieldTags, displayValueOrNotSet(tags.FormatTags(d.State.ProfileInfo.Tags))})
+		rows = append(rows, []string{fmt.Sprintf("%d", len(rows)+1), consts.ProfileDisplayFieldGroups, displayValueOrNotSet(strings.Join(d.State.ProfileInfo.Groups, ","))})
 	}
 
 	table.Render([]string{consts.ProfileDisplayTableHeaderNo, consts.ProfileDisplayTableHeaderField, consts.ProfileDisplayTableHeaderValue}, rows)
@@ -76,6 +95,21 @@ func (d *Displayer) printChangeSummary() {
 		rows = append(rows, []string{fmt.Sprintf("%d", idx), consts.ProfileDisplayFieldPassword, pwState(orig.DBInfo.Password), pwState(d.State.ProfileInfo.DBInfo.Password)})
 		idx++
 	}
+	dbRows := []struct {
+		label, before, after string
+	}{
+		{consts.ProfileLabelDBSocket, orig.DBInfo.Socket, d.State.ProfileInfo.DBInfo.Socket},
+		{consts.ProfileLabelSSLMode, orig.DBInfo.TLS.Mode, d.State.ProfileInfo.DBInfo.TLS.Mode},
+		{consts.ProfileLabelSSLCA, orig.DBInfo.TLS.CA, d.State.ProfileInfo.DBInfo.TLS.CA},
+		{consts.ProfileLabelSSLCert, orig.DBInfo.TLS.Cert, d.State.ProfileInfo.DBInfo.TLS.Cert},
+		{consts.ProfileLabelSSLKey, orig.DBInfo.TLS.Key, d.State.ProfileInfo.DBInfo.TLS.Key},
+	}
+	for _, r := range dbRows {
+		if r.before != r.after {
+			rows = append(rows, []string{fmt.Sprintf("%d", idx), r.label, displayValueOrNotSet(r.before), displayValueOrNotSet(r.after)})
+			idx++
+		}
+	}
 	if orig.SSHTunnel.Enabled != d.State.ProfileInfo.SSHTunnel.Enabled {
 		rows = append(rows, []string{fmt.Sprintf("%d", idx), consts.ProfileDisplayFieldSSHTunnel, fmt.Sprintf("%v", orig.SSHTunnel.Enabled), fmt.Sprintf("%v", d.State.ProfileInfo.SSHTunnel.Enabled)})
 		idx++
@@ -84,10 +118,26 @@ func (d *Displayer) printChangeSummary() {
 		rows = append(rows, []string{fmt.Sprintf("%d", idx), consts.ProfileLabelSSHHost, orig.SSHTunnel.Host, d.State.ProfileInfo.SSHTunnel.Host})
 		idx++
 	}
+	if before, after := strings.Join(orig.SSHTunnel.JumpHosts, ","), strings.Join(d.State.ProfileInfo.SSHTunnel.JumpHosts, ","); before != after {
+		rows = append(rows, []string{fmt.Sprintf("%d", idx), consts.ProfileLabelSSHJumpHosts, displayValueOrNotSet(before), displayValueOrNotSet(after)})
+		idx++
+	}
+	if orig.SSHTunnel.UseAgent != d.State.ProfileInfo.SSHTunnel.UseAgent {
+		rows = append(rows, []string{fmt.Sprintf("%d", idx), consts.ProfileLabelSSHAgent, displayYesNo(orig.SSHTunnel.UseAgent), displayYesNo(d.State.ProfileInfo.SSHTunnel.UseAgent)})
+		idx++
+	}
 	if orig.SSHTunnel.Password != d.State.ProfileInfo.SSHTunnel.Password {
 		rows = append(rows, []string{fmt.Sprintf("%d", idx), consts.ProfileLabelSSHPassword, pwState(orig.SSHTunnel.Password), pwState(d.State.ProfileInfo.SSHTunnel.Password)})
 		idx++
 	}
+	if before, after := tags.FormatTags(orig.Tags), tags.FormatTags(d.State.ProfileInfo.Tags); before != after {
+		rows = append(rows, []string{fmt.Sprintf("%d", idx), consts.ProfileDisplayFieldTags, displayValueOrNotSet(before), displayValueOrNotSet(after)})
+		idx++
+	}
+	if before, after := strings.Join(orig.Groups, ","), strings.Join(d.State.ProfileInfo.Groups, ","); before != after {
+		rows = append(rows, []string{fmt.Sprintf("%d", idx), consts.ProfileDisplayFieldGroups, displayValueOrNotSet(before), displayValueOrNotSet(after)})
+		idx++
+	}
 
 	if len(rows) == 0 {
 		print.PrintInfo(consts.ProfileDisplayNoChangesDetected)
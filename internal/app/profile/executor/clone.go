@@ -2,13 +2,14 @@
 // Deskripsi : Eksekusi clone profile
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package executor
 
 import (
 	"fmt"
 	profiledisplay "sfdbtools/internal/app/profile/display"
+	"sfdbtools/internal/app/profile/helpers/tags"
 	profilemodel "sfdbtools/internal/app/profile/model"
 	profilevalidation "sfdbtools/internal/app/profile/validation"
 	"sfdbtools/internal/domain"
@@ -174,6 +175,8 @@ func (e *Executor) setupTargetProfileFromSource(cloneOpts *profilemodel.ProfileC
 		Port:     sourceInfo.DBInfo.Port,
 		User:     sourceInfo.DBInfo.User,
 		Password: sourceInfo.DBInfo.Password,
+		TLS:      sourceInfo.DBInfo.TLS,
+		Socket:   sourceInfo.DBInfo.Socket,
 	}
 
 	e.State.ProfileInfo.SSHTunnel = domain.SSHTunnelConfig{
@@ -183,8 +186,11 @@ func (e *Executor) setupTargetProfileFromSource(cloneOpts *profilemodel.ProfileC
 		User:         sourceInfo.SSHTunnel.User,
 		Password:     sourceInfo.SSHTunnel.Password,
 		IdentityFile: sourceInfo.SSHTunnel.IdentityFile,
+		JumpHosts:    append([]string(nil), sourceInfo.SSHTunnel.JumpHosts...),
+		UseAgent:     sourceInfo.SSHTunnel.UseAgent,
 		LocalPort:    sourceInfo.SSHTunnel.LocalPort,
 	}
+	e.State.ProfileInfo.Tags, e.State.ProfileInfo.Groups = tags.Apply(sourceInfo.Tags, sourceInfo.Groups, nil, nil, nil, nil)
 
 	// Apply overrides dari flags
 	if cloneOpts.TargetName != "" {
@@ -196,6 +202,8 @@ func (e *Executor) setupTargetProfileFromSource(cloneOpts *profilemodel.ProfileC
 
 	if cloneOpts.TargetHost != "" {
 		e.State.ProfileInfo.DBInfo.Host = cloneOpts.TargetHost
+		// Socket lokal source tidak berlaku untuk host lain.
+		e.State.ProfileInfo.DBInfo.Socket = ""
 	}
 
 	if cloneOpts.TargetPort > 0 {
@@ -2,7 +2,7 @@
 // Deskripsi : Eksekusi hapus profile
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package executor
 
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"path/filepath"
 
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/history"
 	"sfdbtools/internal/shared/consts"
 	"sfdbtools/internal/shared/fsops"
 	"sfdbtools/internal/shared/validation"
@@ -53,6 +55,7 @@ func (e *Executor) collectValidPathsFromFlags(profiles []string) (validPaths []s
 
 func (e *Executor) deletePaths(paths []string, logSuccessFmt string, showErrorOnFail bool, logFailAsError bool) {
 	for _, p := range paths {
+		e.recordProfileHistory(profileconn.TrimProfileSuffix(filepath.Base(p)), p, history.ActionDelete, nil, "")
 		if err := fsops.RemoveFile(p); err != nil {
 			if logFailAsError {
 				e.Log.Error(fmt.Sprintf(consts.ProfileDeleteFailedFmt, p, err))
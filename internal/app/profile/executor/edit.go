@@ -2,7 +2,7 @@
 // Deskripsi : Eksekusi edit profile
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package executor
 
@@ -86,6 +86,9 @@ func (e *Executor) EditProfile() error {
 			merger.ApplySnapshotAsBaseline(e.State.ProfileInfo, e.State.OriginalProfileInfo)
 			merger.ApplyDBOverrides(e.State.ProfileInfo, overrideDB)
 			merger.ApplySSHOverrides(e.State.ProfileInfo, overrideSSH)
+			if editOpts != nil {
+				merger.ApplyTagOverrides(e.State.ProfileInfo, editOpts.SetTags, editOpts.UnsetTags, editOpts.AddGroups, editOpts.RemoveGroups)
+			}
 
 			{
 				e.Log.Info(consts.ProfileLogValidatingParams)
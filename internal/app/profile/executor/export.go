@@ -0,0 +1,198 @@
+// File : internal/app/profile/executor/export.go
+// Deskripsi : Export profile ke XLSX/CSV dengan schema yang sama seperti profile import (round-trip)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package executor
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/exporter"
+	"sfdbtools/internal/app/profile/helpers/parser"
+	"sfdbtools/internal/app/profile/helpers/tags"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/fsops"
+	"sfdbtools/internal/shared/sheetx"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// ExportProfiles mendekripsi profile di config dir dan menulisnya ke XLSX/CSV.
+// Output dapat di-import kembali dengan `profile import --input <file>`.
+func (e *Executor) ExportProfiles() error {
+	opts, ok := e.State.ExportOptions()
+	if !ok || opts == nil {
+		return fmt.Errorf("internal error: export options tidak tersedia")
+	}
+
+	format, err := exporter.FormatFromPath(opts.Output)
+	if err != nil {
+		return err
+	}
+	if format == exporter.FormatCSV && opts.EncryptKey != "" {
+		return fmt.Errorf("--encrypt-key hanya didukung untuk output .xlsx")
+	}
+	if fsops.PathExists(opts.Output) && !opts.Force {
+		return fmt.Errorf("file output sudah ada: %s (gunakan --force untuk menimpa)", opts.Output)
+	}
+
+	files, err := fsops.ReadDirFiles(e.ConfigDir)
+	if err != nil {
+		return fmt.Errorf("gagal membaca direktori konfigurasi '%s': %w", e.ConfigDir, err)
+	}
+	var targets []string
+	for _, f := range filterProfileConfigFiles(files) {
+		name := connection.TrimProfileSuffix(f)
+		matched, err := matchAnyGlob(opts.Names, name)
+		if err != nil {
+			return err
+		}
+		if matched {
+			targets = append(targets, f)
+		}
+	}
+	if len(targets) == 0 {
+		print.PrintWarn("Tidak ada profile yang cocok untuk di-export di: " + e.ConfigDir)
+		return nil
+	}
+
+	keyMap, err := loadRekeyKeyMap(opts.KeyMapFile)
+	if err != nil {
+		return err
+	}
+	defaultKey := opts.ProfileKey
+	if defaultKey == "" && len(keyMap) == 0 {
+		defaultKey, _, err = crypto.ResolveKey("", consts.ENV_SOURCE_PROFILE_KEY, e.isInteractiveMode())
+		if err != nil {
+			return fmt.Errorf("kunci profile tidak tersedia: %w", err)
+		}
+	}
+
+	rows := make([][]string, 0, len(targets))
+	report := make([][]string, 0, len(targets))
+	skipped := 0
+	for _, f := range targets {
+		name := connection.TrimProfileSuffix(f)
+		key := defaultKey
+		if k, ok := keyMap[name]; ok {
+			key = k
+		}
+		if key == "" {
+			skipped++
+			report = append(report, []string{name, text.ColorText("SKIP", consts.UIColorYellow), "kunci tidak ada di key-map maupun --profile-key"})
+			continue
+		}
+
+		info, err := parser.LoadAndParseProfile(filepath.Join(e.ConfigDir, f), key)
+		if err != nil {
+			skipped++
+			report = append(report, []string{name, text.ColorText("SKIP", consts.UIColorYellow), "gagal dekripsi (kunci salah?)"})
+			e.Log.Warnf("Export: profile %s dilewati: %v", name, err)
+			continue
+		}
+		info.Name = name
+		rows = append(rows, profileExportRow(info, key, opts.Redact))
+		report = append(report, []string{name, text.ColorText("OK", consts.UIColorGreen), "-"})
+	}
+
+	if len(rows) > 0 {
+		switch format {
+		case exporter.FormatXLSX:
+			err = sheetx.WriteXLSX(opts.Output, exporter.DefaultSheetName, parser.ImportColumns, sheetx.TextRows(rows), opts.EncryptKey)
+		default:
+			err = sheetx.WriteCSV(opts.Output, parser.ImportColumns, sheetx.TextRows(rows))
+		}
+		if err != nil {
+			return fmt.Errorf("gagal menulis %s: %w", opts.Output, err)
+		}
+	}
+
+	print.PrintSubHeader("Hasil Export Profile")
+	table.Render([]string{"Profile", "Status", "Keterangan"}, report)
+	if len(rows) == 0 {
+		return fmt.Errorf("tidak ada profile yang berhasil di-export (%d dilewati)", skipped)
+	}
+
+	abs, _ := filepath.Abs(opts.Output)
+	e.Log.Infof("Export %d profile ke %s (%d dilewati, redact=%t, terenkripsi=%t)", len(rows), abs, skipped, opts.Redact, opts.EncryptKey != "")
+	print.PrintInfo(fmt.Sprintf("%d profile di-export ke %s", len(rows), abs))
+	if opts.Redact {
+		print.PrintWarn("Password dan profile_key dikosongkan (--redact); isi kembali sebelum di-import.")
+	} else if opts.EncryptKey == "" {
+		print.PrintWarn("File berisi password dan profile_key dalam plaintext. Simpan dengan aman atau gunakan --encrypt-key/--redact.")
+	}
+	return nil
+}
+
+// profileExportRow menyusun satu baris sesuai urutan parser.ImportColumns.
+func profileExportRow(info *domain.ProfileInfo, key string, redact bool) []string {
+	password, sshPassword := info.DBInfo.Password, info.SSHTunnel.Password
+	if redact {
+		password, sshPassword, key = "", "", ""
+	}
+
+	ssh := info.SSHTunnel
+	sshPort, sshLocal, sshAgent := "", "", ""
+	if ssh.Enabled || ssh.Host != "" {
+		sshPort = strconv.Itoa(ssh.Port)
+		sshAgent = strconv.FormatBool(ssh.UseAgent)
+		if ssh.LocalPort > 0 {
+			sshLocal = strconv.Itoa(ssh.LocalPort)
+		}
+	}
+
+	return []string{
+		info.Name,
+		info.DBInfo.Host,
+		strconv.Itoa(info.DBInfo.Port),
+		info.DBInfo.User,
+		password,
+		key,
+		info.DBInfo.Socket,
+		strconv.FormatBool(ssh.Enabled),
+		ssh.Host,
+		sshPort,
+		ssh.User,
+		sshPassword,
+		ssh.IdentityFile,
+		sshLocal,
+		strings.Join(ssh.JumpHosts, ","),
+		sshAgent,
+		info.DBInfo.TLS.Mode,
+		info.DBInfo.TLS.CA,
+		info.DBInfo.TLS.Cert,
+		info.DBInfo.TLS.Key,
+		tags.FormatTags(info.Tags),
+		strings.Join(info.Groups, ","),
+	}
+}
+
+// matchAnyGlob mengecek nama terhadap daftar glob. Daftar kosong berarti cocok semua.
+func matchAnyGlob(patterns []string, name string) (bool, error) {
+	if len(patterns) == 0 {
+		return true, nil
+	}
+	for _, p := range patterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		ok, err := filepath.Match(p, name)
+		if err != nil {
+			return false, fmt.Errorf("pola --name tidak valid %q: %w", p, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,80 @@
+package executor
+
+import (
+	"slices"
+	"testing"
+
+	"sfdbtools/internal/app/profile/helpers/parser"
+	"sfdbtools/internal/domain"
+)
+
+func TestProfileExportRow(t *testing.T) {
+	info := &domain.ProfileInfo{Name: "prod"}
+	info.DBInfo.Host = "10.0.0.1"
+	info.DBInfo.Port = 3306
+	info.DBInfo.User = "backup"
+	info.DBInfo.Password = "db-secret"
+	info.SSHTunnel.Enabled = true
+	info.SSHTunnel.Host = "bastion"
+	info.SSHTunnel.Port = 22
+	info.SSHTunnel.Password = "ssh-secret"
+
+	column := func(row []string, name string) string {
+		i := slices.Index(parser.ImportColumns, name)
+		if i < 0 {
+			t.Fatalf("kolom %s tidak ada di ImportColumns", name)
+		}
+		return row[i]
+	}
+
+	tests := []struct {
+		name     string
+		redact   bool
+		password string
+		key      string
+		sshPass  string
+	}{
+		{name: "plain", password: "db-secret", key: "profile-key", sshPass: "ssh-secret"},
+		{name: "redacted", redact: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := profileExportRow(info, "profile-key", tt.redact)
+			if len(row) != len(parser.ImportColumns) {
+				t.Fatalf("len(row) = %d, want %d (ImportColumns)", len(row), len(parser.ImportColumns))
+			}
+			if column(row, "name") != "prod" || column(row, "port") != "3306" || column(row, "ssh_port") != "22" || column(row, "ssh_enabled") != "true" {
+				t.Fatalf("row = %v", row)
+			}
+			if column(row, "password") != tt.password || column(row, "profile_key") != tt.key || column(row, "ssh_password") != tt.sshPass {
+				t.Fatalf("secret columns = %q/%q/%q, want %q/%q/%q", column(row, "password"), column(row, "profile_key"),
+					column(row, "ssh_password"), tt.password, tt.key, tt.sshPass)
+			}
+		})
+	}
+}
+
+func TestMatchAnyGlob(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		profile  string
+		want     bool
+		wantErr  bool
+	}{
+		{name: "no pattern matches all", profile: "prod", want: true},
+		{name: "glob", patterns: []string{"stage-*", " prod-* "}, profile: "prod-db1", want: true},
+		{name: "no match", patterns: []string{"stage-*"}, profile: "prod-db1", want: false},
+		{name: "invalid pattern", patterns: []string{"[prod"}, profile: "prod", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchAnyGlob(tt.patterns, tt.profile)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Fatalf("matchAnyGlob() = %v, %v, want %v (wantErr %v)", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
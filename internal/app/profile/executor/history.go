@@ -0,0 +1,264 @@
+// File : internal/app/profile/executor/history.go
+// Deskripsi : Riwayat versi profile: pencatatan sebelum overwrite, history, diff, dan rollback
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/history"
+	"sfdbtools/internal/app/profile/helpers/parser"
+	"sfdbtools/internal/app/profile/merger"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/domain"
+	appconfig "sfdbtools/internal/services/config"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/fsops"
+	"sfdbtools/internal/shared/validation"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/prompt"
+	"sfdbtools/internal/ui/table"
+)
+
+// historyStore mengembalikan store history dari config (nil jika config tidak tersedia).
+func (e *Executor) historyStore() *history.Store {
+	cfg, ok := e.Config.(*appconfig.Config)
+	if !ok || cfg == nil || strings.TrimSpace(cfg.Profile.History.Dir) == "" {
+		return nil
+	}
+	return history.New(cfg.Profile.History.Dir, cfg.Profile.History.Keep)
+}
+
+// recordProfileHistory menyimpan isi file profile saat ini ke history sebelum ditimpa/dihapus.
+// after (opsional) dipakai untuk mencatat field yang berubah; key untuk mendekripsi versi lama.
+// Bersifat best-effort: kegagalan hanya dicatat sebagai warning agar operasi utama tetap jalan.
+func (e *Executor) recordProfileHistory(name, path, action string, after *domain.ProfileInfo, key string) {
+	store := e.historyStore()
+	if store == nil {
+		return
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			e.Log.Warnf("History profile %s tidak disimpan: %v", name, err)
+		}
+		return
+	}
+
+	var changed []string
+	if after != nil && strings.TrimSpace(key) != "" {
+		if before, derr := parser.DecryptProfileData(data, key); derr == nil {
+			changed = history.ChangedFields(before, after)
+		}
+	}
+	entry, err := store.Record(name, data, action, changed, time.Now())
+	if err != nil {
+		e.Log.Warnf("History profile %s tidak disimpan: %v", name, err)
+		return
+	}
+	e.Log.Infof("Versi sebelumnya profile %s disimpan di history (v%d)", name, entry.Version)
+}
+
+// ShowProfileHistory menampilkan daftar versi lama sebuah profile.
+func (e *Executor) ShowProfileHistory() error {
+	opts, ok := e.State.HistoryOptions()
+	if !ok || opts == nil {
+		return fmt.Errorf("options history profile tidak tersedia")
+	}
+	store, name, err := e.historyTarget(opts.ProfileName)
+	if err != nil {
+		return err
+	}
+	entries, err := store.List(name)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		print.PrintInfo(fmt.Sprintf("Belum ada history untuk profile %s (%s)", name, store.Dir))
+		return nil
+	}
+
+	rows := make([][]string, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		en := entries[i]
+		fields := "-"
+		if len(en.ChangedFields) > 0 {
+			fields = strings.Join(en.ChangedFields, ", ")
+		}
+		rows = append(rows, []string{fmt.Sprintf("v%d", en.Version), en.Timestamp.Format("2006-01-02 15:04:05"), en.OSUser, en.Action, fields})
+	}
+	print.PrintSubHeader("History Profile " + name)
+	table.Render([]string{"Versi", "Waktu", "User OS", "Aksi", "Field Berubah"}, rows)
+	print.PrintInfo("Setiap versi berisi isi profile SEBELUM aksi tersebut. Bandingkan: sfdbtools profile diff " + name + " <versi> current")
+	return nil
+}
+
+// DiffProfileVersions menampilkan perbedaan field antara dua versi (password disamarkan).
+func (e *Executor) DiffProfileVersions() error {
+	opts, ok := e.State.DiffOptions()
+	if !ok || opts == nil {
+		return fmt.Errorf("options diff profile tidak tersedia")
+	}
+	store, name, err := e.historyTarget(opts.ProfileName)
+	if err != nil {
+		return err
+	}
+	key, err := e.resolveHistoryKey(opts.ProfileKey, opts.Interactive)
+	if err != nil {
+		return err
+	}
+
+	before, err := e.loadProfileVersion(store, name, opts.From, key)
+	if err != nil {
+		return err
+	}
+	after, err := e.loadProfileVersion(store, name, opts.To, key)
+	if err != nil {
+		return err
+	}
+
+	changes := history.Diff(before, after)
+	print.PrintSubHeader(fmt.Sprintf("Diff Profile %s: %s -> %s", name, versionLabel(opts.From), versionLabel(opts.To)))
+	if len(changes) == 0 {
+		print.PrintInfo(consts.ProfileDisplayNoChangesDetected)
+		return nil
+	}
+	rows := make([][]string, 0, len(changes))
+	for i, c := range changes {
+		rows = append(rows, []string{fmt.Sprintf("%d", i+1), c.Field, c.Before, c.After})
+	}
+	table.Render([]string{consts.ProfileDisplayTableHeaderNo, consts.ProfileDisplayTableHeaderField, versionLabel(opts.From), versionLabel(opts.To)}, rows)
+	return nil
+}
+
+// RollbackProfile mengembalikan file profile ke versi tertentu.
+// Isi profile saat ini (jika ada) disimpan dulu ke history sehingga rollback juga bisa dibatalkan.
+func (e *Executor) RollbackProfile() error {
+	opts, ok := e.State.RollbackOptions()
+	if !ok || opts == nil {
+		return fmt.Errorf("options rollback profile tidak tersedia")
+	}
+	if opts.Version == 0 {
+		return fmt.Errorf("rollback membutuhkan nomor versi (bukan %q)", history.VersionCurrent)
+	}
+	store, name, err := e.historyTarget(opts.ProfileName)
+	if err != nil {
+		return err
+	}
+	key, err := e.resolveHistoryKey(opts.ProfileKey, opts.Interactive)
+	if err != nil {
+		return err
+	}
+
+	_, data, err := store.Load(name, opts.Version)
+	if err != nil {
+		return err
+	}
+	target, err := parser.DecryptProfileData(data, key)
+	if err != nil {
+		return fmt.Errorf("versi %d tidak bisa dibaca dengan kunci ini (versi dari sebelum rekey?): %w", opts.Version, err)
+	}
+
+	path := filepath.Join(e.ConfigDir, merger.BuildProfileFileName(name))
+	var current *domain.ProfileInfo
+	if fsops.FileExists(path) {
+		current, err = parser.LoadAndParseProfile(path, key)
+		if err != nil {
+			return fmt.Errorf("profile aktif %s tidak bisa dibaca dengan kunci ini: %w", name, err)
+		}
+	}
+
+	print.PrintSubHeader(fmt.Sprintf("Rollback Profile %s ke v%d", name, opts.Version))
+	if current == nil {
+		print.PrintInfo("File profile aktif tidak ada (sudah dihapus); versi ini akan dipulihkan.")
+	} else {
+		changes := history.Diff(current, target)
+		if len(changes) == 0 {
+			print.PrintInfo("Versi ini sama dengan profile aktif; tidak ada yang perlu di-rollback.")
+			return nil
+		}
+		rows := make([][]string, 0, len(changes))
+		for i, c := range changes {
+			rows = append(rows, []string{fmt.Sprintf("%d", i+1), c.Field, c.Before, c.After})
+		}
+		table.Render([]string{consts.ProfileDisplayTableHeaderNo, consts.ProfileDisplayTableHeaderField, history.VersionCurrent, fmt.Sprintf("v%d", opts.Version)}, rows)
+	}
+
+	if opts.Interactive && !opts.SkipConfirm {
+		confirmed, err := prompt.Confirm(fmt.Sprintf("Rollback profile %s ke v%d?", name, opts.Version), false)
+		if err != nil {
+			return validation.HandleInputError(err)
+		}
+		if !confirmed {
+			return validation.ErrUserCancelled
+		}
+	}
+
+	e.recordProfileHistory(name, path, history.ActionRollback, target, key)
+	if err := fsops.WriteFile(path, data); err != nil {
+		return fmt.Errorf(consts.ProfileErrWriteConfigFailedFmt, err)
+	}
+	e.Log.Infof("Profile %s dikembalikan ke v%d (%s)", name, opts.Version, path)
+	return nil
+}
+
+// historyTarget memvalidasi nama profile dan mengembalikan store history.
+// Profile yang sudah dihapus tetap bisa dipakai selama history-nya ada.
+func (e *Executor) historyTarget(profileName string) (*history.Store, string, error) {
+	store := e.historyStore()
+	if store == nil {
+		return nil, "", fmt.Errorf("history profile tidak tersedia (config profile.history.dir kosong)")
+	}
+	name := profileconn.TrimProfileSuffix(filepath.Base(strings.TrimSpace(profileName)))
+	if err := validation.ValidateProfileName(name); err != nil {
+		return nil, "", err
+	}
+	return store, name, nil
+}
+
+func (e *Executor) resolveHistoryKey(key string, interactive bool) (string, error) {
+	if strings.TrimSpace(key) != "" {
+		return key, nil
+	}
+	k, _, err := crypto.ResolveKey("", consts.ENV_SOURCE_PROFILE_KEY, interactive)
+	if err != nil {
+		return "", fmt.Errorf("kunci profile dibutuhkan untuk membaca versi: %w", err)
+	}
+	return k, nil
+}
+
+// loadProfileVersion membaca satu versi profile; version 0 berarti file profile aktif.
+func (e *Executor) loadProfileVersion(store *history.Store, name string, version int, key string) (*domain.ProfileInfo, error) {
+	if version == 0 {
+		path := filepath.Join(e.ConfigDir, merger.BuildProfileFileName(name))
+		if !fsops.FileExists(path) {
+			return nil, fmt.Errorf("profile aktif %s tidak ditemukan (%s)", name, path)
+		}
+		return parser.LoadAndParseProfile(path, key)
+	}
+	_, data, err := store.Load(name, version)
+	if err != nil {
+		return nil, err
+	}
+	info, err := parser.DecryptProfileData(data, key)
+	if err != nil {
+		return nil, fmt.Errorf("versi %d tidak bisa dibaca: %w", version, err)
+	}
+	return info, nil
+}
+
+func versionLabel(v int) string {
+	if v == 0 {
+		return history.VersionCurrent
+	}
+	return fmt.Sprintf("v%d", v)
+}
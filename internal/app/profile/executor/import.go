@@ -1,8 +1,8 @@
 // File : internal/app/profile/executor/import.go
-// Deskripsi : Import bulk profile dari XLSX lokal atau Google Spreadsheet (executor layer)
+// Deskripsi : Import bulk profile dari XLSX/CSV lokal, Google Spreadsheet, manifest YAML/JSON, atau option file MySQL (executor layer)
 // Author : Hadiyatna Muflihun
 // Tanggal : 25 Januari 2026
-// Last Modified : 26 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package executor
 
@@ -12,6 +12,7 @@ import (
 
 	importdisplay "sfdbtools/internal/app/profile/display"
 	"sfdbtools/internal/app/profile/helpers/importer"
+	"sfdbtools/internal/app/profile/helpers/reader"
 	profilemodel "sfdbtools/internal/app/profile/model"
 	"sfdbtools/internal/app/profile/wizard"
 	appconfig "sfdbtools/internal/services/config"
@@ -57,8 +58,17 @@ func (e *Executor) ImportProfiles() error {
 	srcLabel := "import"
 	if strings.TrimSpace(opts.Input) != "" {
 		srcLabel = "XLSX"
+		if reader.IsCSVPath(opts.Input) {
+			srcLabel = "CSV"
+		} else if reader.IsManifestPath(opts.Input) {
+			srcLabel = "manifest"
+		}
 	} else if strings.TrimSpace(opts.GSheetURL) != "" {
 		srcLabel = "Google Spreadsheet"
+	} else if strings.TrimSpace(opts.FromMyCnf) != "" {
+		srcLabel = "option file"
+	} else if strings.TrimSpace(opts.FromLoginPath) != "" {
+		srcLabel = "login path"
 	}
 
 	// PHASE 4: Display summary + confirmation
@@ -0,0 +1,324 @@
+// File : internal/app/profile/executor/rekey.go
+// Deskripsi : Rotasi kunci enkripsi profile (profile rekey) secara atomic dengan backup sementara
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package executor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/fsops"
+	"sfdbtools/internal/shared/validation"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/prompt"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// Status per file pada laporan rekey.
+const (
+	rekeyStatusOK         = "OK"
+	rekeyStatusFailed     = "GAGAL"
+	rekeyStatusSkipped    = "TIDAK DIUBAH"
+	rekeyStatusRolledBack = "DIKEMBALIKAN"
+)
+
+// rekeyItem adalah satu file profile yang diproses rekey.
+type rekeyItem struct {
+	Name      string
+	Path      string
+	KeySource string // "--old-key" atau "key-map"
+	oldKey    string // Kunci lama (untuk enkripsi ulang history)
+	original  []byte // Ciphertext lama (untuk backup/rollback)
+	plaintext []byte
+	written   bool
+	Status    string
+	Detail    string
+}
+
+// RekeyProfiles mendekripsi semua profile target dengan kunci lama lalu mengenkripsi ulang dengan kunci baru.
+// Semua profile harus berhasil didekripsi sebelum ada file yang ditulis; jika penulisan gagal di tengah jalan,
+// file yang sudah ditulis dikembalikan ke versi lama.
+func (e *Executor) RekeyProfiles() error {
+	opts, ok := e.State.RekeyOptions()
+	if !ok || opts == nil {
+		return fmt.Errorf("opsi rekey tidak tersedia")
+	}
+	interactive := e.isInteractiveMode()
+
+	items, err := e.collectRekeyTargets(opts.All, opts.Profiles)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		print.PrintWarn("Tidak ada profile di direktori: " + e.ConfigDir)
+		return nil
+	}
+
+	keyMap, err := loadRekeyKeyMap(opts.KeyMapFile)
+	if err != nil {
+		return err
+	}
+
+	oldKey := opts.OldKey
+	if oldKey == "" && len(keyMap) == 0 {
+		oldKey, _, err = crypto.ResolveKey("", consts.ENV_SOURCE_PROFILE_KEY, interactive)
+		if err != nil {
+			return fmt.Errorf("kunci lama tidak tersedia: %w", err)
+		}
+	}
+	newKey, _, err := crypto.ResolveKey(opts.NewKey, consts.ENV_TARGET_PROFILE_KEY, interactive)
+	if err != nil {
+		return fmt.Errorf("kunci baru tidak tersedia: %w", err)
+	}
+
+	// Tahap 1: dekripsi semua profile. Tidak ada file yang diubah jika salah satu gagal.
+	failed := 0
+	for _, it := range items {
+		key, source := oldKey, "--old-key"
+		if k, ok := keyMap[it.Name]; ok {
+			key, source = k, "key-map"
+		}
+		it.KeySource = source
+		if key == "" {
+			it.Status, it.Detail = rekeyStatusFailed, "kunci lama tidak ada di key-map maupun --old-key"
+			failed++
+			continue
+		}
+		data, err := os.ReadFile(it.Path)
+		if err != nil {
+			it.Status, it.Detail = rekeyStatusFailed, err.Error()
+			failed++
+			continue
+		}
+		plain, err := crypto.DecryptData(data, []byte(key))
+		if err != nil {
+			it.Status, it.Detail = rekeyStatusFailed, "gagal dekripsi (kunci lama salah?)"
+			failed++
+			continue
+		}
+		it.original = data
+		it.plaintext = plain
+		it.oldKey = key
+	}
+	if failed > 0 {
+		for _, it := range items {
+			if it.Status == "" {
+				it.Status, it.Detail = rekeyStatusSkipped, "dibatalkan karena profile lain gagal"
+			}
+		}
+		displayRekeyReport(items)
+		return fmt.Errorf("rekey dibatalkan: %d profile gagal didekripsi, tidak ada file yang diubah", failed)
+	}
+
+	if interactive && !opts.SkipConfirm {
+		confirmed, err := prompt.Confirm(fmt.Sprintf("Rekey %d profile dengan kunci baru?", len(items)), false)
+		if err != nil {
+			return validation.HandleInputError(err)
+		}
+		if !confirmed {
+			return validation.ErrUserCancelled
+		}
+	}
+
+	// Tahap 2: backup file lama, lalu tulis ulang dengan kunci baru.
+	backupDir := filepath.Join(e.ConfigDir, ".rekey-backup-"+time.Now().Format("20060102_150405"))
+	if err := fsops.CreateDirIfNotExist(backupDir); err != nil {
+		return fmt.Errorf("gagal membuat direktori backup rekey: %w", err)
+	}
+	for _, it := range items {
+		if err := fsops.WriteFile(filepath.Join(backupDir, filepath.Base(it.Path)), it.original); err != nil {
+			_ = os.RemoveAll(backupDir)
+			return fmt.Errorf("gagal backup %s: %w", it.Path, err)
+		}
+	}
+	e.Log.Infof("Backup profile lama disimpan sementara di %s", backupDir)
+
+	for _, it := range items {
+		if err := rewriteProfile(it, []byte(newKey)); err != nil {
+			it.Status, it.Detail = rekeyStatusFailed, err.Error()
+			e.Log.Errorf("Rekey %s gagal: %v", it.Path, err)
+			rollbackRekey(items, it)
+			displayRekeyReport(items)
+			return fmt.Errorf("rekey gagal pada %s, semua profile dikembalikan ke kunci lama (backup: %s): %w", it.Name, backupDir, err)
+		}
+		it.Status = rekeyStatusOK
+		e.Log.Infof("Profile %s berhasil di-rekey (kunci lama dari %s)", it.Name, it.KeySource)
+	}
+	e.reencryptHistory(items, newKey)
+
+	displayRekeyReport(items)
+	if opts.KeepBackup {
+		print.PrintInfo("Backup file lama: " + backupDir)
+		return nil
+	}
+	if err := os.RemoveAll(backupDir); err != nil {
+		e.Log.Warnf("Gagal menghapus backup rekey %s: %v", backupDir, err)
+	}
+	return nil
+}
+
+// collectRekeyTargets mengumpulkan file profile target (semua profile atau dari --profile).
+func (e *Executor) collectRekeyTargets(all bool, profiles []string) ([]*rekeyItem, error) {
+	var paths []string
+	if all {
+		files, err := fsops.ReadDirFiles(e.ConfigDir)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membaca direktori konfigurasi '%s': %w", e.ConfigDir, err)
+		}
+		for _, f := range filterProfileConfigFiles(files) {
+			paths = append(paths, filepath.Join(e.ConfigDir, f))
+		}
+	} else {
+		valid, _, err := e.collectValidPathsFromFlags(profiles)
+		if err != nil {
+			return nil, err
+		}
+		paths = valid
+	}
+
+	items := make([]*rekeyItem, 0, len(paths))
+	for _, p := range paths {
+		items = append(items, &rekeyItem{Name: connection.TrimProfileSuffix(filepath.Base(p)), Path: p})
+	}
+	return items, nil
+}
+
+// rewriteProfile mengenkripsi ulang profile dengan kunci baru (atomic write) dan memverifikasi hasilnya.
+func rewriteProfile(it *rekeyItem, newKey []byte) error {
+	encrypted, err := crypto.EncryptData(it.plaintext, newKey)
+	if err != nil {
+		return fmt.Errorf("gagal enkripsi: %w", err)
+	}
+	if err := fsops.WriteFile(it.Path, encrypted); err != nil {
+		return fmt.Errorf("gagal menulis file: %w", err)
+	}
+	it.written = true
+
+	data, err := os.ReadFile(it.Path)
+	if err != nil {
+		return fmt.Errorf("gagal verifikasi: %w", err)
+	}
+	check, err := crypto.DecryptData(data, newKey)
+	if err != nil || !bytes.Equal(check, it.plaintext) {
+		return fmt.Errorf("verifikasi dengan kunci baru gagal")
+	}
+	return nil
+}
+
+// rollbackRekey mengembalikan file yang sudah ditulis ke ciphertext lama.
+func rollbackRekey(items []*rekeyItem, failedItem *rekeyItem) {
+	for _, it := range items {
+		if !it.written {
+			if it.Status == "" {
+				it.Status, it.Detail = rekeyStatusSkipped, "dibatalkan"
+			}
+			continue
+		}
+		if err := fsops.WriteFile(it.Path, it.original); err != nil {
+			it.Status, it.Detail = rekeyStatusFailed, "rollback gagal: "+err.Error()
+			continue
+		}
+		if it != failedItem {
+			it.Status, it.Detail = rekeyStatusRolledBack, "dikembalikan ke kunci lama"
+		}
+	}
+}
+
+// loadRekeyKeyMap membaca file map kunci: satu baris "nama_profile = kunci", baris '#' diabaikan.
+// Kunci boleh berupa referensi secret (vault://, file://, cmd://).
+func loadRekeyKeyMap(path string) (map[string]string, error) {
+	keys := make(map[string]string)
+	if strings.TrimSpace(path) == "" {
+		return keys, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca key-map: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, key, found := strings.Cut(line, "=")
+		name = connection.TrimProfileSuffix(strings.TrimSpace(name))
+		key = strings.TrimSpace(key)
+		if !found || name == "" || key == "" {
+			return nil, fmt.Errorf("key-map %s baris %d: format harus 'nama_profile = kunci'", path, lineNo)
+		}
+		resolved, err := crypto.ResolveSecret(key)
+		if err != nil {
+			return nil, fmt.Errorf("key-map %s baris %d: %w", path, lineNo, err)
+		}
+		keys[name] = resolved
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("gagal membaca key-map: %w", err)
+	}
+	return keys, nil
+}
+
+// displayRekeyReport menampilkan status rekey per file.
+func displayRekeyReport(items []*rekeyItem) {
+	rows := make([][]string, 0, len(items))
+	ok := 0
+	for i, it := range items {
+		status := it.Status
+		switch it.Status {
+		case rekeyStatusOK:
+			ok++
+			status = text.ColorText(status, consts.UIColorGreen)
+		case rekeyStatusFailed:
+			status = text.ColorText(status, consts.UIColorRed)
+		default:
+			status = text.ColorText(status, consts.UIColorYellow)
+		}
+		detail := it.Detail
+		if detail == "" {
+			detail = "-"
+		}
+		rows = append(rows, []string{fmt.Sprintf("%d", i+1), it.Name, it.KeySource, status, detail})
+	}
+	print.PrintSubHeader("Hasil Rekey Profile")
+	table.Render([]string{"No", "Profile", "Kunci Lama", "Status", "Keterangan"}, rows)
+	fmt.Printf("Berhasil: %d/%d\n", ok, len(items))
+}
+
+// reencryptHistory mengenkripsi ulang versi history setiap profile dengan kunci baru agar tetap bisa di-diff/rollback.
+// Versi yang tidak bisa didekripsi dengan kunci lama (mis. dari sebelum rotasi sebelumnya) dibiarkan.
+func (e *Executor) reencryptHistory(items []*rekeyItem, newKey string) {
+	store := e.historyStore()
+	if store == nil {
+		return
+	}
+	for _, it := range items {
+		changed, skipped, err := store.Reencrypt(it.Name, []byte(it.oldKey), []byte(newKey))
+		if err != nil {
+			e.Log.Warnf("History profile %s gagal dienkripsi ulang: %v", it.Name, err)
+			continue
+		}
+		if changed > 0 {
+			e.Log.Infof("History profile %s: %d versi dienkripsi ulang", it.Name, changed)
+		}
+		if skipped > 0 {
+			e.Log.Warnf("History profile %s: %d versi tidak bisa dibaca dengan kunci lama dan tidak diubah", it.Name, skipped)
+		}
+	}
+}
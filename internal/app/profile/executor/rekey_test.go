@@ -0,0 +1,135 @@
+package executor
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"sfdbtools/internal/crypto"
+)
+
+func TestRewriteProfileRollback(t *testing.T) {
+	dir := t.TempDir()
+	oldKey, newKey := []byte("kunci-lama-123"), []byte("kunci-baru-456")
+
+	newItem := func(name string, plain string) *rekeyItem {
+		t.Helper()
+		enc, err := crypto.EncryptData([]byte(plain), oldKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, name+".cnf.enc")
+		if err := os.WriteFile(path, enc, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return &rekeyItem{Name: name, Path: path, original: enc, plaintext: []byte(plain)}
+	}
+
+	first := newItem("prod", "[client]\nhost=10.0.0.1\n")
+	second := newItem("stage", "[client]\nhost=10.0.0.2\n")
+	// File ketiga tidak bisa ditulis (parent berupa file) sehingga memicu rollback.
+	blocker := filepath.Join(dir, "blocker")
+	if err := os.WriteFile(blocker, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	broken := &rekeyItem{Name: "broken", Path: filepath.Join(blocker, "broken.cnf.enc"), plaintext: []byte("x")}
+	untouched := newItem("dev", "[client]\nhost=10.0.0.3\n")
+	items := []*rekeyItem{first, second, broken, untouched}
+
+	for _, it := range items[:2] {
+		if err := rewriteProfile(it, newKey); err != nil {
+			t.Fatalf("rewriteProfile(%s) error = %v", it.Name, err)
+		}
+		it.Status = rekeyStatusOK
+	}
+	data, _ := os.ReadFile(first.Path)
+	if plain, err := crypto.DecryptData(data, newKey); err != nil || !bytes.Equal(plain, first.plaintext) {
+		t.Fatalf("profile tidak terbaca dengan kunci baru: %v", err)
+	}
+
+	err := rewriteProfile(broken, newKey)
+	if err == nil {
+		t.Fatal("rewriteProfile(broken) harus gagal")
+	}
+	broken.Status = rekeyStatusFailed
+	rollbackRekey(items, broken)
+
+	tests := []struct {
+		item       *rekeyItem
+		wantStatus string
+	}{
+		{item: first, wantStatus: rekeyStatusRolledBack},
+		{item: second, wantStatus: rekeyStatusRolledBack},
+		{item: broken, wantStatus: rekeyStatusFailed},
+		{item: untouched, wantStatus: rekeyStatusSkipped},
+	}
+	for _, tt := range tests {
+		t.Run(tt.item.Name, func(t *testing.T) {
+			if tt.item.Status != tt.wantStatus {
+				t.Fatalf("Status = %q, want %q", tt.item.Status, tt.wantStatus)
+			}
+			if tt.item.original == nil {
+				return
+			}
+			data, err := os.ReadFile(tt.item.Path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if plain, err := crypto.DecryptData(data, oldKey); err != nil || !bytes.Equal(plain, tt.item.plaintext) {
+				t.Fatalf("profile tidak kembali ke kunci lama: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadRekeyKeyMap(t *testing.T) {
+	dir := t.TempDir()
+	secretPath := filepath.Join(dir, "stage.key")
+	if err := os.WriteFile(secretPath, []byte("dari-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "names, comments and secret reference",
+			content: "# key map\nprod.cnf.enc = rahasia=dengan=sama\n\nstage = file://" + secretPath + "\n",
+			want:    map[string]string{"prod": "rahasia=dengan=sama", "stage": "dari-file"},
+		},
+		{name: "missing separator", content: "prod rahasia\n", wantErr: true},
+		{name: "empty key", content: "prod =\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "keymap.txt")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			got, err := loadRekeyKeyMap(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadRekeyKeyMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("loadRekeyKeyMap() = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Fatalf("loadRekeyKeyMap()[%s] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+
+	if got, err := loadRekeyKeyMap(" "); err != nil || len(got) != 0 {
+		t.Fatalf("loadRekeyKeyMap(kosong) = %v, %v", got, err)
+	}
+}
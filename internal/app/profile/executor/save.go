@@ -2,7 +2,7 @@
 // Deskripsi : Simpan profile ke file (terenkripsi)
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 25 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package executor
 
@@ -14,7 +14,9 @@ import (
 	"strings"
 
 	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/history"
 	"sfdbtools/internal/app/profile/helpers/keys"
+	"sfdbtools/internal/app/profile/helpers/loader"
 	"sfdbtools/internal/app/profile/merger"
 	"sfdbtools/internal/crypto"
 	"sfdbtools/internal/shared/consts"
@@ -34,6 +36,10 @@ func (e *Executor) SaveProfile(mode string) error {
 				skipConnTest = true
 			}
 		}
+		if syncOpts, ok := e.State.SyncOptions(); ok && syncOpts != nil {
+			// Sync sudah melakukan conn-test per profile sebelum menyimpan.
+			skipConnTest = true
+		}
 	}
 
 	var baseDir string
@@ -53,7 +59,12 @@ func (e *Executor) SaveProfile(mode string) error {
 	if !skipConnTest {
 		e.Log.Info("Menghubungkan ke database target, sebelum menyimpan profile...")
 
-		if c, err := profileconn.ConnectWithProfile(e.Config, e.State.ProfileInfo, consts.DefaultInitialDatabase); err != nil {
+		// Referensi secret disimpan apa adanya; hanya koneksi test yang memakai nilai hasil resolve.
+		resolved, err := loader.WithResolvedSecrets(e.State.ProfileInfo)
+		if err != nil {
+			return err
+		}
+		if c, err := profileconn.ConnectWithProfile(e.Config, resolved, consts.DefaultInitialDatabase); err != nil {
 			if !isInteractive {
 				return err
 			}
@@ -126,10 +137,6 @@ func (e *Executor) SaveProfile(mode string) error {
 	newFilePath := filepath.Join(baseDir, newFileName)
 
 	if mode == consts.ProfileSaveModeEdit && e.State.OriginalProfileName != "" && e.State.OriginalProfileName != e.State.ProfileInfo.Name {
-		if err := fsops.WriteFile(newFilePath, encryptedContent); err != nil {
-			return fmt.Errorf(consts.ProfileErrWriteNewConfigFailedFmt, err)
-		}
-
 		oldFilePath := originalAbsPath
 		if oldFilePath == "" && e.State.OriginalProfileInfo != nil && e.State.OriginalProfileInfo.Path != "" {
 			oldFilePath = e.State.OriginalProfileInfo.Path
@@ -137,15 +144,34 @@ func (e *Executor) SaveProfile(mode string) error {
 		if oldFilePath == "" {
 			oldFilePath = filepath.Join(baseDir, merger.BuildProfileFileName(e.State.OriginalProfileName))
 		}
+		oldName := profileconn.TrimProfileSuffix(filepath.Base(oldFilePath))
+		e.recordProfileHistory(oldName, oldFilePath, history.ActionRename, e.State.ProfileInfo, e.State.ProfileInfo.EncryptionKey)
+
+		if err := fsops.WriteFile(newFilePath, encryptedContent); err != nil {
+			return fmt.Errorf(consts.ProfileErrWriteNewConfigFailedFmt, err)
+		}
 
 		if err := os.Remove(oldFilePath); err != nil {
 			e.Log.Warn(fmt.Sprintf(consts.ProfileWarnSavedButDeleteOldFailedFmt, newFileName, oldFilePath, err))
+		} else if store := e.historyStore(); store != nil {
+			if err := store.Rename(oldName, e.State.ProfileInfo.Name); err != nil {
+				e.Log.Warnf("History profile %s tidak ikut dipindahkan: %v", oldName, err)
+			}
 		}
 		e.Log.Info(fmt.Sprintf(consts.ProfileSuccessSavedRenamedFmt, newFileName, merger.BuildProfileFileName(e.State.OriginalProfileName)))
 		e.Log.Info(consts.ProfileMsgConfigSavedAtPrefix + newFilePath)
 		return nil
 	}
 
+	// Simpan versi lama sebelum file ditimpa (edit atau import dengan overwrite).
+	action := history.ActionEdit
+	if importOpts, ok := e.State.ImportOptions(); ok && importOpts != nil {
+		action = history.ActionImport
+	} else if syncOpts, ok := e.State.SyncOptions(); ok && syncOpts != nil {
+		action = history.ActionSync
+	}
+	e.recordProfileHistory(e.State.ProfileInfo.Name, newFilePath, action, e.State.ProfileInfo, e.State.ProfileInfo.EncryptionKey)
+
 	if err := fsops.WriteFile(newFilePath, encryptedContent); err != nil {
 		return fmt.Errorf(consts.ProfileErrWriteConfigFailedFmt, err)
 	}
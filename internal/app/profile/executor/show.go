@@ -106,6 +106,7 @@ func (e *Executor) ShowProfile() error {
 			ProfilePath:    e.State.OriginalProfileInfo.Path,
 			ProfileKey:     e.State.ProfileInfo.EncryptionKey,
 			RequireProfile: true,
+			KeepSecretRefs: true,
 		})
 		if err != nil {
 			return err
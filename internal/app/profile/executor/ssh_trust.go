@@ -0,0 +1,156 @@
+// File : internal/app/profile/executor/ssh_trust.go
+// Deskripsi : Eksekusi profile ssh trust (tampilkan dan pin fingerprint host key SSH)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/loader"
+	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/app/profile/process"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/validation"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/prompt"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// TrustSSHHostKeys memindai host key setiap hop SSH profile (jump host lalu bastion),
+// menampilkan fingerprint-nya, lalu mem-pin ke known_hosts sfdbtools setelah dikonfirmasi.
+// Host key yang berubah (mismatch) hanya bisa diterima lewat flow ini: dikonfirmasi interaktif
+// atau cocok dengan --fingerprint; --skip-confirm hanya mem-pin host key yang belum dikenal.
+func (e *Executor) TrustSSHHostKeys() error {
+	opts, ok := e.State.SSHTrustOptions()
+	if !ok || opts == nil {
+		return fmt.Errorf("options ssh trust tidak tersedia")
+	}
+
+	info, err := loader.ResolveAndLoadProfile(loader.ProfileLoadOptions{
+		ConfigDir:         e.ConfigDir,
+		ProfilePath:       opts.ProfileInfo.Path,
+		ProfileKey:        opts.ProfileInfo.EncryptionKey,
+		RequireProfile:    true,
+		AllowInteractive:  opts.Interactive,
+		InteractivePrompt: "Pilih profil yang host key SSH-nya akan dipercaya:",
+	})
+	if err != nil {
+		return err
+	}
+	e.State.ProfileInfo.Name = info.Name
+	e.State.ProfileInfo.Path = info.Path
+
+	if strings.TrimSpace(info.SSHTunnel.Host) == "" {
+		return fmt.Errorf("profile %s tidak memakai SSH tunnel", info.Name)
+	}
+	tunnelOpts, err := profileconn.BuildSSHTunnelOptions(info, profileconn.ProfileConnectTimeout(e.Config))
+	if err != nil {
+		return err
+	}
+
+	scans, knownHostsPath, scanErr := process.ScanHostKeys(tunnelOpts)
+	if len(scans) == 0 {
+		if scanErr != nil {
+			return fmt.Errorf("gagal memindai host key SSH: %w", scanErr)
+		}
+		return fmt.Errorf("tidak ada host key SSH yang dapat dipindai")
+	}
+	displayHostKeyScans(scans)
+	print.PrintInfo("known_hosts sfdbtools: " + knownHostsPath)
+
+	pinned, rejected, err := e.pinScannedHostKeys(scans, knownHostsPath, opts)
+	if err != nil {
+		return err
+	}
+
+	if len(rejected) > 0 {
+		return fmt.Errorf("fingerprint tidak cocok dengan --fingerprint, host key tidak di-pin: %s", strings.Join(rejected, ", "))
+	}
+	if errors.Is(scanErr, process.ErrHopNotTrusted) {
+		if pinned > 0 {
+			return fmt.Errorf("pemindaian berhenti di hop yang belum dipercaya: %w; jalankan ulang command ini untuk memindai hop berikutnya", scanErr)
+		}
+		return fmt.Errorf("pemindaian berhenti di hop yang belum dipercaya: %w; pin host key hop tersebut sebelum melanjutkan", scanErr)
+	}
+	if scanErr != nil {
+		return fmt.Errorf("pemindaian berhenti sebelum semua hop dijangkau: %w", scanErr)
+	}
+	if pinned == 0 {
+		print.PrintInfo("Tidak ada host key baru yang di-pin.")
+	}
+	return nil
+}
+
+// pinScannedHostKeys mem-pin host key hasil scan sesuai --fingerprint/--skip-confirm/konfirmasi.
+// Mengembalikan jumlah key yang di-pin dan hop yang ditolak karena tidak ada di --fingerprint.
+func (e *Executor) pinScannedHostKeys(scans []process.HostKeyScan, knownHostsPath string, opts *profilemodel.ProfileSSHTrustOptions) (pinned int, rejected []string, err error) {
+	for _, s := range scans {
+		// Hop yang sudah dipercaya tidak perlu ada di --fingerprint (mis. re-pin satu bastion di belakang jump host).
+		if s.Status == process.HostKeyTrusted {
+			continue
+		}
+		if len(opts.Fingerprints) > 0 && !slices.Contains(opts.Fingerprints, s.Fingerprint) {
+			rejected = append(rejected, fmt.Sprintf("%s (%s)", s.Hop.Addr(), s.Fingerprint))
+			continue
+		}
+
+		// --fingerprint yang cocok adalah verifikasi out-of-band; --skip-confirm tidak boleh menerima key yang berubah.
+		accept := len(opts.Fingerprints) > 0 || (opts.SkipConfirm && s.Status == process.HostKeyUnknown)
+		if !accept && s.Status == process.HostKeyMismatch && (opts.SkipConfirm || !opts.Interactive) {
+			mismatch := &process.HostKeyMismatchError{
+				Host:           s.Hop.Host,
+				Port:           s.Hop.Port,
+				Fingerprint:    s.Fingerprint,
+				Known:          s.Known,
+				KnownHostsPath: knownHostsPath,
+			}
+			return pinned, rejected, fmt.Errorf("%w (gunakan --fingerprint %s jika perubahan ini sudah diverifikasi)", mismatch, s.Fingerprint)
+		}
+		if !accept {
+			if s.Status == process.HostKeyMismatch {
+				print.PrintWarning(fmt.Sprintf("Host key %s BERUBAH (tercatat: %s). Pastikan perubahan ini disengaja sebelum menerima.",
+					s.Hop.Addr(), strings.Join(s.Known, ", ")))
+			}
+			label := fmt.Sprintf("Percayai host key %s %s untuk %s?", s.KeyType, s.Fingerprint, s.Hop.Addr())
+			accept, err = prompt.Confirm(label, s.Status == process.HostKeyUnknown)
+			if err != nil {
+				return pinned, rejected, validation.HandleInputError(err)
+			}
+		}
+		if !accept {
+			e.Log.Warnf("Host key %s (%s) tidak dipercaya", s.Hop.Addr(), s.Fingerprint)
+			continue
+		}
+		if err := process.PinHostKey(knownHostsPath, s.Hop, s.Key); err != nil {
+			return pinned, rejected, fmt.Errorf("gagal menulis known_hosts %s: %w", knownHostsPath, err)
+		}
+		e.Log.Infof("Host key %s di-pin: %s %s", s.Hop.Addr(), s.KeyType, s.Fingerprint)
+		pinned++
+	}
+	return pinned, rejected, nil
+}
+
+func displayHostKeyScans(scans []process.HostKeyScan) {
+	rows := make([][]string, 0, len(scans))
+	for i, s := range scans {
+		status := s.Status
+		switch s.Status {
+		case process.HostKeyTrusted:
+			status = text.ColorText(status, consts.UIColorGreen)
+		case process.HostKeyMismatch:
+			status = text.ColorText(status, consts.UIColorRed)
+		default:
+			status = text.ColorText(status, consts.UIColorYellow)
+		}
+		rows = append(rows, []string{fmt.Sprintf("%d", i+1), s.Hop.String(), s.KeyType, s.Fingerprint, status})
+	}
+	print.PrintSubHeader("Host Key SSH")
+	table.Render([]string{"No", "Host", "Tipe", "Fingerprint", "Status"}, rows)
+}
@@ -0,0 +1,100 @@
+package executor
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/app/profile/process"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestPinScannedHostKeys(t *testing.T) {
+	newScan := func(host, status string) process.HostKeyScan {
+		t.Helper()
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := ssh.NewPublicKey(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return process.HostKeyScan{
+			Hop:         process.SSHHop{User: "ops", Host: host, Port: 22},
+			Key:         key,
+			KeyType:     key.Type(),
+			Fingerprint: ssh.FingerprintSHA256(key),
+			Status:      status,
+		}
+	}
+
+	jump := newScan("jump.example", process.HostKeyTrusted)
+	bastion := newScan("bastion.example", process.HostKeyMismatch)
+	stranger := newScan("other.example", process.HostKeyUnknown)
+
+	tests := []struct {
+		name         string
+		scans        []process.HostKeyScan
+		fingerprints []string
+		wantPinned   int
+		wantRejected []string
+		wantErr      string
+	}{
+		{
+			name:         "re-pin changed bastion behind trusted jump host",
+			scans:        []process.HostKeyScan{jump, bastion},
+			fingerprints: []string{bastion.Fingerprint},
+			wantPinned:   1,
+		},
+		{
+			name:         "untrusted hop outside fingerprint list is rejected",
+			scans:        []process.HostKeyScan{jump, stranger},
+			fingerprints: []string{bastion.Fingerprint},
+			wantRejected: []string{"other.example:22"},
+		},
+		{
+			name:    "mismatch without fingerprint fails non-interactively",
+			scans:   []process.HostKeyScan{jump, bastion},
+			wantErr: "--fingerprint",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			knownHosts := filepath.Join(t.TempDir(), "known_hosts")
+			opts := &profilemodel.ProfileSSHTrustOptions{Fingerprints: tt.fingerprints}
+			e := New(nil, nil, t.TempDir(), nil, nil)
+
+			pinned, rejected, err := e.pinScannedHostKeys(tt.scans, knownHosts, opts)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("pinScannedHostKeys() error = %v, want mengandung %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("pinScannedHostKeys() error = %v", err)
+			}
+			if pinned != tt.wantPinned || len(rejected) != len(tt.wantRejected) {
+				t.Fatalf("pinScannedHostKeys() = %d, %v, want %d, %v", pinned, rejected, tt.wantPinned, tt.wantRejected)
+			}
+			for i, r := range tt.wantRejected {
+				if !strings.HasPrefix(rejected[i], r) {
+					t.Fatalf("rejected[%d] = %q, want prefix %q", i, rejected[i], r)
+				}
+			}
+			if pinned > 0 {
+				data, err := os.ReadFile(knownHosts)
+				if err != nil || !strings.Contains(string(data), "bastion.example") {
+					t.Fatalf("known_hosts tidak berisi bastion: %q, %v", data, err)
+				}
+			}
+		})
+	}
+}
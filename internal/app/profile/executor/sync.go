@@ -0,0 +1,252 @@
+// File : internal/app/profile/executor/sync.go
+// Deskripsi : Sinkronisasi direktori profile agar sama persis dengan manifest (create/update/hapus ekstra)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package executor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/history"
+	"sfdbtools/internal/app/profile/helpers/importer"
+	"sfdbtools/internal/app/profile/helpers/parser"
+	"sfdbtools/internal/app/profile/merger"
+	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/app/profile/wizard"
+	appconfig "sfdbtools/internal/services/config"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/fsops"
+	"sfdbtools/internal/shared/validation"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/prompt"
+	"sfdbtools/internal/ui/table"
+)
+
+// Aksi rencana sync per profile.
+const (
+	syncActionCreate    = "create"
+	syncActionUpdate    = "update"
+	syncActionUnchanged = "unchanged"
+	syncActionExtra     = "extra"
+)
+
+// syncItem adalah rencana sync untuk satu profile.
+type syncItem struct {
+	Name   string
+	Path   string
+	Action string
+	Detail string
+	Row    profilemodel.ImportRow
+}
+
+// SyncProfiles menyamakan direktori profile dengan manifest: profile baru dibuat, profile yang berbeda
+// ditimpa (versi lama masuk history), dan profile yang tidak ada di manifest hanya dihapus setelah dikonfirmasi.
+func (e *Executor) SyncProfiles() error {
+	opts, ok := e.State.SyncOptions()
+	if !ok || opts == nil {
+		return fmt.Errorf("options sync profile tidak tersedia")
+	}
+	cfg, ok := e.Config.(*appconfig.Config)
+	if !ok {
+		return fmt.Errorf("internal error: config type assertion failed")
+	}
+
+	// Validasi sumber sama persis dengan import; row invalid selalu menggagalkan sync.
+	importOpts := &profilemodel.ProfileImportOptions{
+		Input:           opts.Input,
+		ProfileKey:      opts.ProfileKey,
+		SkipConfirm:     !opts.Interactive || opts.SkipConfirm,
+		ContinueOnError: opts.ContinueOnError,
+		SkipConnTest:    opts.SkipConnTest,
+		Interactive:     opts.Interactive,
+	}
+	wiz := wizard.NewImportWizard(e.Log, cfg, e.ConfigDir)
+	rows, err := wiz.ReadAndValidate(importOpts)
+	if err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if r.Skip {
+			return fmt.Errorf("sync dibatalkan: row %d (%s) tidak valid", r.RowNum, importer.SafeName(r.Name))
+		}
+	}
+
+	items, err := e.planSync(rows)
+	if err != nil {
+		return err
+	}
+	displaySyncPlan(items)
+
+	var toSave, extras []*syncItem
+	for _, it := range items {
+		switch it.Action {
+		case syncActionCreate, syncActionUpdate:
+			toSave = append(toSave, it)
+		case syncActionExtra:
+			extras = append(extras, it)
+		}
+	}
+	if opts.DryRun {
+		print.PrintInfo("Dry run: tidak ada perubahan yang ditulis.")
+		return nil
+	}
+	if len(toSave) == 0 && len(extras) == 0 {
+		print.PrintInfo("Direktori profile sudah sesuai manifest.")
+		return nil
+	}
+
+	if len(toSave) > 0 && opts.Interactive && !opts.SkipConfirm {
+		confirmed, err := prompt.Confirm(fmt.Sprintf("Terapkan %d perubahan profile dari manifest?", len(toSave)), false)
+		if err != nil {
+			return validation.HandleInputError(err)
+		}
+		if !confirmed {
+			return validation.ErrUserCancelled
+		}
+	}
+
+	failed := e.applySync(wiz, importOpts, toSave, opts.ContinueOnError)
+	if failed < 0 {
+		return fmt.Errorf("sync dihentikan karena error (gunakan --continue-on-error untuk melanjutkan profile lain)")
+	}
+
+	if err := e.pruneSyncExtras(extras, opts); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("sync selesai dengan %d profile gagal", failed)
+	}
+	return nil
+}
+
+// planSync membandingkan setiap row manifest dengan file profile yang ada.
+func (e *Executor) planSync(rows []profilemodel.ImportRow) ([]*syncItem, error) {
+	declared := map[string]bool{}
+	items := make([]*syncItem, 0, len(rows))
+	for _, r := range rows {
+		name := profileconn.TrimProfileSuffix(strings.TrimSpace(r.Name))
+		r.PlannedName = name
+		declared[strings.ToLower(name)] = true
+		it := &syncItem{Name: name, Path: filepath.Join(e.ConfigDir, merger.BuildProfileFileName(name)), Row: r}
+		items = append(items, it)
+
+		if !fsops.FileExists(it.Path) {
+			it.Action, it.Detail = syncActionCreate, "profile baru"
+			continue
+		}
+		data, err := os.ReadFile(it.Path)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membaca profile %s: %w", it.Path, err)
+		}
+		current, err := parser.DecryptProfileData(data, r.ProfileKey)
+		if err != nil {
+			it.Action, it.Detail = syncActionUpdate, "tidak bisa dibaca dengan profile_key manifest (kunci berbeda)"
+			continue
+		}
+		want := importer.BuildProfileInfoFromRow(r)
+		changed := history.ChangedFields(current, &want)
+		if len(changed) == 0 {
+			it.Action, it.Detail = syncActionUnchanged, "-"
+			continue
+		}
+		it.Action, it.Detail = syncActionUpdate, strings.Join(changed, ", ")
+	}
+
+	files, err := fsops.ReadDirFiles(e.ConfigDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("gagal membaca direktori konfigurasi '%s': %w", e.ConfigDir, err)
+	}
+	extras := filterProfileConfigFiles(files)
+	sort.Strings(extras)
+	for _, f := range extras {
+		name := profileconn.TrimProfileSuffix(f)
+		if declared[strings.ToLower(name)] {
+			continue
+		}
+		items = append(items, &syncItem{Name: name, Path: filepath.Join(e.ConfigDir, f), Action: syncActionExtra, Detail: "tidak ada di manifest"})
+	}
+	return items, nil
+}
+
+// applySync menjalankan conn-test (opsional) lalu menyimpan profile create/update.
+// Mengembalikan jumlah profile gagal, atau -1 jika harus berhenti.
+func (e *Executor) applySync(wiz *wizard.ImportWizard, importOpts *profilemodel.ProfileImportOptions, items []*syncItem, continueOnError bool) int {
+	failed := 0
+	for i, it := range items {
+		if !importOpts.SkipConnTest {
+			tested, err := wiz.TestRow(it.Row, i+1, len(items), importOpts)
+			if err != nil {
+				e.Log.Errorf("Sync %s: %v", it.Name, err)
+				return -1
+			}
+			if tested.Skip {
+				failed++
+				continue
+			}
+		}
+
+		info := importer.BuildProfileInfoFromRow(it.Row)
+		e.State.ProfileInfo = &info
+		if err := e.SaveProfile(consts.ProfileSaveModeCreate); err != nil {
+			failed++
+			e.Log.Errorf("Sync %s gagal disimpan: %v", it.Name, err)
+			if !continueOnError {
+				return -1
+			}
+			continue
+		}
+		e.Log.Infof("Sync %s: %s", it.Name, it.Action)
+	}
+	return failed
+}
+
+// pruneSyncExtras menghapus profile yang tidak ada di manifest. Penghapusan selalu butuh konfirmasi:
+// prompt di mode interaktif, atau --prune (+ --skip-confirm) di mode non-interaktif.
+func (e *Executor) pruneSyncExtras(extras []*syncItem, opts *profilemodel.ProfileSyncOptions) error {
+	if len(extras) == 0 {
+		return nil
+	}
+	paths := make([]string, 0, len(extras))
+	names := make([]string, 0, len(extras))
+	for _, it := range extras {
+		paths = append(paths, it.Path)
+		names = append(names, it.Name)
+	}
+
+	switch {
+	case opts.Interactive && !opts.SkipConfirm:
+		confirmed, err := prompt.Confirm(fmt.Sprintf("Hapus %d profile yang tidak ada di manifest (%s)?", len(extras), strings.Join(names, ", ")), false)
+		if err != nil {
+			return validation.HandleInputError(err)
+		}
+		if !confirmed {
+			e.Log.Warnf("%d profile ekstra dibiarkan: %s", len(extras), strings.Join(names, ", "))
+			return nil
+		}
+	case !opts.Prune:
+		e.Log.Warnf("%d profile tidak ada di manifest dan dibiarkan (gunakan --prune untuk menghapus): %s", len(extras), strings.Join(names, ", "))
+		return nil
+	}
+	e.deletePaths(paths, consts.ProfileDeleteForceDeletedFmt, false, true)
+	return nil
+}
+
+func displaySyncPlan(items []*syncItem) {
+	rows := make([][]string, 0, len(items))
+	counts := map[string]int{}
+	for i, it := range items {
+		counts[it.Action]++
+		rows = append(rows, []string{fmt.Sprintf("%d", i+1), it.Name, it.Action, it.Detail})
+	}
+	print.PrintSubHeader("Rencana Sync Profile")
+	table.Render([]string{"No", "Profile", "Aksi", "Keterangan"}, rows)
+	fmt.Printf("Create: %d | Update: %d | Tetap: %d | Ekstra: %d\n",
+		counts[syncActionCreate], counts[syncActionUpdate], counts[syncActionUnchanged], counts[syncActionExtra])
+}
@@ -0,0 +1,96 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/shared/consts"
+)
+
+func writeSyncProfile(t *testing.T, dir, name, content, key string) string {
+	t.Helper()
+	enc, err := crypto.EncryptData([]byte(content), []byte(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name+consts.ExtCnf+consts.ExtEnc)
+	if err := os.WriteFile(path, enc, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPlanSync(t *testing.T) {
+	dir := t.TempDir()
+	key := "kunci-profile-123"
+	writeSyncProfile(t, dir, "same", "[client]\nhost=10.0.0.1\nport=3306\nuser=backup\npassword=rahasia\n", key)
+	writeSyncProfile(t, dir, "changed", "[client]\nhost=10.0.0.2\nport=3306\nuser=backup\npassword=rahasia\n", key)
+	writeSyncProfile(t, dir, "otherkey", "[client]\nhost=10.0.0.3\nport=3306\nuser=backup\npassword=rahasia\n", "kunci-lain-456")
+	writeSyncProfile(t, dir, "leftover", "[client]\nhost=10.0.0.9\n", key)
+
+	row := func(name, host string) profilemodel.ImportRow {
+		return profilemodel.ImportRow{Name: name, Host: host, Port: 3306, User: "backup", Password: "rahasia", ProfileKey: key}
+	}
+	rows := []profilemodel.ImportRow{
+		row("same", "10.0.0.1"),
+		row("changed.cnf.enc", "10.0.0.20"),
+		row("otherkey", "10.0.0.3"),
+		row("fresh", "10.0.0.4"),
+	}
+
+	e := New(nil, nil, dir, nil, nil)
+	items, err := e.planSync(rows)
+	if err != nil {
+		t.Fatalf("planSync() error = %v", err)
+	}
+
+	want := []struct{ name, action string }{
+		{"same", syncActionUnchanged},
+		{"changed", syncActionUpdate},
+		{"otherkey", syncActionUpdate},
+		{"fresh", syncActionCreate},
+		{"leftover", syncActionExtra},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("planSync() = %d item, want %d", len(items), len(want))
+	}
+	for i, w := range want {
+		if items[i].Name != w.name || items[i].Action != w.action {
+			t.Errorf("item %d = %s/%s (%s), want %s/%s", i, items[i].Name, items[i].Action, items[i].Detail, w.name, w.action)
+		}
+	}
+	if items[1].Detail != consts.ProfileDisplayFieldHost {
+		t.Errorf("detail update = %q, want %q", items[1].Detail, consts.ProfileDisplayFieldHost)
+	}
+}
+
+func TestPruneSyncExtras(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       profilemodel.ProfileSyncOptions
+		wantExists bool
+	}{
+		{name: "non-interactive without prune keeps extras", opts: profilemodel.ProfileSyncOptions{}, wantExists: true},
+		{name: "non-interactive with prune deletes extras", opts: profilemodel.ProfileSyncOptions{Prune: true}, wantExists: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := writeSyncProfile(t, dir, "leftover", "[client]\nhost=10.0.0.9\n", "kunci-profile-123")
+			e := New(nil, nil, dir, nil, nil)
+
+			extras := []*syncItem{{Name: "leftover", Path: path, Action: syncActionExtra}}
+			if err := e.pruneSyncExtras(extras, &tt.opts); err != nil {
+				t.Fatalf("pruneSyncExtras() error = %v", err)
+			}
+			_, err := os.Stat(path)
+			if exists := err == nil; exists != tt.wantExists {
+				t.Fatalf("profile masih ada = %v, want %v", exists, tt.wantExists)
+			}
+		})
+	}
+}
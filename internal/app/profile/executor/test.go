@@ -0,0 +1,103 @@
+// File : internal/app/profile/executor/test.go
+// Deskripsi : Eksekusi test koneksi profile (DNS/TCP/SSH/DB)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+package executor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	fleetmodel "sfdbtools/internal/app/fleet/model"
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/loader"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+)
+
+// TestProfile memuat profile lalu menjalankan test koneksi bertahap beserta probe kapabilitas server.
+// Dengan --all/--profiles, banyak profile dites paralel (lihat testManyProfiles).
+// Error dikembalikan jika profile tidak sehat agar scripting/fleet mendapat exit code non-zero.
+func (e *Executor) TestProfile() error {
+	opts, ok := e.State.TestOptions()
+	if !ok || opts == nil {
+		return fmt.Errorf("options test profile tidak tersedia")
+	}
+	if opts.IsMulti() {
+		return e.testManyProfiles(opts)
+	}
+
+	info, err := loader.ResolveAndLoadProfile(loader.ProfileLoadOptions{
+		ConfigDir:         e.ConfigDir,
+		ProfilePath:       opts.ProfileInfo.Path,
+		ProfileKey:        opts.ProfileInfo.EncryptionKey,
+		RequireProfile:    true,
+		AllowInteractive:  opts.Interactive,
+		InteractivePrompt: "Pilih profil yang akan dites:",
+	})
+	if err != nil {
+		return err
+	}
+	e.State.ProfileInfo.Name = info.Name
+	e.State.ProfileInfo.Path = info.Path
+
+	report := profileconn.TestConnectionWithCapabilities(e.Config, info, consts.DefaultInitialDatabase)
+	if opts.Format == consts.ProfileTestFormatJSON {
+		res := e.buildTestResult(info, report)
+		if err := printJSON(res); err != nil {
+			return err
+		}
+		if res.Status != fleetmodel.StatusOK {
+			return fmt.Errorf("profile %s tidak sehat: %s", info.Name, res.Error)
+		}
+		return nil
+	}
+
+	health := "HEALTHY"
+	if !report.Healthy {
+		health = "UNHEALTHY"
+	}
+	version := report.DBVersion
+	if strings.TrimSpace(version) == "" {
+		version = "-"
+	}
+	rows := [][]string{
+		{consts.ProfileDisplayFieldName, info.Name},
+		{consts.ProfileDisplayFieldHost, database.DBInfoAddr(info.DBInfo)},
+		{"DNS Resolution", report.DNSResolution.Display()},
+		{"TCP Connection", report.TCPConnection.Display()},
+		{"SSH Tunnel", report.SSHTunnel.Display()},
+		{"Authentication", report.Authentication.Display()},
+		{"DB Version", version},
+		{"Latency", report.TotalLatency.Round(time.Millisecond).String()},
+		{"Health", health},
+	}
+	if c := report.Capabilities; c != nil {
+		rows = append(rows,
+			[]string{"Flavor", c.Flavor},
+			[]string{"Read Only", c.ReadOnlyDisplay()},
+			[]string{"Replication", c.RoleDisplay()},
+			[]string{"Backup Privileges", c.Backup.Display()},
+			[]string{"Restore Privileges", c.Restore.Display()},
+		)
+	}
+	table.Render([]string{consts.ProfileDisplayTableHeaderField, consts.ProfileDisplayTableHeaderValue}, rows)
+	if c := report.Capabilities; c != nil {
+		for _, w := range c.Warnings {
+			print.PrintWarning("Probe: " + w)
+		}
+	}
+
+	if report.Err != nil {
+		desc := profileconn.DescribeConnectError(e.Config, report.Err)
+		for _, h := range desc.Hints {
+			print.PrintInfo("Hint: " + h)
+		}
+		return fmt.Errorf("%s (%s): %s", desc.Title, info.Name, desc.Detail)
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+// File : internal/app/profile/executor/test_many.go
+// Deskripsi : Test koneksi banyak profile sekaligus (--all/--profiles) dengan laporan latency dan kapabilitas
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"sfdbtools/internal/app/fleet"
+	fleetmodel "sfdbtools/internal/app/fleet/model"
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/loader"
+	"sfdbtools/internal/app/profile/helpers/parser"
+	"sfdbtools/internal/app/profile/helpers/tags"
+	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/domain"
+	appconfig "sfdbtools/internal/services/config"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
+	"sfdbtools/internal/ui/print"
+	"sfdbtools/internal/ui/table"
+	"sfdbtools/internal/ui/text"
+)
+
+// profileTestStep adalah hasil satu tahap test (dns/tcp/ssh/auth) dalam laporan.
+type profileTestStep struct {
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+	Detail    string `json:"detail,omitempty"`
+}
+
+// profileTestResult adalah hasil test satu profile dalam laporan table/json.
+type profileTestResult struct {
+	Profile        string                          `json:"profile"`
+	Path           string                          `json:"path"`
+	Address        string                          `json:"address,omitempty"`
+	Status         string                          `json:"status"`
+	Reachable      bool                            `json:"reachable"`
+	DNS            *profileTestStep                `json:"dns,omitempty"`
+	TCP            *profileTestStep                `json:"tcp,omitempty"`
+	SSH            *profileTestStep                `json:"ssh,omitempty"`
+	Auth           *profileTestStep                `json:"auth,omitempty"`
+	TotalLatencyMs int64                           `json:"total_latency_ms"`
+	Version        string                          `json:"version,omitempty"`
+	Capabilities   *profileconn.ServerCapabilities `json:"capabilities,omitempty"`
+	Error          string                          `json:"error,omitempty"`
+	Hints          []string                        `json:"hints,omitempty"`
+}
+
+// profileTestSummary adalah laporan agregat test banyak profile.
+type profileTestSummary struct {
+	Selector  string              `json:"selector"`
+	StartedAt time.Time           `json:"started_at"`
+	Duration  string              `json:"duration"`
+	Total     int                 `json:"total"`
+	Healthy   int                 `json:"healthy"`
+	Failed    int                 `json:"failed"`
+	Results   []profileTestResult `json:"results"`
+}
+
+// testManyProfiles mengetes semua profile yang cocok dengan selector secara paralel.
+// Profile yang gagal didekripsi atau tidak sehat dihitung gagal; error dikembalikan jika ada yang gagal.
+func (e *Executor) testManyProfiles(opts *profilemodel.ProfileTestOptions) error {
+	sel, err := tags.ParseSelector(opts.Selector)
+	if err != nil {
+		return err
+	}
+	key := strings.TrimSpace(opts.ProfileInfo.EncryptionKey)
+	if key == "" {
+		key, _, err = crypto.ResolveKey("", consts.ENV_SOURCE_PROFILE_KEY, opts.Interactive)
+		if err != nil {
+			return fmt.Errorf("kunci profile dibutuhkan untuk test banyak profile: %w", err)
+		}
+	}
+
+	targets, skipped, err := fleet.SelectProfiles(e.ConfigDir, sel, key, e.Log)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 && len(skipped) == 0 {
+		return fmt.Errorf("tidak ada profile yang cocok dengan selector %q di %s", sel.String(), e.ConfigDir)
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		if cfg, ok := e.Config.(*appconfig.Config); ok {
+			concurrency = cfg.Profile.Fleet.Concurrency
+		}
+	}
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	if concurrency > len(targets) {
+		concurrency = len(targets)
+	}
+
+	jsonOut := opts.Format == consts.ProfileTestFormatJSON
+	if !jsonOut {
+		e.Log.Infof("Test %d profile (selector %q), concurrency %d", len(targets), sel.String(), concurrency)
+	}
+
+	started := time.Now()
+	results := make([]profileTestResult, len(targets))
+	sem := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	done := 0
+	for i, t := range targets {
+		wg.Add(1)
+		go func(i int, t fleetmodel.Target) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			res := e.testTarget(t, key)
+			results[i] = res
+
+			mu.Lock()
+			defer mu.Unlock()
+			done++
+			if jsonOut {
+				return
+			}
+			if res.Status == fleetmodel.StatusOK {
+				e.Log.Infof("[%d/%d] %s: %s (%dms)", done, len(targets), res.Profile, res.Status, res.TotalLatencyMs)
+				return
+			}
+			e.Log.Warnf("[%d/%d] %s: %s - %s", done, len(targets), res.Profile, res.Status, res.Error)
+		}(i, t)
+	}
+	wg.Wait()
+
+	for _, s := range skipped {
+		results = append(results, profileTestResult{Profile: s.Profile, Path: s.Path, Status: fleetmodel.StatusFailed, Error: s.Detail})
+	}
+
+	summary := &profileTestSummary{
+		Selector:  sel.String(),
+		StartedAt: started,
+		Duration:  time.Since(started).Round(time.Millisecond).String(),
+		Total:     len(results),
+		Results:   results,
+	}
+	for _, r := range results {
+		if r.Status == fleetmodel.StatusOK {
+			summary.Healthy++
+		} else {
+			summary.Failed++
+		}
+	}
+
+	if jsonOut {
+		if err := printJSON(summary); err != nil {
+			return err
+		}
+	} else {
+		displayTestSummary(summary)
+	}
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("profile test: %d dari %d profile gagal", summary.Failed, summary.Total)
+	}
+	return nil
+}
+
+// testTarget memuat satu profile lalu menjalankan test koneksi + probe kapabilitas.
+func (e *Executor) testTarget(t fleetmodel.Target, key string) profileTestResult {
+	info, err := parser.LoadAndParseProfile(t.Path, key)
+	if err != nil {
+		return profileTestResult{Profile: t.Name, Path: t.Path, Status: fleetmodel.StatusFailed, Error: "gagal memuat profile: " + err.Error()}
+	}
+	info.Name = t.Name
+	info.Path = t.Path
+	if err := loader.ResolveProfileSecrets(info); err != nil {
+		return profileTestResult{Profile: t.Name, Path: t.Path, Status: fleetmodel.StatusFailed, Error: err.Error()}
+	}
+	report := profileconn.TestConnectionWithCapabilities(e.Config, info, consts.DefaultInitialDatabase)
+	return e.buildTestResult(info, report)
+}
+
+// buildTestResult mengubah report test koneksi menjadi entry laporan.
+func (e *Executor) buildTestResult(info *domain.ProfileInfo, report *profileconn.ConnectionTestReport) profileTestResult {
+	res := profileTestResult{
+		Profile:        info.Name,
+		Path:           info.Path,
+		Address:        database.DBInfoAddr(info.DBInfo),
+		Status:         fleetmodel.StatusOK,
+		Reachable:      report.TCPConnection.Status == profileconn.StepStatusSuccess,
+		DNS:            newTestStep(report.DNSResolution),
+		TCP:            newTestStep(report.TCPConnection),
+		SSH:            newTestStep(report.SSHTunnel),
+		Auth:           newTestStep(report.Authentication),
+		TotalLatencyMs: report.TotalLatency.Milliseconds(),
+		Capabilities:   report.Capabilities,
+	}
+	if v := strings.TrimSpace(report.DBVersion); v != "" && v != "-" {
+		res.Version = v
+	}
+	if !report.Healthy {
+		res.Status = fleetmodel.StatusFailed
+	}
+	if report.Err != nil {
+		desc := profileconn.DescribeConnectError(e.Config, report.Err)
+		res.Error = desc.Title + ": " + desc.Detail
+		res.Hints = desc.Hints
+	}
+	return res
+}
+
+func newTestStep(s profileconn.StepResult) *profileTestStep {
+	step := &profileTestStep{Status: string(s.Status), LatencyMs: s.Duration.Milliseconds(), Detail: s.Detail}
+	if s.Err != nil {
+		step.Detail = s.Err.Error()
+	}
+	return step
+}
+
+// printJSON menulis laporan ke stdout (gunakan --quiet agar log tidak bercampur).
+func printJSON(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("gagal membuat laporan JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func displayTestSummary(s *profileTestSummary) {
+	rows := make([][]string, 0, len(s.Results))
+	var notes [][]string
+	for i, r := range s.Results {
+		status := text.ColorText(r.Status, consts.UIColorGreen)
+		if r.Status != fleetmodel.StatusOK {
+			status = text.ColorText(r.Status, consts.UIColorRed)
+		}
+		version, readOnly, role, backup, restore := "-", "-", "-", "-", "-"
+		if r.Version != "" {
+			version = shortVersion(r.Version)
+		}
+		if c := r.Capabilities; c != nil {
+			version = c.Flavor + " " + version
+			readOnly = c.ReadOnlyDisplay()
+			role = c.RoleDisplay()
+			backup = privilegeCell(c.Backup)
+			restore = privilegeCell(c.Restore)
+			if len(c.Backup.Missing) > 0 {
+				notes = append(notes, []string{r.Profile, "Privilege backup " + c.Backup.Display()})
+			}
+			if len(c.Restore.Missing) > 0 {
+				notes = append(notes, []string{r.Profile, "Privilege restore " + c.Restore.Display()})
+			}
+			for _, w := range c.Warnings {
+				notes = append(notes, []string{r.Profile, "Probe: " + w})
+			}
+		}
+		if r.Error != "" {
+			notes = append(notes, []string{r.Profile, truncateNote(r.Error, 160)})
+		}
+		tcp := stepCell(r.TCP)
+		if r.DNS != nil && profileconn.StepStatus(r.DNS.Status) == profileconn.StepStatusFailed {
+			tcp = text.ColorText("DNS GAGAL", consts.UIColorRed)
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", i+1), r.Profile, status,
+			tcp, stepCell(r.SSH), stepCell(r.Auth),
+			version, readOnly, role, backup, restore,
+		})
+	}
+
+	print.PrintSubHeader("Laporan Test Profile")
+	table.Render([]string{"No", "Profile", "Status", "TCP", "SSH", "Auth", "Versi", "Read Only", "Replikasi", "Backup", "Restore"}, rows)
+	fmt.Printf("Sehat: %d | Gagal: %d | Total: %d | Durasi: %s\n", s.Healthy, s.Failed, s.Total, s.Duration)
+	if len(notes) > 0 {
+		print.PrintSubHeader("Catatan")
+		table.Render([]string{"Profile", "Keterangan"}, notes)
+	}
+}
+
+func stepCell(s *profileTestStep) string {
+	if s == nil {
+		return "-"
+	}
+	switch profileconn.StepStatus(s.Status) {
+	case profileconn.StepStatusSuccess:
+		return fmt.Sprintf("%dms", s.LatencyMs)
+	case profileconn.StepStatusFailed:
+		return text.ColorText("GAGAL", consts.UIColorRed)
+	case profileconn.StepStatusSkipped:
+		return "skip"
+	default:
+		return "-"
+	}
+}
+
+func privilegeCell(p profileconn.PrivilegeCheck) string {
+	switch {
+	case !p.Checked:
+		return "-"
+	case p.OK():
+		return text.ColorText("OK", consts.UIColorGreen)
+	case p.Partial:
+		return text.ColorText("parsial", consts.UIColorYellow)
+	default:
+		return text.ColorText(fmt.Sprintf("kurang %d", len(p.Missing)), consts.UIColorYellow)
+	}
+}
+
+func truncateNote(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-3] + "..."
+}
+
+// shortVersion memotong suffix build dari VERSION(), mis. "10.11.6-MariaDB-log" -> "10.11.6".
+func shortVersion(v string) string {
+	if i := strings.Index(v, "-"); i > 0 {
+		return v[:i]
+	}
+	return v
+}
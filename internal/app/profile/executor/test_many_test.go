@@ -0,0 +1,98 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	fleetmodel "sfdbtools/internal/app/fleet/model"
+	profileconn "sfdbtools/internal/app/profile/connection"
+	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+)
+
+func TestBuildTestResult(t *testing.T) {
+	info := &domain.ProfileInfo{Name: "prod", Path: "/etc/sfdbtools/prod.cnf.enc"}
+	info.DBInfo.Host = "10.0.0.1"
+	info.DBInfo.Port = 3306
+
+	ok := profileconn.StepResult{Status: profileconn.StepStatusSuccess, Duration: 3 * time.Millisecond}
+	tests := []struct {
+		name          string
+		report        profileconn.ConnectionTestReport
+		wantStatus    string
+		wantReachable bool
+		wantVersion   string
+	}{
+		{
+			name:          "healthy",
+			report:        profileconn.ConnectionTestReport{Healthy: true, TCPConnection: ok, Authentication: ok, DBVersion: "10.11.6-MariaDB"},
+			wantStatus:    fleetmodel.StatusOK,
+			wantReachable: true,
+			wantVersion:   "10.11.6-MariaDB",
+		},
+		{
+			name: "auth failed but reachable",
+			report: profileconn.ConnectionTestReport{
+				TCPConnection:  ok,
+				Authentication: profileconn.StepResult{Status: profileconn.StepStatusFailed},
+				DBVersion:      "-",
+			},
+			wantStatus:    fleetmodel.StatusFailed,
+			wantReachable: true,
+		},
+		{
+			name:       "unreachable",
+			report:     profileconn.ConnectionTestReport{TCPConnection: profileconn.StepResult{Status: profileconn.StepStatusFailed}},
+			wantStatus: fleetmodel.StatusFailed,
+		},
+	}
+
+	e := &Executor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := e.buildTestResult(info, &tt.report)
+			if res.Status != tt.wantStatus || res.Reachable != tt.wantReachable || res.Version != tt.wantVersion {
+				t.Fatalf("buildTestResult() = status %q reachable %v version %q, want %q %v %q",
+					res.Status, res.Reachable, res.Version, tt.wantStatus, tt.wantReachable, tt.wantVersion)
+			}
+			if res.Profile != "prod" || res.Address != "10.0.0.1:3306" {
+				t.Fatalf("buildTestResult() profile/address = %q/%q", res.Profile, res.Address)
+			}
+		})
+	}
+}
+
+func TestTestManyProfilesFailures(t *testing.T) {
+	dir := t.TempDir()
+	key := "kunci-profile-123"
+	write := func(name, content, k string) {
+		t.Helper()
+		enc, err := crypto.EncryptData([]byte(content), []byte(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name+consts.ExtCnf+consts.ExtEnc), enc, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Port 1 di loopback menolak koneksi sehingga test gagal cepat tanpa server database.
+	write("closed", "[client]\nhost=127.0.0.1\nport=1\nuser=root\npassword=x\n", key)
+	write("wrongkey", "[client]\nhost=127.0.0.1\nport=1\nuser=root\n", "kunci-lain-456")
+
+	e := New(nil, nil, dir, nil, nil)
+	opts := &profilemodel.ProfileTestOptions{Selector: "all", Concurrency: 2, Format: consts.ProfileTestFormatJSON}
+	opts.ProfileInfo.EncryptionKey = key
+
+	err := e.testManyProfiles(opts)
+	if err == nil {
+		t.Fatal("testManyProfiles() harus mengembalikan error jika ada profile gagal")
+	}
+	if !strings.Contains(err.Error(), "2 dari 2 profile gagal") {
+		t.Fatalf("testManyProfiles() error = %v, want 2 dari 2 profile gagal", err)
+	}
+}
@@ -0,0 +1,34 @@
+// File : internal/app/profile/helpers/exporter/format.go
+// Deskripsi : Format file export profile (XLSX/CSV, kebalikan dari helpers/reader)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package exporter
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultSheetName adalah nama sheet hasil export (sama dengan default --sheet pada profile import).
+const DefaultSheetName = "Profiles"
+
+// Format file export yang didukung.
+const (
+	FormatXLSX = "xlsx"
+	FormatCSV  = "csv"
+)
+
+// FormatFromPath menentukan format export dari ekstensi file output.
+func FormatFromPath(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(strings.TrimSpace(path))) {
+	case ".xlsx":
+		return FormatXLSX, nil
+	case ".csv":
+		return FormatCSV, nil
+	default:
+		return "", fmt.Errorf("format output tidak didukung: %s (gunakan .xlsx atau .csv)", path)
+	}
+}
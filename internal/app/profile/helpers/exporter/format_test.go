@@ -0,0 +1,25 @@
+package exporter
+
+import "testing"
+
+func TestFormatFromPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{path: "profiles.xlsx", want: FormatXLSX},
+		{path: " /tmp/Profiles.CSV ", want: FormatCSV},
+		{path: "profiles.xls", wantErr: true},
+		{path: "profiles", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := FormatFromPath(tt.path)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Fatalf("FormatFromPath(%q) = %q, %v, want %q (wantErr %v)", tt.path, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// File : internal/app/profile/helpers/history/diff.go
+// Deskripsi : Perbandingan field antar versi profile (secret disamarkan)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package history
+
+import (
+	"strconv"
+	"strings"
+
+	"sfdbtools/internal/app/profile/helpers/tags"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+)
+
+// maskedSecret ditampilkan sebagai pengganti password/secret yang terisi.
+const maskedSecret = "********"
+
+// FieldChange adalah satu field yang berbeda antara dua versi profile.
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// profileField mendeskripsikan cara membaca satu field dari ProfileInfo.
+type profileField struct {
+	label  string
+	secret bool
+	ssh    bool // Detail SSH tunnel; diabaikan jika tunnel nonaktif di kedua versi
+	get    func(p *domain.ProfileInfo) string
+}
+
+var profileFields = []profileField{
+	{label: consts.ProfileDisplayFieldHost, get: func(p *domain.ProfileInfo) string { return p.DBInfo.Host }},
+	{label: consts.ProfileDisplayFieldPort, get: func(p *domain.ProfileInfo) string { return portString(p.DBInfo.Port) }},
+	{label: consts.ProfileDisplayFieldUser, get: func(p *domain.ProfileInfo) string { return p.DBInfo.User }},
+	{label: consts.ProfileDisplayFieldPassword, secret: true, get: func(p *domain.ProfileInfo) string { return p.DBInfo.Password }},
+	{label: consts.ProfileLabelDBSocket, get: func(p *domain.ProfileInfo) string { return p.DBInfo.Socket }},
+	{label: consts.ProfileLabelSSLMode, get: func(p *domain.ProfileInfo) string { return p.DBInfo.TLS.Mode }},
+	{label: consts.ProfileLabelSSLCA, get: func(p *domain.ProfileInfo) string { return p.DBInfo.TLS.CA }},
+	{label: consts.ProfileLabelSSLCert, get: func(p *domain.ProfileInfo) string { return p.DBInfo.TLS.Cert }},
+	{label: consts.ProfileLabelSSLKey, get: func(p *domain.ProfileInfo) string { return p.DBInfo.TLS.Key }},
+	{label: consts.ProfileDisplayFieldSSHTunnel, get: func(p *domain.ProfileInfo) string { return strconv.FormatBool(p.SSHTunnel.Enabled) }},
+	{label: consts.ProfileLabelSSHHost, ssh: true, get: func(p *domain.ProfileInfo) string { return p.SSHTunnel.Host }},
+	{label: consts.ProfileLabelSSHPort, ssh: true, get: func(p *domain.ProfileInfo) string { return portString(p.SSHTunnel.Port) }},
+	{label: consts.ProfileLabelSSHUser, ssh: true, get: func(p *domain.ProfileInfo) string { return p.SSHTunnel.User }},
+	{label: consts.ProfileLabelSSHPassword, secret: true, ssh: true, get: func(p *domain.ProfileInfo) string { return p.SSHTunnel.Password }},
+	{label: consts.ProfileLabelSSHIdentityFile, ssh: true, get: func(p *domain.ProfileInfo) string { return p.SSHTunnel.IdentityFile }},
+	{label: consts.ProfileLabelSSHJumpHosts, ssh: true, get: func(p *domain.ProfileInfo) string { return strings.Join(p.SSHTunnel.JumpHosts, ",") }},
+	{label: consts.ProfileLabelSSHAgent, ssh: true, get: func(p *domain.ProfileInfo) string { return strconv.FormatBool(p.SSHTunnel.UseAgent) }},
+	{label: consts.ProfileLabelSSHLocalPort, ssh: true, get: func(p *domain.ProfileInfo) string { return portString(p.SSHTunnel.LocalPort) }},
+	{label: consts.ProfileDisplayFieldTags, get: func(p *domain.ProfileInfo) string { return tags.FormatTags(p.Tags) }},
+	{label: consts.ProfileDisplayFieldGroups, get: func(p *domain.ProfileInfo) string { return strings.Join(p.Groups, ",") }},
+}
+
+// Diff mengembalikan field yang berbeda antara before dan after.
+// Password/secret tidak pernah ditampilkan; hanya status terisi/berubah (kecuali referensi secret seperti vault://).
+func Diff(before, after *domain.ProfileInfo) []FieldChange {
+	if before == nil {
+		before = &domain.ProfileInfo{}
+	}
+	if after == nil {
+		after = &domain.ProfileInfo{}
+	}
+	sshOff := !before.SSHTunnel.Enabled && !after.SSHTunnel.Enabled
+	var changes []FieldChange
+	for _, f := range profileFields {
+		if f.ssh && sshOff {
+			continue
+		}
+		b, a := f.get(before), f.get(after)
+		if b == a {
+			continue
+		}
+		c := FieldChange{Field: f.label, Before: displayValue(b), After: displayValue(a)}
+		if f.secret {
+			c.Before, c.After = maskSecret(b), maskSecret(a)
+			if b != "" && a != "" && c.Before == c.After {
+				c.After += " (berubah)"
+			}
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// ChangedFields mengembalikan nama field yang berbeda (untuk metadata history).
+func ChangedFields(before, after *domain.ProfileInfo) []string {
+	changes := Diff(before, after)
+	names := make([]string, 0, len(changes))
+	for _, c := range changes {
+		names = append(names, c.Field)
+	}
+	return names
+}
+
+func maskSecret(v string) string {
+	switch {
+	case strings.TrimSpace(v) == "":
+		return consts.ProfileDisplayStateNotSet
+	case crypto.IsSecretReference(v):
+		return v
+	default:
+		return maskedSecret
+	}
+}
+
+func displayValue(v string) string {
+	if strings.TrimSpace(v) == "" {
+		return consts.ProfileDisplayStateNotSet
+	}
+	return v
+}
+
+func portString(p int) string {
+	if p == 0 {
+		return ""
+	}
+	return strconv.Itoa(p)
+}
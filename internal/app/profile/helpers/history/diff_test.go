@@ -0,0 +1,69 @@
+package history
+
+import (
+	"slices"
+	"testing"
+
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+)
+
+func TestDiff(t *testing.T) {
+	base := func() *domain.ProfileInfo {
+		p := &domain.ProfileInfo{}
+		p.DBInfo.Host = "10.0.0.1"
+		p.DBInfo.Port = 3306
+		p.DBInfo.User = "backup"
+		p.DBInfo.Password = "lama"
+		p.SSHTunnel.Host = "bastion"
+		return p
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(p *domain.ProfileInfo)
+		want   []FieldChange
+	}{
+		{name: "no change", mutate: func(*domain.ProfileInfo) {}},
+		{
+			name:   "host change",
+			mutate: func(p *domain.ProfileInfo) { p.DBInfo.Host = "10.0.0.2" },
+			want:   []FieldChange{{Field: consts.ProfileDisplayFieldHost, Before: "10.0.0.1", After: "10.0.0.2"}},
+		},
+		{
+			name:   "password is masked",
+			mutate: func(p *domain.ProfileInfo) { p.DBInfo.Password = "baru" },
+			want:   []FieldChange{{Field: consts.ProfileDisplayFieldPassword, Before: maskedSecret, After: maskedSecret + " (berubah)"}},
+		},
+		{
+			name:   "secret reference is shown",
+			mutate: func(p *domain.ProfileInfo) { p.DBInfo.Password = "vault://secret/db#password" },
+			want:   []FieldChange{{Field: consts.ProfileDisplayFieldPassword, Before: maskedSecret, After: "vault://secret/db#password"}},
+		},
+		{
+			name:   "ssh detail ignored while tunnel disabled",
+			mutate: func(p *domain.ProfileInfo) { p.SSHTunnel.Host = "bastion-2" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := base()
+			tt.mutate(after)
+			got := Diff(base(), after)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("Diff() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("ssh enabled", func(t *testing.T) {
+		after := base()
+		after.SSHTunnel.Enabled = true
+		after.SSHTunnel.Host = "bastion-2"
+		fields := ChangedFields(base(), after)
+		if !slices.Contains(fields, consts.ProfileDisplayFieldSSHTunnel) || !slices.Contains(fields, consts.ProfileLabelSSHHost) {
+			t.Fatalf("ChangedFields() = %v", fields)
+		}
+	})
+}
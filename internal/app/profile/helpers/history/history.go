@@ -0,0 +1,272 @@
+// File : internal/app/profile/helpers/history/history.go
+// Deskripsi : Penyimpanan versi lama profile (terenkripsi) beserta index metadata perubahan
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/shared/fsops"
+)
+
+// Aksi yang menyebabkan versi lama disimpan.
+const (
+	ActionEdit     = "edit"
+	ActionRename   = "rename"
+	ActionImport   = "import"
+	ActionDelete   = "delete"
+	ActionRollback = "rollback"
+	ActionSync     = "sync"
+)
+
+// DefaultKeep adalah jumlah versi per profile yang disimpan jika config tidak diisi.
+const DefaultKeep = 20
+
+// VersionCurrent adalah alias versi untuk file profile aktif (belum masuk history).
+const VersionCurrent = "current"
+
+const indexFile = "index.json"
+
+// Entry adalah metadata satu versi lama profile.
+// Versi berisi isi profile SEBELUM perubahan yang dicatat (waktu, user, field yang berubah).
+type Entry struct {
+	Version       int       `json:"version"`
+	Timestamp     time.Time `json:"timestamp"`
+	OSUser        string    `json:"os_user"`
+	Action        string    `json:"action"`
+	ChangedFields []string  `json:"changed_fields,omitempty"`
+	File          string    `json:"file"`
+}
+
+// index adalah isi index.json per profile.
+type index struct {
+	Profile     string  `json:"profile"`
+	NextVersion int     `json:"next_version"`
+	Entries     []Entry `json:"entries"`
+}
+
+// Store mengelola folder history: <Dir>/<profile>/vNNNN.cnf.enc + index.json.
+type Store struct {
+	Dir  string
+	Keep int // Jumlah versi terbaru yang dipertahankan per profile (<= 0 = DefaultKeep)
+}
+
+// New membuat Store. keep <= 0 memakai DefaultKeep.
+func New(dir string, keep int) *Store {
+	if keep <= 0 {
+		keep = DefaultKeep
+	}
+	return &Store{Dir: dir, Keep: keep}
+}
+
+func (s *Store) profileDir(name string) string {
+	return filepath.Join(s.Dir, name)
+}
+
+// Record menyimpan ciphertext profile (apa adanya, tetap terenkripsi) sebagai versi baru,
+// lalu memangkas versi lama di luar retensi.
+func (s *Store) Record(name string, ciphertext []byte, action string, changed []string, now time.Time) (Entry, error) {
+	if strings.TrimSpace(name) == "" {
+		return Entry{}, fmt.Errorf("nama profile kosong")
+	}
+	idx, err := s.loadIndex(name)
+	if err != nil {
+		return Entry{}, err
+	}
+	if idx.NextVersion <= 0 {
+		idx.NextVersion = 1
+	}
+
+	e := Entry{
+		Version:       idx.NextVersion,
+		Timestamp:     now,
+		OSUser:        currentOSUser(),
+		Action:        action,
+		ChangedFields: changed,
+		File:          fmt.Sprintf("v%04d.cnf.enc", idx.NextVersion),
+	}
+	dir := s.profileDir(name)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return Entry{}, fmt.Errorf("gagal membuat folder history %s: %w", dir, err)
+	}
+	if err := fsops.WriteFile(filepath.Join(dir, e.File), ciphertext); err != nil {
+		return Entry{}, fmt.Errorf("gagal menyimpan versi profile: %w", err)
+	}
+	idx.Profile = name
+	idx.NextVersion++
+	idx.Entries = append(idx.Entries, e)
+	s.prune(idx)
+	if err := s.saveIndex(name, idx); err != nil {
+		return Entry{}, err
+	}
+	return e, nil
+}
+
+// List mengembalikan versi profile dari yang terlama ke terbaru.
+func (s *Store) List(name string) ([]Entry, error) {
+	idx, err := s.loadIndex(name)
+	if err != nil {
+		return nil, err
+	}
+	return idx.Entries, nil
+}
+
+// Load membaca ciphertext satu versi.
+func (s *Store) Load(name string, version int) (Entry, []byte, error) {
+	idx, err := s.loadIndex(name)
+	if err != nil {
+		return Entry{}, nil, err
+	}
+	for _, e := range idx.Entries {
+		if e.Version != version {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.profileDir(name), e.File))
+		if err != nil {
+			return Entry{}, nil, fmt.Errorf("gagal membaca versi %d profile %s: %w", version, name, err)
+		}
+		return e, data, nil
+	}
+	return Entry{}, nil, fmt.Errorf("versi %d tidak ditemukan di history profile %s", version, name)
+}
+
+// Rename memindahkan history saat profile diganti nama. Tidak melakukan apa pun jika history belum ada.
+func (s *Store) Rename(oldName, newName string) error {
+	if oldName == newName {
+		return nil
+	}
+	oldDir, newDir := s.profileDir(oldName), s.profileDir(newName)
+	if !fsops.DirExists(oldDir) {
+		return nil
+	}
+	if fsops.PathExists(newDir) {
+		return fmt.Errorf("history untuk profile %s sudah ada di %s", newName, newDir)
+	}
+	if err := os.Rename(oldDir, newDir); err != nil {
+		return fmt.Errorf("gagal memindahkan history %s ke %s: %w", oldName, newName, err)
+	}
+	idx, err := s.loadIndex(newName)
+	if err != nil {
+		return err
+	}
+	idx.Profile = newName
+	return s.saveIndex(newName, idx)
+}
+
+// Reencrypt mengenkripsi ulang semua versi yang bisa didekripsi dengan oldKey memakai newKey
+// (dipanggil setelah profile rekey). Mengembalikan jumlah versi yang diubah dan yang dilewati.
+func (s *Store) Reencrypt(name string, oldKey, newKey []byte) (int, int, error) {
+	idx, err := s.loadIndex(name)
+	if err != nil {
+		return 0, 0, err
+	}
+	changed, skipped := 0, 0
+	for _, e := range idx.Entries {
+		path := filepath.Join(s.profileDir(name), e.File)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			skipped++
+			continue
+		}
+		plain, err := crypto.DecryptData(data, oldKey)
+		if err != nil {
+			// Versi dari sebelum rotasi kunci sebelumnya; biarkan apa adanya.
+			skipped++
+			continue
+		}
+		enc, err := crypto.EncryptData(plain, newKey)
+		if err != nil {
+			return changed, skipped, fmt.Errorf("gagal enkripsi ulang versi %d: %w", e.Version, err)
+		}
+		if err := fsops.WriteFile(path, enc); err != nil {
+			return changed, skipped, fmt.Errorf("gagal menulis versi %d: %w", e.Version, err)
+		}
+		changed++
+	}
+	return changed, skipped, nil
+}
+
+// prune menghapus versi terlama sehingga tersisa maksimal s.Keep versi.
+func (s *Store) prune(idx *index) {
+	keep := s.Keep
+	if keep <= 0 {
+		keep = DefaultKeep
+	}
+	if len(idx.Entries) <= keep {
+		return
+	}
+	sort.Slice(idx.Entries, func(i, j int) bool { return idx.Entries[i].Version < idx.Entries[j].Version })
+	drop := idx.Entries[:len(idx.Entries)-keep]
+	for _, e := range drop {
+		_ = os.Remove(filepath.Join(s.profileDir(idx.Profile), e.File))
+	}
+	idx.Entries = append([]Entry(nil), idx.Entries[len(idx.Entries)-keep:]...)
+}
+
+func (s *Store) loadIndex(name string) (*index, error) {
+	idx := &index{Profile: name, NextVersion: 1}
+	data, err := os.ReadFile(filepath.Join(s.profileDir(name), indexFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return idx, nil
+		}
+		return nil, fmt.Errorf("gagal membaca index history %s: %w", name, err)
+	}
+	if err := json.Unmarshal(data, idx); err != nil {
+		return nil, fmt.Errorf("gagal parse index history %s: %w", name, err)
+	}
+	return idx, nil
+}
+
+func (s *Store) saveIndex(name string, idx *index) error {
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := fsops.WriteFile(filepath.Join(s.profileDir(name), indexFile), data); err != nil {
+		return fmt.Errorf("gagal menulis index history %s: %w", name, err)
+	}
+	return nil
+}
+
+// ParseVersion mem-parse argumen versi: angka ("3" atau "v3") atau "current" (file profile aktif, hasil 0).
+func ParseVersion(s string) (int, error) {
+	v := strings.ToLower(strings.TrimSpace(s))
+	if v == VersionCurrent {
+		return 0, nil
+	}
+	v = strings.TrimPrefix(v, "v")
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("versi tidak valid: %q (contoh: 3 atau v3)", s)
+	}
+	return n, nil
+}
+
+// currentOSUser mengembalikan user OS yang menjalankan perubahan, termasuk user asli jika lewat sudo.
+func currentOSUser() string {
+	name := os.Getenv("USER")
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		name = u.Username
+	}
+	if sudo := os.Getenv("SUDO_USER"); sudo != "" && sudo != name {
+		return name + " (sudo: " + sudo + ")"
+	}
+	if name == "" {
+		return "unknown"
+	}
+	return name
+}
@@ -0,0 +1,138 @@
+package history
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"sfdbtools/internal/crypto"
+)
+
+func TestStoreRecordPruneAndLoad(t *testing.T) {
+	s := New(t.TempDir(), 2)
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+
+	for i, content := range []string{"v1", "v2", "v3"} {
+		e, err := s.Record("prod", []byte(content), ActionEdit, []string{"Host"}, now.Add(time.Duration(i)*time.Minute))
+		if err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+		if e.Version != i+1 {
+			t.Fatalf("Record() version = %d, want %d", e.Version, i+1)
+		}
+	}
+
+	entries, err := s.List("prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Version != 2 || entries[1].Version != 3 {
+		t.Fatalf("List() = %+v, want versi 2 dan 3", entries)
+	}
+	if _, err := os.Stat(filepath.Join(s.Dir, "prod", "v0001.cnf.enc")); !os.IsNotExist(err) {
+		t.Fatalf("file versi 1 harus dihapus oleh prune, stat err = %v", err)
+	}
+
+	e, data, err := s.Load("prod", 3)
+	if err != nil || string(data) != "v3" || e.Action != ActionEdit {
+		t.Fatalf("Load(3) = %+v, %q, %v", e, data, err)
+	}
+	if _, _, err := s.Load("prod", 1); err == nil {
+		t.Fatal("Load(1) harus gagal setelah prune")
+	}
+	if _, err := s.Record(" ", []byte("x"), ActionEdit, nil, now); err == nil {
+		t.Fatal("Record() dengan nama kosong harus gagal")
+	}
+}
+
+func TestStoreRename(t *testing.T) {
+	s := New(t.TempDir(), 0)
+	if err := s.Rename("missing", "other"); err != nil {
+		t.Fatalf("Rename() tanpa history = %v", err)
+	}
+	if _, err := s.Record("old", []byte("v1"), ActionEdit, nil, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Record("taken", []byte("v1"), ActionEdit, nil, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Rename("old", "taken"); err == nil {
+		t.Fatal("Rename() ke profile yang sudah punya history harus gagal")
+	}
+	if err := s.Rename("old", "new"); err != nil {
+		t.Fatalf("Rename() error = %v", err)
+	}
+	entries, err := s.List("new")
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("List(new) = %+v, %v", entries, err)
+	}
+	// Versi berikutnya tetap melanjutkan nomor versi lama.
+	if e, err := s.Record("new", []byte("v2"), ActionRename, nil, time.Now()); err != nil || e.Version != 2 {
+		t.Fatalf("Record(new) = %+v, %v", e, err)
+	}
+}
+
+func TestStoreReencrypt(t *testing.T) {
+	s := New(t.TempDir(), 0)
+	oldKey, newKey, otherKey := []byte("kunci-lama-123"), []byte("kunci-baru-456"), []byte("kunci-lain-789")
+
+	for _, k := range [][]byte{oldKey, otherKey, oldKey} {
+		enc, err := crypto.EncryptData([]byte("[client]\nhost=db\n"), k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Record("prod", enc, ActionEdit, nil, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	changed, skipped, err := s.Reencrypt("prod", oldKey, newKey)
+	if err != nil || changed != 2 || skipped != 1 {
+		t.Fatalf("Reencrypt() = %d, %d, %v, want 2, 1", changed, skipped, err)
+	}
+
+	tests := []struct {
+		version int
+		key     []byte
+	}{
+		{version: 1, key: newKey},
+		{version: 2, key: otherKey},
+		{version: 3, key: newKey},
+	}
+	for _, tt := range tests {
+		_, data, err := s.Load("prod", tt.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		plain, err := crypto.DecryptData(data, tt.key)
+		if err != nil || !bytes.Contains(plain, []byte("host=db")) {
+			t.Fatalf("versi %d tidak terbaca dengan kunci yang diharapkan: %v", tt.version, err)
+		}
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{in: "3", want: 3},
+		{in: "v12", want: 12},
+		{in: " Current ", want: 0},
+		{in: "0", wantErr: true},
+		{in: "v-1", wantErr: true},
+		{in: "latest", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseVersion(tt.in)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Fatalf("ParseVersion(%q) = %d, %v, want %d (wantErr %v)", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
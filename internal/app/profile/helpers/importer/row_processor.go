@@ -2,7 +2,7 @@
 // Deskripsi : Row processing helpers untuk import workflow
 // Author : Hadiyatna Muflihun
 // Tanggal : 25 Januari 2026
-// Last Modified : 25 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package importer
 
@@ -43,6 +43,8 @@ func BuildProfileInfoFromRow(r profilemodel.ImportRow) domain.ProfileInfo {
 			Port:     r.Port,
 			User:     strings.TrimSpace(r.User),
 			Password: r.Password,
+			TLS:      r.TLS,
+			Socket:   strings.TrimSpace(r.Socket),
 		},
 		SSHTunnel: domain.SSHTunnelConfig{
 			Enabled:      r.SSHEnabled,
@@ -51,8 +53,12 @@ func BuildProfileInfoFromRow(r profilemodel.ImportRow) domain.ProfileInfo {
 			User:         strings.TrimSpace(r.SSHUser),
 			Password:     r.SSHPassword,
 			IdentityFile: strings.TrimSpace(r.SSHIdentity),
+			JumpHosts:    r.SSHJumps,
+			UseAgent:     r.SSHAgent,
 			LocalPort:    r.SSHLocal,
 		},
+		Tags:             r.Tags,
+		Groups:           r.Groups,
 		EncryptionKey:    r.ProfileKey,
 		EncryptionSource: "import",
 	}
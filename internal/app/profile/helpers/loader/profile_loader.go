@@ -7,6 +7,7 @@ import (
 	"sfdbtools/internal/app/profile/helpers/paths"
 	"sfdbtools/internal/app/profile/helpers/selection"
 	"sfdbtools/internal/app/profile/merger"
+	"sfdbtools/internal/crypto"
 	"sfdbtools/internal/domain"
 	"sfdbtools/internal/shared/consts"
 	"sfdbtools/internal/shared/envx"
@@ -23,6 +24,9 @@ type ProfileLoadOptions struct {
 	ProfilePurpose    string
 	AllowInteractive  bool
 	InteractivePrompt string
+	// KeepSecretRefs membiarkan referensi secret (vault://, file://, cmd://) apa adanya.
+	// Dipakai oleh show/edit agar referensi tidak tertimpa nilai rahasia saat profile disimpan ulang.
+	KeepSecretRefs bool
 }
 
 // ResolveAndLoadProfile me-resolve dan load profile dengan fallback ke environment variables.
@@ -45,6 +49,11 @@ func ResolveAndLoadProfile(opts ProfileLoadOptions) (*domain.ProfileInfo, error)
 				if err != nil {
 					return nil, fmt.Errorf("gagal memilih konfigurasi database: %w", err)
 				}
+				if !opts.KeepSecretRefs {
+					if err := ResolveProfileSecrets(&info); err != nil {
+						return nil, err
+					}
+				}
 				return &info, nil
 			}
 
@@ -88,9 +97,48 @@ func ResolveAndLoadProfile(opts ProfileLoadOptions) (*domain.ProfileInfo, error)
 	profile.Path = absPath
 	profile.Name = name
 
+	if !opts.KeepSecretRefs {
+		if err := ResolveProfileSecrets(profile); err != nil {
+			return nil, err
+		}
+	}
 	return profile, nil
 }
 
+// ResolveProfileSecrets mengganti referensi secret pada password DB dan SSH dengan nilainya.
+// Secret di-resolve sekali saat load; layer koneksi memakai nilai profile apa adanya
+// (cmd:// tidak boleh dijalankan dua kali).
+func ResolveProfileSecrets(profile *domain.ProfileInfo) error {
+	if profile == nil {
+		return nil
+	}
+	pw, err := crypto.ResolveSecret(profile.DBInfo.Password)
+	if err != nil {
+		return fmt.Errorf("profile %s: password database: %w", profile.Name, err)
+	}
+	profile.DBInfo.Password = pw
+
+	sshPw, err := crypto.ResolveSecret(profile.SSHTunnel.Password)
+	if err != nil {
+		return fmt.Errorf("profile %s: password SSH: %w", profile.Name, err)
+	}
+	profile.SSHTunnel.Password = sshPw
+	return nil
+}
+
+// WithResolvedSecrets mengembalikan salinan profile dengan referensi secret sudah di-resolve.
+// Dipakai alur yang menyimpan referensi apa adanya (show, create/edit, import) sebelum membuka koneksi.
+func WithResolvedSecrets(profile *domain.ProfileInfo) (*domain.ProfileInfo, error) {
+	if profile == nil {
+		return nil, nil
+	}
+	resolved := *profile
+	if err := ResolveProfileSecrets(&resolved); err != nil {
+		return nil, err
+	}
+	return &resolved, nil
+}
+
 // LoadSourceProfile loads source profile untuk backup/dbscan operations dengan interactive mode.
 func LoadSourceProfile(configDir, profilePath, profileKey string, allowInteractive bool) (*domain.ProfileInfo, error) {
 	return ResolveAndLoadProfile(ProfileLoadOptions{
@@ -117,6 +165,7 @@ func SelectExistingDBConfigWithSnapshot(configDir string, promptText string) (in
 		AllowInteractive:  true,
 		InteractivePrompt: promptText,
 		RequireProfile:    true,
+		KeepSecretRefs:    true,
 	})
 	if err != nil {
 		return nil, "", nil, err
@@ -0,0 +1,53 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"sfdbtools/internal/domain"
+)
+
+func TestWithResolvedSecrets(t *testing.T) {
+	dir := t.TempDir()
+	secretPath := filepath.Join(dir, "db")
+	if err := os.WriteFile(secretPath, []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		password    string
+		sshPassword string
+		wantDB      string
+		wantSSH     string
+		wantErr     bool
+	}{
+		{name: "plain values unchanged", password: "plain", sshPassword: "ssh", wantDB: "plain", wantSSH: "ssh"},
+		{name: "file reference resolved", password: "file://" + secretPath, wantDB: "s3cret"},
+		{name: "ssh reference resolved", sshPassword: "file://" + secretPath, wantSSH: "s3cret"},
+		{name: "missing secret fails", password: "file://" + filepath.Join(dir, "missing"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := &domain.ProfileInfo{Name: "app"}
+			orig.DBInfo.Password = tt.password
+			orig.SSHTunnel.Password = tt.sshPassword
+
+			got, err := WithResolvedSecrets(orig)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WithResolvedSecrets err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if orig.DBInfo.Password != tt.password || orig.SSHTunnel.Password != tt.sshPassword {
+				t.Fatalf("profile asli berubah: %+v", orig)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.DBInfo.Password != tt.wantDB || got.SSHTunnel.Password != tt.wantSSH {
+				t.Fatalf("resolved = %q/%q, want %q/%q", got.DBInfo.Password, got.SSHTunnel.Password, tt.wantDB, tt.wantSSH)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 // Deskripsi : Parser untuk import data (schema validation, row parsing) - REFACTORED with common utils
 // Author : Hadiyatna Muflihun
 // Tanggal : 25 Januari 2026
-// Last Modified : 25 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package parser
 
@@ -11,10 +11,23 @@ import (
 	"strings"
 
 	"sfdbtools/internal/app/profile/helpers/common"
+	"sfdbtools/internal/app/profile/helpers/tags"
 	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/app/profile/process"
 	profilevalidation "sfdbtools/internal/app/profile/validation"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
 )
 
+// ImportColumns adalah urutan kolom tabel profile (dipakai oleh import dan export).
+var ImportColumns = []string{
+	"name", "host", "port", "user", "password", "profile_key", "socket",
+	"ssh_enabled", "ssh_host", "ssh_port", "ssh_user", "ssh_password",
+	"ssh_identity_file", "ssh_local_port", "ssh_jump_hosts", "ssh_use_agent",
+	"ssl_mode", "ssl_ca", "ssl_cert", "ssl_key", "tags", "groups",
+}
+
 // SchemaResult represents hasil validasi schema kolom
 type SchemaResult struct {
 	Schema   map[string]int // column name -> index mapping
@@ -46,8 +59,8 @@ func BuildImportSchema(headers []string) (*SchemaResult, error) {
 		}
 	}
 
-	// Validate required columns
-	required := []string{"name", "host", "user", "password", "profile_key"}
+	// Validate required columns (password divalidasi per baris karena opsional untuk koneksi socket)
+	required := []string{"name", "host", "user", "profile_key"}
 	missing := make([]string, 0, len(required))
 	for _, k := range required {
 		if _, ok := schema[k]; !ok {
@@ -59,10 +72,9 @@ func BuildImportSchema(headers []string) (*SchemaResult, error) {
 	}
 
 	// Check for unknown columns (warning only)
-	known := map[string]bool{
-		"name": true, "host": true, "port": true, "user": true, "password": true, "profile_key": true,
-		"ssh_enabled": true, "ssh_host": true, "ssh_port": true, "ssh_user": true, "ssh_password": true,
-		"ssh_identity_file": true, "ssh_local_port": true,
+	known := make(map[string]bool, len(ImportColumns))
+	for _, c := range ImportColumns {
+		known[c] = true
 	}
 
 	warnings := []string{}
@@ -116,12 +128,29 @@ func ParseImportRows(dataRows [][]string, schema map[string]int) ([]profilemodel
 		user := get("user")
 		password := get("password")
 		profileKey := get("profile_key")
+		socket := get("socket")
+		if strings.EqualFold(socket, consts.DBSocketAuto) {
+			detected, serr := database.DetectSocketPath()
+			if serr != nil {
+				errList = append(errList, profilemodel.ImportCellError{
+					Row: rowNum, Column: "socket", Message: serr.Error(),
+				})
+			}
+			socket = detected
+		}
+		// Koneksi socket selalu lokal; host kosong diisi localhost.
+		if socket != "" && common.IsEmpty(host) {
+			host = "localhost"
+		}
 
 		// Validate required fields (pakai existing validator)
 		profilevalidation.ValidateRequiredField(name, "name", rowNum, &errList)
 		profilevalidation.ValidateRequiredField(host, "host", rowNum, &errList)
 		profilevalidation.ValidateRequiredField(user, "user", rowNum, &errList)
-		profilevalidation.ValidateRequiredField(password, "password", rowNum, &errList)
+		// Password opsional untuk socket (auth unix_socket).
+		if socket == "" {
+			profilevalidation.ValidateRequiredField(password, "password", rowNum, &errList)
+		}
 		profilevalidation.ValidateRequiredField(profileKey, "profile_key", rowNum, &errList)
 
 		// Parse port (pakai common util)
@@ -139,6 +168,13 @@ func ParseImportRows(dataRows [][]string, schema map[string]int) ([]profilemodel
 		sshUser := get("ssh_user")
 		sshPassword := get("ssh_password")
 		sshIdentity := get("ssh_identity_file")
+		sshUseAgent := common.ParseBool(get("ssh_use_agent"))
+		sshJumpHosts := process.SplitJumpHosts(get("ssh_jump_hosts"))
+		if jerr := process.ValidateJumpHosts(sshJumpHosts); jerr != nil {
+			errList = append(errList, profilemodel.ImportCellError{
+				Row: rowNum, Column: "ssh_jump_hosts", Message: jerr.Error(),
+			})
+		}
 
 		// SSH port (pakai common util)
 		sshPort, sperr := common.ParsePort(get("ssh_port"), 22, "ssh_port")
@@ -159,22 +195,61 @@ func ParseImportRows(dataRows [][]string, schema map[string]int) ([]profilemodel
 		}
 
 		// Validate SSH conditional fields (pakai existing validator)
+		if sshEnabled && socket != "" {
+			errList = append(errList, profilemodel.ImportCellError{
+				Row: rowNum, Column: "socket", Message: "socket tidak bisa dipakai bersama ssh_enabled=true",
+			})
+		}
 		if sshEnabled {
 			profilevalidation.ValidateRequiredField(sshHost, "ssh_host (ssh_enabled=true)", rowNum, &errList)
 			profilevalidation.ValidateRequiredField(sshUser, "ssh_user (ssh_enabled=true)", rowNum, &errList)
 
 			// Untuk SSH tunnel, minimal harus ada 1 metode autentikasi:
 			// - password, atau
-			// - identity file (private key)
-			if common.IsEmpty(sshPassword) && common.IsEmpty(sshIdentity) {
+			// - identity file (private key), atau
+			// - ssh-agent (ssh_use_agent=true)
+			if common.IsEmpty(sshPassword) && common.IsEmpty(sshIdentity) && !sshUseAgent {
 				errList = append(errList, profilemodel.ImportCellError{
 					Row:     rowNum,
 					Column:  "ssh_password",
-					Message: "wajib isi ssh_password atau ssh_identity_file jika ssh_enabled=true",
+					Message: "wajib isi ssh_password, ssh_identity_file, atau ssh_use_agent=true jika ssh_enabled=true",
 				})
 			}
 		}
 
+		// TLS (opsional)
+		tlsCfg := domain.TLSConfig{CA: get("ssl_ca"), Cert: get("ssl_cert"), Key: get("ssl_key")}
+		sslMode, merr := database.NormalizeSSLMode(get("ssl_mode"))
+		if merr != nil {
+			errList = append(errList, profilemodel.ImportCellError{
+				Row: rowNum, Column: "ssl_mode", Message: merr.Error(),
+			})
+		} else {
+			tlsCfg.Mode = sslMode
+			if verr := database.ValidateTLSConfig(tlsCfg); verr != nil {
+				errList = append(errList, profilemodel.ImportCellError{
+					Row: rowNum, Column: "ssl_mode", Message: verr.Error(),
+				})
+			}
+		}
+
+		// Tag dan grup (opsional)
+		tagMap, terr := tags.ParseTags([]string{get("tags")})
+		if terr != nil {
+			errList = append(errList, profilemodel.ImportCellError{
+				Row: rowNum, Column: "tags", Message: terr.Error(),
+			})
+		}
+		if len(tagMap) == 0 {
+			tagMap = nil
+		}
+		groups, gerr := tags.ParseGroups([]string{get("groups")})
+		if gerr != nil {
+			errList = append(errList, profilemodel.ImportCellError{
+				Row: rowNum, Column: "groups", Message: gerr.Error(),
+			})
+		}
+
 		// Build ImportRow
 		out = append(out, profilemodel.ImportRow{
 			RowNum:      rowNum,
@@ -184,13 +259,19 @@ func ParseImportRows(dataRows [][]string, schema map[string]int) ([]profilemodel
 			User:        user,
 			Password:    password,
 			ProfileKey:  profileKey,
+			Socket:      socket,
 			SSHEnabled:  sshEnabled,
 			SSHHost:     sshHost,
 			SSHPort:     sshPort,
 			SSHUser:     sshUser,
 			SSHPassword: sshPassword,
 			SSHIdentity: sshIdentity,
+			SSHJumps:    sshJumpHosts,
+			SSHAgent:    sshUseAgent,
 			SSHLocal:    sshLocalPort,
+			TLS:         tlsCfg,
+			Tags:        tagMap,
+			Groups:      groups,
 		})
 	}
 
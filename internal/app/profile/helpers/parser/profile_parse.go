@@ -2,7 +2,7 @@
 // Deskripsi : Utility untuk load dan parse profil terenkripsi
 // Author : Hadiyatna Muflihun
 // Tanggal : 5 Desember 2025
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 package parser
 
 import (
@@ -13,6 +13,8 @@ import (
 	"strings"
 
 	"sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/tags"
+	"sfdbtools/internal/app/profile/process"
 	"sfdbtools/internal/cli/parsing"
 	"sfdbtools/internal/crypto"
 	"sfdbtools/internal/domain"
@@ -38,9 +40,14 @@ func LoadAndParseProfile(absPath string, key string) (*domain.ProfileInfo, error
 		info.EncryptionSource = src
 		info.EncryptionKey = strings.TrimSpace(k)
 	} else {
-		// Key berasal dari flag/state/env yang sudah dipass oleh caller.
+		// Key berasal dari flag/state/env yang sudah dipass oleh caller (boleh referensi secret).
+		resolved, rerr := crypto.ResolveSecret(k)
+		if rerr != nil {
+			return nil, fmt.Errorf("kunci enkripsi tidak tersedia: %w", rerr)
+		}
+		k = strings.TrimSpace(resolved)
 		info.EncryptionSource = "flag/state"
-		info.EncryptionKey = strings.TrimSpace(k)
+		info.EncryptionKey = k
 	}
 
 	plaintext, err := crypto.DecryptData(data, []byte(k))
@@ -59,15 +66,40 @@ func LoadAndParseProfile(absPath string, key string) (*domain.ProfileInfo, error
 		return nil, fmt.Errorf("gagal mendekripsi file '%s': %s", absPath, hint)
 	}
 
-	plainStr := string(plaintext)
+	if err := parseProfileINI(info, string(plaintext)); err != nil {
+		return nil, fmt.Errorf("gagal mem-parse isi konfigurasi '%s': %w", absPath, err)
+	}
+	info.Name = connection.TrimProfileSuffix(filepath.Base(absPath))
+	return info, nil
+}
+
+// DecryptProfileData mendekripsi isi file profile (mis. versi di history) dengan key lalu mem-parsing INI-nya.
+// Key boleh berupa referensi secret; tidak ada prompt.
+func DecryptProfileData(data []byte, key string) (*domain.ProfileInfo, error) {
+	k, err := crypto.ResolveSecret(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("kunci enkripsi tidak tersedia: %w", err)
+	}
+	plaintext, err := crypto.DecryptData(data, []byte(strings.TrimSpace(k)))
+	if err != nil {
+		return nil, fmt.Errorf("gagal mendekripsi data profile (kunci salah?)")
+	}
+	info := &domain.ProfileInfo{}
+	if err := parseProfileINI(info, string(plaintext)); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// parseProfileINI mengisi DBInfo, SSHTunnel dan metadata info dari isi INI profile.
+func parseProfileINI(info *domain.ProfileInfo, plainStr string) error {
 	parsed := parsing.ParseINIClient(plainStr)
 	if parsed == nil {
-		return nil, fmt.Errorf("gagal mem-parse isi konfigurasi '%s': format INI bagian [client] tidak ditemukan atau rusak", absPath)
+		return fmt.Errorf("format INI bagian [client] tidak ditemukan atau rusak")
 	}
 
 	sshParsed := parsing.ParseINISection(plainStr, "ssh")
 
-	info.Name = connection.TrimProfileSuffix(filepath.Base(absPath))
 	{
 		if h, ok := parsed["host"]; ok {
 			info.DBInfo.Host = h
@@ -83,6 +115,13 @@ func LoadAndParseProfile(absPath string, key string) (*domain.ProfileInfo, error
 		if pw, ok := parsed["password"]; ok {
 			info.DBInfo.Password = pw
 		}
+		info.DBInfo.Socket = strings.TrimSpace(parsed["socket"])
+		info.DBInfo.TLS = domain.TLSConfig{
+			Mode: strings.ToLower(strings.TrimSpace(parsed["ssl_mode"])),
+			CA:   strings.TrimSpace(parsed["ssl_ca"]),
+			Cert: strings.TrimSpace(parsed["ssl_cert"]),
+			Key:  strings.TrimSpace(parsed["ssl_key"]),
+		}
 	}
 
 	if sshParsed != nil {
@@ -113,6 +152,15 @@ func LoadAndParseProfile(absPath string, key string) (*domain.ProfileInfo, error
 		if v, ok := sshParsed["identity_file"]; ok {
 			ssh.IdentityFile = strings.TrimSpace(v)
 		}
+		if v, ok := sshParsed["jump_hosts"]; ok {
+			ssh.JumpHosts = process.SplitJumpHosts(v)
+		}
+		if v, ok := sshParsed["use_agent"]; ok {
+			switch strings.ToLower(strings.TrimSpace(v)) {
+			case "1", "true", "yes", "y", "on":
+				ssh.UseAgent = true
+			}
+		}
 		if v, ok := sshParsed["local_port"]; ok {
 			if lp, lperr := strconv.Atoi(strings.TrimSpace(v)); lperr == nil {
 				ssh.LocalPort = lp
@@ -120,5 +168,10 @@ func LoadAndParseProfile(absPath string, key string) (*domain.ProfileInfo, error
 		}
 		info.SSHTunnel = ssh
 	}
-	return info, nil
+
+	if meta := parsing.ParseINISection(plainStr, "meta"); meta != nil {
+		info.Tags = tags.ParseTagString(meta["tags"])
+		info.Groups = tags.ParseGroupString(meta["groups"])
+	}
+	return nil
 }
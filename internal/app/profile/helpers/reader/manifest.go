@@ -0,0 +1,232 @@
+// File : internal/app/profile/helpers/reader/manifest.go
+// Deskripsi : Reader manifest profile YAML/JSON (deklaratif, dikelola di git) sebagai sumber import/sync
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package reader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"sfdbtools/internal/app/profile/helpers/parser"
+	"sfdbtools/internal/app/profile/helpers/tags"
+	"sfdbtools/internal/crypto"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestVersion adalah versi format manifest yang didukung.
+const ManifestVersion = 1
+
+// Manifest adalah isi file manifest profile.
+//
+//	version: 1
+//	defaults:
+//	  profile_key: file:///run/secrets/sfdb_profile_key
+//	  user: backup
+//	profiles:
+//	  - name: prod-db
+//	    host: 10.0.0.5
+//	    password: vault://kv/db/prod#password
+//	    tags: {env: prod}
+type Manifest struct {
+	Version  int               `yaml:"version" json:"version"`
+	Defaults ManifestProfile   `yaml:"defaults" json:"defaults"`
+	Profiles []ManifestProfile `yaml:"profiles" json:"profiles"`
+}
+
+// ManifestProfile adalah satu profile di manifest (juga dipakai untuk defaults).
+// Password/secret sebaiknya berupa referensi (vault://, file://, cmd://) dan disimpan apa adanya di profile.
+type ManifestProfile struct {
+	Name       string            `yaml:"name" json:"name"`
+	Host       string            `yaml:"host" json:"host"`
+	Port       int               `yaml:"port" json:"port"`
+	User       string            `yaml:"user" json:"user"`
+	Password   string            `yaml:"password" json:"password"`
+	ProfileKey string            `yaml:"profile_key" json:"profile_key"`
+	Socket     string            `yaml:"socket" json:"socket"`
+	SSL        *ManifestSSL      `yaml:"ssl" json:"ssl"`
+	SSH        *ManifestSSH      `yaml:"ssh" json:"ssh"`
+	Tags       map[string]string `yaml:"tags" json:"tags"`
+	Groups     []string          `yaml:"groups" json:"groups"`
+}
+
+// ManifestSSL adalah pengaturan TLS/SSL koneksi database.
+type ManifestSSL struct {
+	Mode string `yaml:"mode" json:"mode"`
+	CA   string `yaml:"ca" json:"ca"`
+	Cert string `yaml:"cert" json:"cert"`
+	Key  string `yaml:"key" json:"key"`
+}
+
+// ManifestSSH adalah pengaturan SSH tunnel.
+type ManifestSSH struct {
+	Enabled      bool     `yaml:"enabled" json:"enabled"`
+	Host         string   `yaml:"host" json:"host"`
+	Port         int      `yaml:"port" json:"port"`
+	User         string   `yaml:"user" json:"user"`
+	Password     string   `yaml:"password" json:"password"`
+	IdentityFile string   `yaml:"identity_file" json:"identity_file"`
+	LocalPort    int      `yaml:"local_port" json:"local_port"`
+	JumpHosts    []string `yaml:"jump_hosts" json:"jump_hosts"`
+	UseAgent     bool     `yaml:"use_agent" json:"use_agent"`
+}
+
+// IsManifestPath bernilai true untuk file manifest YAML/JSON.
+func IsManifestPath(path string) bool {
+	switch strings.ToLower(filepath.Ext(strings.TrimSpace(path))) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// ReadManifest membaca manifest YAML/JSON lalu mengubahnya menjadi tabel import (kolom = parser.ImportColumns),
+// sehingga validasi per-row, resolver konflik, dan conn-test sama dengan XLSX/CSV.
+// defaultKey dipakai untuk profile tanpa profile_key (profile maupun defaults). Referensi secret pada
+// profile_key di-resolve di sini karena file profile dienkripsi dengan nilai kuncinya.
+func ReadManifest(path, defaultKey string) (*ImportTableResult, error) {
+	m, err := LoadManifest(path)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ImportTableResult{Headers: append([]string(nil), parser.ImportColumns...), Source: "manifest " + strings.TrimSpace(path)}
+	resolvedKeys := map[string]string{}
+	for i, p := range m.Profiles {
+		p = mergeManifestDefaults(m.Defaults, p)
+		if strings.TrimSpace(p.ProfileKey) == "" {
+			p.ProfileKey = defaultKey
+		}
+		key, err := resolveManifestKey(p.ProfileKey, resolvedKeys)
+		if err != nil {
+			return nil, fmt.Errorf("manifest profile #%d (%s): profile_key: %w", i+1, p.Name, err)
+		}
+		res.DataRows = append(res.DataRows, manifestRow(p, key, res.Headers))
+	}
+	return res, nil
+}
+
+// LoadManifest mem-parse file manifest; field yang tidak dikenal ditolak agar typo tidak lolos diam-diam.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(strings.TrimSpace(path))
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca manifest: %w", err)
+	}
+	m := &Manifest{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(m); err != nil {
+			return nil, fmt.Errorf("gagal parse manifest JSON %s: %w", path, err)
+		}
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(m); err != nil {
+			return nil, fmt.Errorf("gagal parse manifest YAML %s: %w", path, err)
+		}
+	}
+	if m.Version != 0 && m.Version != ManifestVersion {
+		return nil, fmt.Errorf("versi manifest %d tidak didukung (gunakan version: %d)", m.Version, ManifestVersion)
+	}
+	if len(m.Profiles) == 0 {
+		return nil, fmt.Errorf("manifest %s tidak berisi profiles", path)
+	}
+	return m, nil
+}
+
+// mergeManifestDefaults mengisi field kosong profile dari defaults; tag digabung (profile menang).
+func mergeManifestDefaults(d, p ManifestProfile) ManifestProfile {
+	if p.Host == "" {
+		p.Host = d.Host
+	}
+	if p.Port == 0 {
+		p.Port = d.Port
+	}
+	if p.User == "" {
+		p.User = d.User
+	}
+	if p.Password == "" {
+		p.Password = d.Password
+	}
+	if p.ProfileKey == "" {
+		p.ProfileKey = d.ProfileKey
+	}
+	if p.Socket == "" {
+		p.Socket = d.Socket
+	}
+	if p.SSL == nil {
+		p.SSL = d.SSL
+	}
+	if p.SSH == nil {
+		p.SSH = d.SSH
+	}
+	if len(d.Tags) > 0 {
+		merged := make(map[string]string, len(d.Tags)+len(p.Tags))
+		for k, v := range d.Tags {
+			merged[k] = v
+		}
+		for k, v := range p.Tags {
+			merged[k] = v
+		}
+		p.Tags = merged
+	}
+	if len(p.Groups) == 0 {
+		p.Groups = d.Groups
+	}
+	return p
+}
+
+func resolveManifestKey(key string, cache map[string]string) (string, error) {
+	key = strings.TrimSpace(key)
+	if key == "" || !crypto.IsSecretReference(key) {
+		return key, nil
+	}
+	if v, ok := cache[key]; ok {
+		return v, nil
+	}
+	v, err := crypto.ResolveSecret(key)
+	if err != nil {
+		return "", err
+	}
+	cache[key] = strings.TrimSpace(v)
+	return cache[key], nil
+}
+
+// manifestRow menyusun satu baris sesuai urutan headers.
+func manifestRow(p ManifestProfile, key string, headers []string) []string {
+	cells := map[string]string{
+		"name": p.Name, "host": p.Host, "port": intCell(p.Port), "user": p.User, "password": p.Password,
+		"profile_key": key, "socket": p.Socket, "tags": tags.FormatTags(p.Tags), "groups": strings.Join(p.Groups, ","),
+	}
+	if s := p.SSH; s != nil {
+		cells["ssh_enabled"] = strconv.FormatBool(s.Enabled)
+		cells["ssh_host"], cells["ssh_port"], cells["ssh_user"], cells["ssh_password"] = s.Host, intCell(s.Port), s.User, s.Password
+		cells["ssh_identity_file"], cells["ssh_local_port"] = s.IdentityFile, intCell(s.LocalPort)
+		cells["ssh_jump_hosts"], cells["ssh_use_agent"] = strings.Join(s.JumpHosts, ","), strconv.FormatBool(s.UseAgent)
+	}
+	if s := p.SSL; s != nil {
+		cells["ssl_mode"], cells["ssl_ca"], cells["ssl_cert"], cells["ssl_key"] = s.Mode, s.CA, s.Cert, s.Key
+	}
+	row := make([]string, len(headers))
+	for i, h := range headers {
+		row[i] = cells[h]
+	}
+	return row
+}
+
+func intCell(v int) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.Itoa(v)
+}
@@ -0,0 +1,118 @@
+package reader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func manifestCell(t *testing.T, res *ImportTableResult, row int, column string) string {
+	t.Helper()
+	for i, h := range res.Headers {
+		if h == column {
+			return res.DataRows[row][i]
+		}
+	}
+	t.Fatalf("kolom %q tidak ada di headers", column)
+	return ""
+}
+
+func TestReadManifest(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "profile.key")
+	if err := os.WriteFile(keyFile, []byte("kunci-dari-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	manifest := filepath.Join(dir, "profiles.yaml")
+	content := `version: 1
+defaults:
+  user: backup
+  port: 3306
+  tags: {env: prod, team: dba}
+profiles:
+  - name: prod-db
+    host: 10.0.0.5
+    password: vault://kv/db/prod#password
+    profile_key: file://` + keyFile + `
+    tags: {env: staging}
+    ssh:
+      enabled: true
+      host: bastion
+      jump_hosts: [jump1, jump2]
+  - name: report-db
+    host: 10.0.0.6
+    port: 3307
+`
+	if err := os.WriteFile(manifest, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := ReadManifest(manifest, "kunci-default")
+	if err != nil {
+		t.Fatalf("ReadManifest() error = %v", err)
+	}
+	if len(res.DataRows) != 2 {
+		t.Fatalf("ReadManifest() rows = %d, want 2", len(res.DataRows))
+	}
+
+	tests := []struct {
+		row    int
+		column string
+		want   string
+	}{
+		{0, "user", "backup"},
+		{0, "port", "3306"},
+		{0, "password", "vault://kv/db/prod#password"},
+		{0, "profile_key", "kunci-dari-file"},
+		{0, "ssh_enabled", "true"},
+		{0, "ssh_jump_hosts", "jump1,jump2"},
+		{1, "port", "3307"},
+		{1, "profile_key", "kunci-default"},
+		{1, "ssh_enabled", ""},
+	}
+	for _, tt := range tests {
+		if got := manifestCell(t, res, tt.row, tt.column); got != tt.want {
+			t.Errorf("row %d %s = %q, want %q", tt.row, tt.column, got, tt.want)
+		}
+	}
+	// Tag profile menang atas defaults, tag lain tetap diwarisi.
+	if got := manifestCell(t, res, 0, "tags"); !strings.Contains(got, "env=staging") || !strings.Contains(got, "team=dba") {
+		t.Errorf("row 0 tags = %q", got)
+	}
+}
+
+func TestLoadManifestErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+	}{
+		{name: "unknown yaml field", file: "m.yaml", content: "profiles:\n  - name: a\n    hots: x\n", wantErr: "hots"},
+		{name: "unknown json field", file: "m.json", content: `{"profiles":[{"name":"a","hots":"x"}]}`, wantErr: "hots"},
+		{name: "unsupported version", file: "m.yml", content: "version: 2\nprofiles:\n  - name: a\n", wantErr: "versi manifest 2"},
+		{name: "no profiles", file: "m.yaml", content: "version: 1\n", wantErr: "tidak berisi profiles"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadManifest(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("LoadManifest() error = %v, want mengandung %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsManifestPath(t *testing.T) {
+	for path, want := range map[string]bool{"a.yaml": true, "a.YML": true, " a.json ": true, "a.csv": false, "a.xlsx": false} {
+		if got := IsManifestPath(path); got != want {
+			t.Errorf("IsManifestPath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
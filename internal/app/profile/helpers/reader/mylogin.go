@@ -0,0 +1,65 @@
+// File : internal/app/profile/helpers/reader/mylogin.go
+// Deskripsi : Decoder file login path mysql_config_editor (.mylogin.cnf, AES-128-ECB obfuscation)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package reader
+
+import (
+	"crypto/aes"
+	"encoding/binary"
+	"fmt"
+)
+
+// Layout .mylogin.cnf:
+//
+//	4 byte   : unused (nol)
+//	20 byte  : bahan kunci; di-XOR berulang menjadi kunci AES-128
+//	berulang : panjang chunk (int32 little-endian) + chunk AES-128-ECB (padding PKCS#7), satu chunk per baris
+const (
+	loginPathUnusedLen = 4
+	loginPathKeyLen    = 20
+	loginPathHeaderLen = loginPathUnusedLen + loginPathKeyLen
+)
+
+// DecodeLoginPathFile mengembalikan isi plaintext (format INI) dari file .mylogin.cnf.
+// Ini bukan enkripsi sungguhan: kuncinya ada di file itu sendiri, hanya mencegah dibaca sekilas.
+func DecodeLoginPathFile(data []byte) ([]byte, error) {
+	if len(data) < loginPathHeaderLen {
+		return nil, fmt.Errorf("file terlalu pendek untuk format .mylogin.cnf")
+	}
+	key := make([]byte, aes.BlockSize)
+	for i, b := range data[loginPathUnusedLen:loginPathHeaderLen] {
+		key[i%aes.BlockSize] ^= b
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	rest := data[loginPathHeaderLen:]
+	for len(rest) > 0 {
+		if len(rest) < 4 {
+			return nil, fmt.Errorf("chunk terpotong di akhir file")
+		}
+		n := int(binary.LittleEndian.Uint32(rest[:4]))
+		rest = rest[4:]
+		if n <= 0 || n%aes.BlockSize != 0 || n > len(rest) {
+			return nil, fmt.Errorf("panjang chunk tidak valid: %d", n)
+		}
+		chunk := make([]byte, n)
+		for off := 0; off < n; off += aes.BlockSize {
+			block.Decrypt(chunk[off:off+aes.BlockSize], rest[off:off+aes.BlockSize])
+		}
+		rest = rest[n:]
+
+		pad := int(chunk[n-1])
+		if pad <= 0 || pad > aes.BlockSize || pad > n {
+			return nil, fmt.Errorf("padding chunk tidak valid (bukan file mysql_config_editor?)")
+		}
+		out = append(out, chunk[:n-pad]...)
+	}
+	return out, nil
+}
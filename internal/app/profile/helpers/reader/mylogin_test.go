@@ -0,0 +1,81 @@
+package reader
+
+import (
+	"bytes"
+	"crypto/aes"
+	"encoding/binary"
+	"testing"
+)
+
+// encodeLoginPath meniru mysql_config_editor: setiap baris dienkripsi sebagai satu chunk AES-128-ECB.
+func encodeLoginPath(t *testing.T, keyMaterial []byte, lines []string) []byte {
+	t.Helper()
+	key := make([]byte, aes.BlockSize)
+	for i, b := range keyMaterial {
+		key[i%aes.BlockSize] ^= b
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := make([]byte, loginPathUnusedLen)
+	out = append(out, keyMaterial...)
+	for _, line := range lines {
+		plain := []byte(line)
+		pad := aes.BlockSize - len(plain)%aes.BlockSize
+		plain = append(plain, bytes.Repeat([]byte{byte(pad)}, pad)...)
+		enc := make([]byte, len(plain))
+		for off := 0; off < len(plain); off += aes.BlockSize {
+			block.Encrypt(enc[off:off+aes.BlockSize], plain[off:off+aes.BlockSize])
+		}
+		out = binary.LittleEndian.AppendUint32(out, uint32(len(enc)))
+		out = append(out, enc...)
+	}
+	return out
+}
+
+func TestDecodeLoginPathFile(t *testing.T) {
+	keyMaterial := []byte("0123456789abcdefghij")
+	lines := []string{"[client]\n", "user = \"root\"\n", "password = \"s3cret-that-spans-blocks\"\n", "host = \"db1\"\n"}
+	valid := encodeLoginPath(t, keyMaterial, lines)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr bool
+	}{
+		{name: "valid file", data: valid, want: "[client]\nuser = \"root\"\npassword = \"s3cret-that-spans-blocks\"\nhost = \"db1\"\n"},
+		{name: "header only", data: valid[:loginPathHeaderLen], want: ""},
+		{name: "too short", data: valid[:loginPathHeaderLen-1], wantErr: true},
+		{name: "truncated length", data: valid[:loginPathHeaderLen+2], wantErr: true},
+		{name: "truncated chunk", data: valid[:loginPathHeaderLen+4+8], wantErr: true},
+		{
+			name:    "chunk length not block aligned",
+			data:    binary.LittleEndian.AppendUint32(append([]byte(nil), valid[:loginPathHeaderLen]...), 15),
+			wantErr: true,
+		},
+		{
+			name: "wrong key produces invalid padding",
+			data: func() []byte {
+				d := append([]byte(nil), valid...)
+				d[loginPathUnusedLen] ^= 0xff
+				return d
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeLoginPathFile(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeLoginPathFile err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Fatalf("DecodeLoginPathFile = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
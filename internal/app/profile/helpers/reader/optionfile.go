@@ -0,0 +1,402 @@
+// File : internal/app/profile/helpers/reader/optionfile.go
+// Deskripsi : Reader option file MySQL/MariaDB (my.cnf, debian.cnf, .mylogin.cnf) sebagai sumber import profile
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package reader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sfdbtools/internal/shared/consts"
+)
+
+// maxOptionFileIncludeDepth membatasi kedalaman !include/!includedir.
+const maxOptionFileIncludeDepth = 10
+
+// optionFileColumns adalah kolom tabel import yang diisi dari option file.
+var optionFileColumns = []string{
+	"name", "host", "port", "user", "password", "profile_key", "socket",
+	"ssl_mode", "ssl_ca", "ssl_cert", "ssl_key",
+}
+
+// optionConnKeys adalah opsi yang dianggap bagian dari kredensial koneksi.
+var optionConnKeys = []string{"host", "port", "user", "password", "socket", "ssl-mode", "ssl", "ssl-ca", "ssl-cert", "ssl-key"}
+
+// OptionSection adalah satu section option file beserta opsinya (key dinormalisasi: lowercase, '_' -> '-').
+type OptionSection struct {
+	Name    string
+	Source  string // File asal section (untuk pesan)
+	Options map[string]string
+}
+
+// ReadMyCnf membaca option file (mis. /root/.my.cnf atau /etc/mysql/debian.cnf) beserta !include/!includedir,
+// lalu mengubah setiap section client menjadi satu baris import. Section program (mis. [mysqldump])
+// mewarisi [client] seperti perilaku client MySQL.
+func ReadMyCnf(path, profileKey string) (*ImportTableResult, error) {
+	path = strings.TrimSpace(path)
+	sections := newSectionSet()
+	if err := readOptionFileInto(sections, path, 0, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	stem := optionFileStem(path)
+	return buildOptionTable(sections.list(), profileKey, "option file "+path, func(section string) string {
+		if strings.EqualFold(section, "client") {
+			return stem
+		}
+		return stem + "-" + section
+	}, isClientOptionGroup)
+}
+
+// ReadLoginPath membaca file login path hasil mysql_config_editor (format AES-obfuscated).
+// Setiap login path menjadi satu profile dengan nama login path tersebut; login path mewarisi [client].
+// path kosong = $MYSQL_TEST_LOGIN_FILE atau ~/.mylogin.cnf.
+func ReadLoginPath(path, profileKey string) (*ImportTableResult, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		path = DefaultLoginPathFile()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca file login path %s: %w", path, err)
+	}
+	plain, err := DecodeLoginPathFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("gagal decode %s: %w", path, err)
+	}
+	sections := newSectionSet()
+	parseOptionContent(sections, path, string(plain), nil)
+	return buildOptionTable(sections.list(), profileKey, "login path "+path, func(section string) string {
+		return section
+	}, func(string) bool { return true })
+}
+
+// DefaultLoginPathFile mengembalikan lokasi default file login path mysql_config_editor.
+func DefaultLoginPathFile() string {
+	if p := strings.TrimSpace(os.Getenv("MYSQL_TEST_LOGIN_FILE")); p != "" {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".mylogin.cnf"
+	}
+	return filepath.Join(home, ".mylogin.cnf")
+}
+
+// isClientOptionGroup bernilai true untuk section yang dibaca program client
+// ([client], [client-*], [mysql*], [mariadb-client], [mariadb-dump], ...), bukan server ([mysqld], [mariadb], [galera]).
+func isClientOptionGroup(name string) bool {
+	n := strings.ToLower(strings.TrimSpace(name))
+	switch {
+	case n == "client" || strings.HasPrefix(n, "client-"):
+		return true
+	case strings.HasPrefix(n, "mysqld") && !strings.HasPrefix(n, "mysqldump"):
+		// [mysqld], [mysqld-8.0], [mysqld_safe], [mysqld1] (mysqld_multi).
+		return false
+	case strings.HasPrefix(n, "mysql"):
+		return true
+	case strings.HasPrefix(n, "mariadb-"):
+		// [mariadb-10.6] adalah section server berversi.
+		rest := n[len("mariadb-"):]
+		return rest != "" && (rest[0] < '0' || rest[0] > '9')
+	default:
+		return false
+	}
+}
+
+// buildOptionTable mengubah section option file menjadi tabel import.
+// Section program hanya diimpor jika mendefinisikan opsi koneksi sendiri; hasil yang identik dilewati.
+func buildOptionTable(sections []*OptionSection, profileKey, source string, nameFor func(string) string, isClient func(string) bool) (*ImportTableResult, error) {
+	var base map[string]string
+	for _, s := range sections {
+		if strings.EqualFold(s.Name, "client") {
+			base = s.Options
+		}
+	}
+
+	res := &ImportTableResult{Headers: append([]string(nil), optionFileColumns...), Source: source}
+	seen := map[string]string{}
+	for _, s := range sections {
+		if !isClient(s.Name) {
+			continue
+		}
+		if !strings.EqualFold(s.Name, "client") && !hasConnOption(s.Options) {
+			continue
+		}
+		opts := map[string]string{}
+		if !strings.EqualFold(s.Name, "client") {
+			for k, v := range base {
+				opts[k] = v
+			}
+		}
+		for k, v := range s.Options {
+			opts[k] = v
+		}
+		if strings.TrimSpace(opts["user"]) == "" {
+			res.Warnings = append(res.Warnings, fmt.Sprintf("[%s] (%s) dilewati: opsi user tidak diisi", s.Name, s.Source))
+			continue
+		}
+
+		row := optionRow(nameFor(s.Name), opts, profileKey)
+		fingerprint := strings.Join(row[1:], "\x00")
+		if prev, ok := seen[fingerprint]; ok {
+			res.Warnings = append(res.Warnings, fmt.Sprintf("[%s] (%s) dilewati: kredensial sama dengan [%s]", s.Name, s.Source, prev))
+			continue
+		}
+		seen[fingerprint] = s.Name
+		res.DataRows = append(res.DataRows, row)
+	}
+	if len(res.DataRows) == 0 {
+		return nil, fmt.Errorf("tidak ada section client dengan kredensial di %s", source)
+	}
+	return res, nil
+}
+
+// optionRow memetakan opsi client ke urutan optionFileColumns.
+func optionRow(name string, opts map[string]string, profileKey string) []string {
+	host := strings.TrimSpace(opts["host"])
+	socket := strings.TrimSpace(opts["socket"])
+	protocol := strings.ToLower(strings.TrimSpace(opts["protocol"]))
+	// Seperti client MySQL: host kosong/localhost tanpa --protocol=tcp berarti koneksi via unix socket.
+	if socket == "" && (host == "" || strings.EqualFold(host, "localhost")) && protocol != "tcp" {
+		socket = consts.DBSocketAuto
+	}
+	if protocol == "tcp" && host == "" {
+		host = "127.0.0.1"
+	}
+	if protocol == "tcp" || (host != "" && !strings.EqualFold(host, "localhost")) {
+		// Socket diabaikan client MySQL untuk koneksi TCP.
+		socket = ""
+	}
+
+	return []string{
+		name,
+		host,
+		strings.TrimSpace(opts["port"]),
+		opts["user"],
+		opts["password"],
+		profileKey,
+		socket,
+		optionSSLMode(opts),
+		strings.TrimSpace(opts["ssl-ca"]),
+		strings.TrimSpace(opts["ssl-cert"]),
+		strings.TrimSpace(opts["ssl-key"]),
+	}
+}
+
+// optionSSLMode menerjemahkan ssl-mode (MySQL) atau ssl/ssl-verify-server-cert/skip-ssl (MariaDB).
+func optionSSLMode(opts map[string]string) string {
+	if v := strings.TrimSpace(opts["ssl-mode"]); v != "" {
+		return strings.ReplaceAll(strings.ToLower(v), "_", "-")
+	}
+	if _, ok := opts["skip-ssl"]; ok {
+		return consts.SSLModeDisabled
+	}
+	if v, ok := opts["ssl-verify-server-cert"]; ok && optionBool(v) {
+		return consts.SSLModeVerifyIdentity
+	}
+	if v, ok := opts["ssl"]; ok {
+		if optionBool(v) {
+			return consts.SSLModeRequired
+		}
+		return consts.SSLModeDisabled
+	}
+	return ""
+}
+
+// optionBool: opsi tanpa nilai (mis. "ssl") berarti true.
+func optionBool(v string) bool {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "", "1", "on", "true", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+func hasConnOption(opts map[string]string) bool {
+	for _, k := range optionConnKeys {
+		if _, ok := opts[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// optionFileStem mengubah path option file menjadi awalan nama profile, mis. /root/.my.cnf -> "my".
+func optionFileStem(path string) string {
+	base := strings.TrimPrefix(filepath.Base(path), ".")
+	base = strings.TrimSuffix(base, filepath.Ext(base))
+	if base == "" {
+		return "mycnf"
+	}
+	return base
+}
+
+// sectionSet menyimpan section sesuai urutan kemunculan pertama; section yang muncul ulang digabung.
+type sectionSet struct {
+	order []string
+	byKey map[string]*OptionSection
+}
+
+func newSectionSet() *sectionSet {
+	return &sectionSet{byKey: map[string]*OptionSection{}}
+}
+
+func (s *sectionSet) get(name, source string) *OptionSection {
+	key := strings.ToLower(name)
+	if sec, ok := s.byKey[key]; ok {
+		return sec
+	}
+	sec := &OptionSection{Name: name, Source: source, Options: map[string]string{}}
+	s.byKey[key] = sec
+	s.order = append(s.order, key)
+	return sec
+}
+
+func (s *sectionSet) list() []*OptionSection {
+	out := make([]*OptionSection, 0, len(s.order))
+	for _, k := range s.order {
+		out = append(out, s.byKey[k])
+	}
+	return out
+}
+
+// readOptionFileInto membaca satu option file; include diproses di posisi kemunculannya.
+func readOptionFileInto(set *sectionSet, path string, depth int, visited map[string]bool) error {
+	if depth > maxOptionFileIncludeDepth {
+		return fmt.Errorf("include option file terlalu dalam (> %d): %s", maxOptionFileIncludeDepth, path)
+	}
+	abs, err := filepath.Abs(path)
+	if err == nil {
+		path = abs
+	}
+	if visited[path] {
+		return nil
+	}
+	visited[path] = true
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("gagal membaca option file %s: %w", path, err)
+	}
+	var includeErr error
+	parseOptionContent(set, path, string(data), func(directive, target string) {
+		if includeErr != nil {
+			return
+		}
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(path), target)
+		}
+		switch directive {
+		case "!include":
+			includeErr = readOptionFileInto(set, target, depth+1, visited)
+		case "!includedir":
+			includeErr = readOptionDir(set, target, depth+1, visited)
+		}
+	})
+	return includeErr
+}
+
+// readOptionDir membaca semua *.cnf di direktori (urut nama), seperti !includedir pada MySQL.
+func readOptionDir(set *sectionSet, dir string, depth int, visited map[string]bool) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("gagal membaca direktori include %s: %w", dir, err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.EqualFold(filepath.Ext(e.Name()), ".cnf") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if err := readOptionFileInto(set, filepath.Join(dir, n), depth, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseOptionContent mem-parse isi option file ke set. include dipanggil untuk !include/!includedir (boleh nil).
+func parseOptionContent(set *sectionSet, source, content string, include func(directive, target string)) {
+	var current *OptionSection
+	for _, raw := range strings.Split(content, "\n") {
+		line := strings.TrimSpace(strings.TrimSuffix(raw, "\r"))
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "!") {
+			if include != nil {
+				fields := strings.Fields(line)
+				if len(fields) >= 2 {
+					include(strings.ToLower(fields[0]), strings.TrimSpace(strings.TrimPrefix(line, fields[0])))
+				}
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if end := strings.Index(line, "]"); end > 0 {
+				current = set.get(strings.TrimSpace(line[1:end]), source)
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		key, value := line, ""
+		if idx := strings.Index(line, "="); idx != -1 {
+			key, value = line[:idx], line[idx+1:]
+		}
+		key = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "_", "-")
+		if key == "" {
+			continue
+		}
+		current.Options[key] = optionValue(value)
+	}
+}
+
+// optionValue membersihkan nilai opsi: kutip tunggal/ganda dilepas (beserta escape), komentar # di akhir dibuang.
+func optionValue(v string) string {
+	v = strings.TrimSpace(v)
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') {
+		quote := v[0]
+		var b strings.Builder
+		for i := 1; i < len(v); i++ {
+			c := v[i]
+			if c == quote {
+				return b.String()
+			}
+			if c == '\\' && i+1 < len(v) {
+				i++
+				switch v[i] {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				case 'r':
+					b.WriteByte('\r')
+				case 'b':
+					b.WriteByte('\b')
+				case 's':
+					b.WriteByte(' ')
+				default:
+					b.WriteByte(v[i])
+				}
+				continue
+			}
+			b.WriteByte(c)
+		}
+		return b.String()
+	}
+	if idx := strings.Index(v, " #"); idx != -1 {
+		v = strings.TrimSpace(v[:idx])
+	}
+	return v
+}
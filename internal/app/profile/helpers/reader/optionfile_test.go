@@ -0,0 +1,141 @@
+package reader
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"sfdbtools/internal/shared/consts"
+)
+
+func TestReadMyCnf(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	// [mysqladmin] mewarisi [client] tanpa perubahan sehingga dilewati sebagai duplikat.
+	write("extra.cnf", "[mysqladmin]\nuser=root\n")
+	write("conf.d/50-remote.cnf", "[client-remote]\nhost = db.example\nport=3307\nuser=app\nssl_mode=VERIFY_CA\n")
+	write("conf.d/README", "[client-ignored]\nuser=nobody\n")
+	path := write(".my.cnf", strings.Join([]string{
+		"[client]",
+		"user=root",
+		`password="p#w" # komentar`,
+		"!include extra.cnf",
+		"!includedir " + filepath.Join(dir, "conf.d"),
+		"[mysqldump]",
+		"user = dump",
+		"[mysql]",
+		"prompt=prod> ",
+		"[mysqld]",
+		"user=mysql",
+		"[mariadb-10.6]",
+		"user=mysql",
+	}, "\n")+"\n")
+
+	res, err := ReadMyCnf(path, "kunci")
+	if err != nil {
+		t.Fatalf("ReadMyCnf() error = %v", err)
+	}
+
+	want := [][]string{
+		{"my", "", "", "root", "p#w", "kunci", consts.DBSocketAuto, "", "", "", ""},
+		{"my-client-remote", "db.example", "3307", "app", "p#w", "kunci", "", "verify-ca", "", "", ""},
+		{"my-mysqldump", "", "", "dump", "p#w", "kunci", consts.DBSocketAuto, "", "", "", ""},
+	}
+	if len(res.DataRows) != len(want) {
+		t.Fatalf("ReadMyCnf() rows = %q, want %d row", res.DataRows, len(want))
+	}
+	for i := range want {
+		if !slices.Equal(res.DataRows[i], want[i]) {
+			t.Errorf("row %d = %q, want %q", i, res.DataRows[i], want[i])
+		}
+	}
+	if len(res.Warnings) != 1 || !strings.Contains(res.Warnings[0], "[mysqladmin]") {
+		t.Errorf("Warnings = %q, want satu duplikat [mysqladmin]", res.Warnings)
+	}
+
+	if _, err := ReadMyCnf(write("empty.cnf", "[mysqld]\nuser=mysql\n"), "kunci"); err == nil {
+		t.Fatal("ReadMyCnf() tanpa section client harus gagal")
+	}
+}
+
+func TestOptionRowHostAndSocket(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       map[string]string
+		wantHost   string
+		wantSocket string
+	}{
+		{name: "no host means socket", opts: map[string]string{}, wantSocket: consts.DBSocketAuto},
+		{name: "localhost means socket", opts: map[string]string{"host": "LocalHost"}, wantHost: "LocalHost", wantSocket: consts.DBSocketAuto},
+		{name: "explicit socket kept", opts: map[string]string{"host": "localhost", "socket": "/tmp/m.sock"}, wantHost: "localhost", wantSocket: "/tmp/m.sock"},
+		{name: "protocol tcp", opts: map[string]string{"protocol": "TCP", "socket": "/tmp/m.sock"}, wantHost: "127.0.0.1"},
+		{name: "remote host ignores socket", opts: map[string]string{"host": "10.0.0.5", "socket": "/tmp/m.sock"}, wantHost: "10.0.0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := optionRow("p", tt.opts, "")
+			if row[1] != tt.wantHost || row[6] != tt.wantSocket {
+				t.Fatalf("optionRow() host/socket = %q/%q, want %q/%q", row[1], row[6], tt.wantHost, tt.wantSocket)
+			}
+		})
+	}
+}
+
+func TestIsClientOptionGroup(t *testing.T) {
+	tests := map[string]bool{
+		"client":         true,
+		"client-mariadb": true,
+		"mysql":          true,
+		"mysqldump":      true,
+		"mariadb-client": true,
+		"mariadb-dump":   true,
+		"mysqld":         false,
+		"mysqld-8.0":     false,
+		"mysqld_safe":    false,
+		"mariadb":        false,
+		"mariadb-10.6":   false,
+		"galera":         false,
+	}
+	for name, want := range tests {
+		if got := isClientOptionGroup(name); got != want {
+			t.Errorf("isClientOptionGroup(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestOptionSSLMode(t *testing.T) {
+	tests := []struct {
+		name string
+		opts map[string]string
+		want string
+	}{
+		{name: "none", opts: map[string]string{}, want: ""},
+		{name: "mysql ssl-mode", opts: map[string]string{"ssl-mode": "VERIFY_IDENTITY"}, want: "verify-identity"},
+		{name: "mariadb skip-ssl", opts: map[string]string{"skip-ssl": ""}, want: consts.SSLModeDisabled},
+		{name: "mariadb verify server cert", opts: map[string]string{"ssl": "", "ssl-verify-server-cert": ""}, want: consts.SSLModeVerifyIdentity},
+		{name: "mariadb ssl flag", opts: map[string]string{"ssl": ""}, want: consts.SSLModeRequired},
+		{name: "mariadb ssl off", opts: map[string]string{"ssl": "0"}, want: consts.SSLModeDisabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := optionSSLMode(tt.opts); got != tt.want {
+				t.Fatalf("optionSSLMode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
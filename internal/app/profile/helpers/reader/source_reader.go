@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -31,7 +32,10 @@ type ImportTableResult struct {
 // Ini adalah dispatcher function yang menentukan reader mana yang akan dipakai
 func ReadImportTable(input, gsheetURL, sheet string, gid int) (*ImportTableResult, error) {
 	if strings.TrimSpace(input) != "" {
-		return ReadXLSX(input, sheet)
+		if IsCSVPath(input) {
+			return ReadCSVFile(input)
+		}
+		return ReadXLSX(input, sheet, "")
 	}
 
 	if strings.TrimSpace(gsheetURL) != "" {
@@ -41,8 +45,9 @@ func ReadImportTable(input, gsheetURL, sheet string, gid int) (*ImportTableResul
 	return nil, fmt.Errorf("sumber import kosong")
 }
 
-// ReadXLSX membaca file XLSX lokal dan mengembalikan headers + data rows
-func ReadXLSX(path string, sheet string) (*ImportTableResult, error) {
+// ReadXLSX membaca file XLSX lokal dan mengembalikan headers + data rows.
+// password diisi jika workbook terenkripsi (mis. hasil `profile export --encrypt-key`).
+func ReadXLSX(path string, sheet string, password string) (*ImportTableResult, error) {
 	path = strings.TrimSpace(path)
 	if path == "" {
 		return nil, fmt.Errorf("path XLSX kosong")
@@ -55,9 +60,9 @@ func ReadXLSX(path string, sheet string) (*ImportTableResult, error) {
 		return nil, fmt.Errorf("path XLSX adalah direktori: %s", path)
 	}
 
-	f, err := excelize.OpenFile(path)
+	f, err := excelize.OpenFile(path, excelize.Options{Password: password})
 	if err != nil {
-		return nil, fmt.Errorf("gagal membuka XLSX: %w", err)
+		return nil, xlsxOpenError(err, password)
 	}
 	defer func() { _ = f.Close() }()
 
@@ -83,28 +88,58 @@ func ReadXLSX(path string, sheet string) (*ImportTableResult, error) {
 		return nil, fmt.Errorf("header kosong di sheet '%s'", sheetName)
 	}
 
-	data := [][]string{}
-	if len(allRows) > 1 {
-		data = allRows[1:]
+	return &ImportTableResult{
+		Headers:  headers,
+		DataRows: padRows(allRows[1:], len(headers)),
+		Warnings: nil,
+		Source:   "XLSX",
+	}, nil
+}
+
+// IsCSVPath mengecek apakah input lokal adalah file CSV (berdasarkan ekstensi).
+func IsCSVPath(path string) bool {
+	return strings.EqualFold(filepath.Ext(strings.TrimSpace(path)), ".csv")
+}
+
+// ReadCSVFile membaca file CSV lokal (mis. hasil `profile export --output profiles.csv`).
+func ReadCSVFile(path string) (*ImportTableResult, error) {
+	f, err := os.Open(strings.TrimSpace(path))
+	if err != nil {
+		return nil, fmt.Errorf("file CSV tidak ditemukan: %w", err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("gagal parse CSV: %w", err)
 	}
+	if len(records) == 0 || len(records[0]) == 0 {
+		return nil, fmt.Errorf("CSV kosong")
+	}
+
+	headers := records[0]
+	return &ImportTableResult{
+		Headers:  headers,
+		DataRows: padRows(records[1:], len(headers)),
+		Warnings: nil,
+		Source:   "CSV",
+	}, nil
+}
 
-	// Pad rows agar minimal sepanjang header
+// padRows memastikan setiap row minimal sepanjang header.
+func padRows(data [][]string, width int) [][]string {
 	rows := make([][]string, 0, len(data))
 	for _, r := range data {
-		if len(r) < len(headers) {
-			padded := make([]string, len(headers))
+		if len(r) < width {
+			padded := make([]string, width)
 			copy(padded, r)
 			r = padded
 		}
 		rows = append(rows, r)
 	}
-
-	return &ImportTableResult{
-		Headers:  headers,
-		DataRows: rows,
-		Warnings: nil,
-		Source:   "XLSX",
-	}, nil
+	return rows
 }
 
 // ReadGoogleSheetAsCSV membaca Google Spreadsheet via CSV export URL
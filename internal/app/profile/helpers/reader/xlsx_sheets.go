@@ -14,8 +14,8 @@ import (
 	"github.com/xuri/excelize/v2"
 )
 
-// ListXLSXSheets mengembalikan daftar nama sheet dari file XLSX.
-func ListXLSXSheets(path string) ([]string, error) {
+// ListXLSXSheets mengembalikan daftar nama sheet dari file XLSX (password untuk workbook terenkripsi).
+func ListXLSXSheets(path string, password string) ([]string, error) {
 	path = strings.TrimSpace(path)
 	if path == "" {
 		return nil, fmt.Errorf("path XLSX kosong")
@@ -28,9 +28,9 @@ func ListXLSXSheets(path string) ([]string, error) {
 		return nil, fmt.Errorf("path XLSX adalah direktori: %s", path)
 	}
 
-	f, err := excelize.OpenFile(path)
+	f, err := excelize.OpenFile(path, excelize.Options{Password: password})
 	if err != nil {
-		return nil, fmt.Errorf("gagal membuka XLSX: %w", err)
+		return nil, xlsxOpenError(err, password)
 	}
 	defer func() { _ = f.Close() }()
 
@@ -58,3 +58,11 @@ func ListXLSXSheets(path string) ([]string, error) {
 	}
 	return out, nil
 }
+
+// xlsxOpenError menambahkan petunjuk --input-password jika workbook kemungkinan terenkripsi.
+func xlsxOpenError(err error, password string) error {
+	if password == "" {
+		return fmt.Errorf("gagal membuka XLSX (jika workbook terenkripsi, gunakan --input-password): %w", err)
+	}
+	return fmt.Errorf("gagal membuka XLSX (password workbook salah?): %w", err)
+}
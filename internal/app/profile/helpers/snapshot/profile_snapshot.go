@@ -2,7 +2,7 @@
 // Deskripsi : Helper untuk load snapshot profile + metadata file (untuk show/edit)
 // Author : Hadiyatna Muflihun
 // Tanggal : 14 Januari 2026
-// Last Modified : 14 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package snapshot
 
@@ -42,6 +42,8 @@ func buildSnapshotFromMeta(absPath string, info *domain.ProfileInfo) *domain.Pro
 		Name:         name,
 		DBInfo:       info.DBInfo,
 		SSHTunnel:    info.SSHTunnel,
+		Tags:         info.Tags,
+		Groups:       info.Groups,
 		Size:         fileSizeStr,
 		LastModified: lastMod,
 		// EncryptionSource sengaja tidak diset di snapshot; ini fokus untuk display baseline.
@@ -61,6 +63,7 @@ func LoadProfileSnapshotFromPath(opts SnapshotLoadOptions) (*domain.ProfileInfo,
 		ProfilePath:    opts.ProfilePath,
 		ProfileKey:     opts.ProfileKey,
 		RequireProfile: opts.RequireProfile,
+		KeepSecretRefs: true,
 	})
 	if err != nil {
 		return buildSnapshotFromMeta(opts.ProfilePath, &domain.ProfileInfo{}), err
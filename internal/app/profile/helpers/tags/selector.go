@@ -0,0 +1,158 @@
+// File : internal/app/profile/helpers/tags/selector.go
+// Deskripsi : Selector profile berbasis tag/grup/nama untuk operasi fleet (--profiles)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package tags
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"sfdbtools/internal/domain"
+)
+
+type termKind int
+
+const (
+	termAll termKind = iota
+	termTag
+	termGroup
+	termName
+)
+
+type term struct {
+	kind   termKind
+	key    string
+	value  string // glob; kosong + hasValue=false berarti cukup key ada
+	hasVal bool
+	negate bool
+}
+
+// Selector memilih profile berdasarkan ekspresi --profiles.
+//
+// Sintaks (beberapa term dipisah koma = AND, prefix '!' = negasi):
+//
+//	all | *              semua profile
+//	tag:env=prod         tag env bernilai prod (value boleh glob, mis. region=jk*)
+//	tag:env              profile punya tag env
+//	group:jkt-primary    profile anggota grup
+//	name:prod-* | prod-* nama profile cocok dengan glob
+type Selector struct {
+	expr  string
+	terms []term
+}
+
+// ParseSelector mem-parsing ekspresi selector.
+func ParseSelector(expr string) (*Selector, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("selector --profiles kosong")
+	}
+	s := &Selector{expr: expr}
+	for _, raw := range strings.Split(expr, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		t := term{}
+		if strings.HasPrefix(raw, "!") {
+			t.negate = true
+			raw = strings.TrimSpace(raw[1:])
+		}
+		prefix, rest, hasPrefix := strings.Cut(raw, ":")
+		switch {
+		case raw == "all" || raw == "*":
+			t.kind = termAll
+		case hasPrefix && strings.EqualFold(prefix, "tag"):
+			t.kind = termTag
+			key, val, hasVal := strings.Cut(rest, "=")
+			t.key = strings.ToLower(strings.TrimSpace(key))
+			t.value = strings.TrimSpace(val)
+			t.hasVal = hasVal
+			if !nameRe.MatchString(t.key) {
+				return nil, fmt.Errorf("selector tidak valid %q: key tag kosong/invalid", raw)
+			}
+		case hasPrefix && strings.EqualFold(prefix, "group"):
+			t.kind = termGroup
+			t.value = strings.ToLower(strings.TrimSpace(rest))
+			if t.value == "" {
+				return nil, fmt.Errorf("selector tidak valid %q: nama grup kosong", raw)
+			}
+		case hasPrefix && strings.EqualFold(prefix, "name"):
+			t.kind = termName
+			t.value = strings.TrimSpace(rest)
+		case hasPrefix:
+			return nil, fmt.Errorf("selector tidak valid %q: prefix yang didukung tag:, group:, name:", raw)
+		default:
+			t.kind = termName
+			t.value = raw
+		}
+		if t.kind == termName || t.kind == termGroup || t.hasVal {
+			if _, err := filepath.Match(t.value, ""); err != nil {
+				return nil, fmt.Errorf("selector tidak valid %q: %w", raw, err)
+			}
+		}
+		s.terms = append(s.terms, t)
+	}
+	if len(s.terms) == 0 {
+		return nil, fmt.Errorf("selector --profiles kosong")
+	}
+	return s, nil
+}
+
+// String mengembalikan ekspresi asli.
+func (s *Selector) String() string { return s.expr }
+
+// NeedsMetadata true jika selector butuh isi profile (tag/grup), sehingga profile harus didekripsi.
+func (s *Selector) NeedsMetadata() bool {
+	for _, t := range s.terms {
+		if t.kind == termTag || t.kind == termGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// Match mengecek apakah profile memenuhi semua term.
+func (s *Selector) Match(info *domain.ProfileInfo) bool {
+	if s == nil || info == nil {
+		return false
+	}
+	for _, t := range s.terms {
+		if t.match(info) == t.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func (t term) match(info *domain.ProfileInfo) bool {
+	switch t.kind {
+	case termAll:
+		return true
+	case termTag:
+		v, ok := info.Tags[t.key]
+		if !ok {
+			return false
+		}
+		if !t.hasVal {
+			return true
+		}
+		matched, _ := filepath.Match(strings.ToLower(t.value), strings.ToLower(v))
+		return matched
+	case termGroup:
+		for _, g := range info.Groups {
+			if matched, _ := filepath.Match(t.value, g); matched {
+				return true
+			}
+		}
+		return false
+	case termName:
+		matched, _ := filepath.Match(t.value, info.Name)
+		return matched
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package tags
+
+import (
+	"testing"
+
+	"sfdbtools/internal/domain"
+)
+
+func TestParseSelectorErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "all", expr: "all"},
+		{name: "star", expr: "*"},
+		{name: "tag with value", expr: "tag:env=prod"},
+		{name: "tag key only", expr: "tag:env"},
+		{name: "group", expr: "group:jkt-primary"},
+		{name: "bare name glob", expr: "prod-*"},
+		{name: "multiple terms", expr: "tag:env=prod, !name:prod-db2"},
+		{name: "empty", expr: "  ", wantErr: true},
+		{name: "only commas", expr: ",,", wantErr: true},
+		{name: "unknown prefix", expr: "host:db1", wantErr: true},
+		{name: "empty tag key", expr: "tag:=prod", wantErr: true},
+		{name: "invalid tag key", expr: "tag:-env", wantErr: true},
+		{name: "empty group", expr: "group:", wantErr: true},
+		{name: "bad glob", expr: "name:prod-[", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSelector(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSelector(%q) err = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSelectorMatch(t *testing.T) {
+	prod := &domain.ProfileInfo{
+		Name:   "prod-db1",
+		Tags:   map[string]string{"env": "prod", "region": "jkt-1"},
+		Groups: []string{"jkt-primary"},
+	}
+	staging := &domain.ProfileInfo{
+		Name: "staging-db1",
+		Tags: map[string]string{"env": "staging"},
+	}
+
+	tests := []struct {
+		name         string
+		expr         string
+		profile      *domain.ProfileInfo
+		want         bool
+		needMetadata bool
+	}{
+		{name: "all matches", expr: "all", profile: staging, want: true},
+		{name: "tag value", expr: "tag:env=prod", profile: prod, want: true, needMetadata: true},
+		{name: "tag value mismatch", expr: "tag:env=prod", profile: staging, want: false, needMetadata: true},
+		{name: "tag value glob case-insensitive", expr: "tag:region=JKT*", profile: prod, want: true, needMetadata: true},
+		{name: "tag key present", expr: "tag:region", profile: prod, want: true, needMetadata: true},
+		{name: "tag key missing", expr: "tag:region", profile: staging, want: false, needMetadata: true},
+		{name: "group", expr: "group:jkt-primary", profile: prod, want: true, needMetadata: true},
+		{name: "group missing", expr: "group:jkt-primary", profile: staging, want: false, needMetadata: true},
+		{name: "name glob", expr: "prod-*", profile: prod, want: true},
+		{name: "name prefix", expr: "name:staging-*", profile: prod, want: false},
+		{name: "negation", expr: "!name:prod-*", profile: staging, want: true},
+		{name: "and with negation", expr: "tag:env=prod,!name:prod-db1", profile: prod, want: false, needMetadata: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseSelector(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseSelector(%q): %v", tt.expr, err)
+			}
+			if got := s.Match(tt.profile); got != tt.want {
+				t.Fatalf("Match(%s) = %v, want %v", tt.profile.Name, got, tt.want)
+			}
+			if got := s.NeedsMetadata(); got != tt.needMetadata {
+				t.Fatalf("NeedsMetadata() = %v, want %v", got, tt.needMetadata)
+			}
+		})
+	}
+}
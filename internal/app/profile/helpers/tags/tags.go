@@ -0,0 +1,147 @@
+// File : internal/app/profile/helpers/tags/tags.go
+// Deskripsi : Parsing dan normalisasi tag (key=value) serta grup pada profile
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var nameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// ParseTags mem-parsing daftar "key=value" (atau "key" saja) menjadi map.
+// Setiap item boleh berisi beberapa tag dipisah koma, mis. "env=prod,region=jkt".
+func ParseTags(items []string) (map[string]string, error) {
+	out := map[string]string{}
+	for _, item := range items {
+		for _, raw := range strings.Split(item, ",") {
+			raw = strings.TrimSpace(raw)
+			if raw == "" {
+				continue
+			}
+			key, val, _ := strings.Cut(raw, "=")
+			key = strings.ToLower(strings.TrimSpace(key))
+			val = strings.TrimSpace(val)
+			if !nameRe.MatchString(key) {
+				return nil, fmt.Errorf("tag tidak valid %q: key hanya boleh huruf, angka, '_', '.', '-'", raw)
+			}
+			if strings.ContainsAny(val, ",\n\r") {
+				return nil, fmt.Errorf("tag tidak valid %q: value tidak boleh berisi koma/baris baru", raw)
+			}
+			out[key] = val
+		}
+	}
+	return out, nil
+}
+
+// ParseTagString mem-parsing nilai tag tersimpan (format FormatTags). Item invalid diabaikan.
+func ParseTagString(s string) map[string]string {
+	out, err := ParseTags([]string{s})
+	if err != nil {
+		out = map[string]string{}
+		for _, raw := range strings.Split(s, ",") {
+			if m, perr := ParseTags([]string{raw}); perr == nil {
+				for k, v := range m {
+					out[k] = v
+				}
+			}
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// FormatTags menyusun map tag menjadi "k1=v1,k2=v2" (terurut berdasarkan key).
+func FormatTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if tags[k] == "" {
+			parts = append(parts, k)
+			continue
+		}
+		parts = append(parts, k+"="+tags[k])
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseGroups mem-parsing daftar grup (boleh dipisah koma), lalu dedup dan sort.
+func ParseGroups(items []string) ([]string, error) {
+	seen := map[string]bool{}
+	var out []string
+	for _, item := range items {
+		for _, g := range strings.Split(item, ",") {
+			g = strings.ToLower(strings.TrimSpace(g))
+			if g == "" || seen[g] {
+				continue
+			}
+			if !nameRe.MatchString(g) {
+				return nil, fmt.Errorf("grup tidak valid %q: hanya boleh huruf, angka, '_', '.', '-'", g)
+			}
+			seen[g] = true
+			out = append(out, g)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// ParseGroupString mem-parsing nilai grup tersimpan. Item invalid diabaikan.
+func ParseGroupString(s string) []string {
+	var out []string
+	for _, g := range strings.Split(s, ",") {
+		if parsed, err := ParseGroups([]string{g}); err == nil {
+			out = append(out, parsed...)
+		}
+	}
+	out, _ = ParseGroups(out)
+	return out
+}
+
+// Apply menerapkan perubahan tag/grup (set, unset, tambah, hapus) ke nilai awal dan mengembalikan hasil baru.
+func Apply(base map[string]string, groups []string, set map[string]string, unset []string, addGroups []string, removeGroups []string) (map[string]string, []string) {
+	outTags := map[string]string{}
+	for k, v := range base {
+		outTags[k] = v
+	}
+	for k, v := range set {
+		outTags[k] = v
+	}
+	for _, k := range unset {
+		delete(outTags, strings.ToLower(strings.TrimSpace(k)))
+	}
+
+	remove := map[string]bool{}
+	for _, g := range removeGroups {
+		remove[strings.ToLower(strings.TrimSpace(g))] = true
+	}
+	var merged []string
+	for _, g := range append(append([]string{}, groups...), addGroups...) {
+		if !remove[g] {
+			merged = append(merged, g)
+		}
+	}
+	merged, _ = ParseGroups(merged)
+
+	if len(outTags) == 0 {
+		outTags = nil
+	}
+	return outTags, merged
+}
+
+// Equal membandingkan dua set tag dan grup.
+func Equal(aTags map[string]string, aGroups []string, bTags map[string]string, bGroups []string) bool {
+	return FormatTags(aTags) == FormatTags(bTags) && strings.Join(aGroups, ",") == strings.Join(bGroups, ",")
+}
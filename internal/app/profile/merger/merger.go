@@ -2,7 +2,7 @@
 // Deskripsi : Helper untuk merge snapshot dan override saat edit profile
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 14 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package merger
 
@@ -10,7 +10,9 @@ import (
 	"strings"
 
 	"sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/helpers/tags"
 	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
 	"sfdbtools/internal/shared/validation"
 )
 
@@ -27,6 +29,8 @@ func CloneAsOriginalProfileInfo(info *domain.ProfileInfo) *domain.ProfileInfo {
 		Name:         info.Name,
 		DBInfo:       info.DBInfo,
 		SSHTunnel:    info.SSHTunnel,
+		Tags:         info.Tags,
+		Groups:       info.Groups,
 		Size:         info.Size,
 		LastModified: info.LastModified,
 	}
@@ -38,6 +42,8 @@ func ApplySnapshotAsBaseline(dst *domain.ProfileInfo, snapshot *domain.ProfileIn
 	}
 	dst.DBInfo = snapshot.DBInfo
 	dst.SSHTunnel = snapshot.SSHTunnel
+	dst.Tags = snapshot.Tags
+	dst.Groups = snapshot.Groups
 	if strings.TrimSpace(dst.Name) == "" {
 		dst.Name = snapshot.Name
 	}
@@ -62,6 +68,29 @@ func ApplyDBOverrides(dst *domain.ProfileInfo, override domain.DBInfo) {
 	if strings.TrimSpace(override.Password) != "" {
 		dst.DBInfo.Password = override.Password
 	}
+	if strings.TrimSpace(override.TLS.Mode) != "" {
+		dst.DBInfo.TLS.Mode = override.TLS.Mode
+	}
+	if strings.TrimSpace(override.TLS.CA) != "" {
+		dst.DBInfo.TLS.CA = override.TLS.CA
+	}
+	if strings.TrimSpace(override.TLS.Cert) != "" {
+		dst.DBInfo.TLS.Cert = override.TLS.Cert
+	}
+	if strings.TrimSpace(override.TLS.Key) != "" {
+		dst.DBInfo.TLS.Key = override.TLS.Key
+	}
+	switch socket := strings.TrimSpace(override.Socket); socket {
+	case "":
+	case consts.DBSocketNone:
+		dst.DBInfo.Socket = ""
+	default:
+		dst.DBInfo.Socket = socket
+	}
+	// ssl_mode=disabled membuang file TLS agar profile tetap konsisten.
+	if dst.DBInfo.TLS.Mode == consts.SSLModeDisabled {
+		dst.DBInfo.TLS = domain.TLSConfig{Mode: consts.SSLModeDisabled}
+	}
 }
 
 func ApplySSHOverrides(dst *domain.ProfileInfo, override domain.SSHTunnelConfig) {
@@ -90,15 +119,35 @@ func ApplySSHOverrides(dst *domain.ProfileInfo, override domain.SSHTunnelConfig)
 	if override.LocalPort != 0 {
 		dst.SSHTunnel.LocalPort = override.LocalPort
 	}
+	// JumpHosts non-nil (termasuk slice kosong) berarti user mengubah rantai; slice kosong menghapusnya.
+	if override.JumpHosts != nil {
+		dst.SSHTunnel.JumpHosts = append([]string(nil), override.JumpHosts...)
+		if len(dst.SSHTunnel.JumpHosts) == 0 {
+			dst.SSHTunnel.JumpHosts = nil
+		}
+	}
+	if override.UseAgent {
+		dst.SSHTunnel.UseAgent = true
+	}
+}
+
+// ApplyTagOverrides menerapkan perubahan tag/grup dari flag edit ke profile.
+func ApplyTagOverrides(dst *domain.ProfileInfo, set map[string]string, unset []string, addGroups []string, removeGroups []string) {
+	if dst == nil {
+		return
+	}
+	dst.Tags, dst.Groups = tags.Apply(dst.Tags, dst.Groups, set, unset, addGroups, removeGroups)
 }
 
 func HasAnyDBOverride(override domain.DBInfo) bool {
 	return strings.TrimSpace(override.Host) != "" || override.Port != 0 ||
-		strings.TrimSpace(override.User) != "" || strings.TrimSpace(override.Password) != ""
+		strings.TrimSpace(override.User) != "" || strings.TrimSpace(override.Password) != "" ||
+		override.TLS != (domain.TLSConfig{}) || strings.TrimSpace(override.Socket) != ""
 }
 
 func HasAnySSHOverride(override domain.SSHTunnelConfig) bool {
 	return override.Enabled || strings.TrimSpace(override.Host) != "" || override.Port != 0 ||
 		strings.TrimSpace(override.User) != "" || strings.TrimSpace(override.Password) != "" ||
-		strings.TrimSpace(override.IdentityFile) != "" || override.LocalPort != 0
+		strings.TrimSpace(override.IdentityFile) != "" || override.LocalPort != 0 ||
+		override.JumpHosts != nil || override.UseAgent
 }
@@ -2,13 +2,15 @@
 // Deskripsi : Types dan constants untuk import profile functionality
 // Author : Hadiyatna Muflihun
 // Tanggal : 25 Januari 2026
-// Last Modified : 25 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package types
 
 import (
 	"fmt"
 	"strings"
+
+	"sfdbtools/internal/domain"
 )
 
 // Import conflict resolution strategies
@@ -63,6 +65,7 @@ type ImportRow struct {
 	User       string
 	Password   string
 	ProfileKey string
+	Socket     string // Path unix socket (opsional; password boleh kosong)
 
 	// SSH tunnel fields
 	SSHEnabled  bool
@@ -71,8 +74,17 @@ type ImportRow struct {
 	SSHUser     string
 	SSHPassword string
 	SSHIdentity string
+	SSHJumps    []string
+	SSHAgent    bool
 	SSHLocal    int
 
+	// TLS/SSL (kolom opsional)
+	TLS domain.TLSConfig
+
+	// Tag dan grup (kolom opsional)
+	Tags   map[string]string
+	Groups []string
+
 	// Processing metadata
 	Skip       bool   // Whether to skip this row
 	SkipReason string // Reason for skipping (invalid|duplicate|conflict|conn_test|unknown)
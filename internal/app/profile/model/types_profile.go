@@ -1,6 +1,9 @@
 package types
 
 import (
+	"slices"
+
+	"sfdbtools/internal/app/profile/helpers/tags"
 	"sfdbtools/internal/domain"
 	"sfdbtools/internal/shared/consts"
 )
@@ -15,7 +18,7 @@ type ProfileOptions interface {
 
 // ProfileCreateOptions - Options for creating a new profile
 type ProfileCreateOptions struct {
-	ProfileInfo domain.ProfileInfo
+	ProfileInfo domain.ProfileInfo // Tags/Groups diisi dari --tag/--group
 	OutputDir   string
 	Interactive bool
 }
@@ -31,6 +34,10 @@ type ProfileEditOptions struct {
 	NewName             string
 	NewProfileKey       string
 	NewProfileKeySource string
+	SetTags             map[string]string // --tag key=value (tambah/ubah)
+	UnsetTags           []string          // --untag key
+	AddGroups           []string          // --group
+	RemoveGroups        []string          // --ungroup
 }
 
 func (o *ProfileEditOptions) Mode() string { return consts.ProfileModeEdit }
@@ -80,10 +87,14 @@ func (o *ProfileCloneOptions) IsInteractive() bool { return o != nil && o.Intera
 // ProfileImportOptions - Options for importing profiles (bulk) dari XLSX atau Google Spreadsheet.
 // Catatan: 1 row = 1 profile = 1 encryption key.
 type ProfileImportOptions struct {
-	Input           string // Path file XLSX lokal (mutually exclusive dengan GSheetURL)
+	Input           string // Path file XLSX/CSV lokal (mutually exclusive dengan GSheetURL)
+	InputPassword   string // Password workbook XLSX terenkripsi (hasil profile export --encrypt-key)
 	Sheet           string // Nama sheet di XLSX (opsional; default sheet pertama)
 	GSheetURL       string // Google Spreadsheet URL (edit/share)
 	GID             int    // Sheet/tab gid untuk export CSV (Google)
+	FromMyCnf       string // Option file MySQL (mis. /root/.my.cnf, /etc/mysql/debian.cnf)
+	FromLoginPath   string // File login path mysql_config_editor (.mylogin.cnf)
+	ProfileKey      string // Kunci enkripsi profile baru untuk sumber option file/login path
 	OnConflict      string // fail|skip|overwrite|rename
 	SkipConfirm     bool   // Jika true: tidak ada prompt (wajib untuk automation)
 	SkipInvalidRows bool   // Jika true: row invalid di-skip (default false)
@@ -101,6 +112,118 @@ func (o *ProfileImportOptions) Mode() string { return consts.ProfileModeImport }
 
 func (o *ProfileImportOptions) IsInteractive() bool { return o != nil && o.Interactive }
 
+// ProfileRekeyOptions - Options untuk rotasi kunci enkripsi profile (profile rekey).
+type ProfileRekeyOptions struct {
+	OldKey      string   // Kunci lama default (boleh referensi secret)
+	NewKey      string   // Kunci baru untuk semua profile target
+	KeyMapFile  string   // File "nama_profile = kunci" untuk profile dengan kunci lama berbeda
+	All         bool     // Rekey semua profile di config_dir.database_profile
+	Profiles    []string // Profile tertentu (jika All=false)
+	KeepBackup  bool     // Pertahankan backup file lama setelah run sukses
+	SkipConfirm bool
+	Interactive bool
+}
+
+func (o *ProfileRekeyOptions) Mode() string { return consts.ProfileModeRekey }
+
+func (o *ProfileRekeyOptions) IsInteractive() bool { return o != nil && o.Interactive }
+
+// ProfileExportOptions - Options untuk export profile ke XLSX/CSV (schema sama dengan profile import).
+type ProfileExportOptions struct {
+	Output      string   // Path output (.xlsx atau .csv)
+	ProfileKey  string   // Kunci default untuk dekripsi profile
+	KeyMapFile  string   // File "nama_profile = kunci" untuk profile dengan kunci berbeda
+	Names       []string // Filter glob nama profile (kosong = semua)
+	Redact      bool     // Kosongkan password, ssh_password, dan profile_key
+	EncryptKey  string   // Password untuk mengenkripsi workbook XLSX
+	Force       bool     // Timpa file output yang sudah ada
+	Interactive bool
+}
+
+func (o *ProfileExportOptions) Mode() string { return consts.ProfileModeExport }
+
+func (o *ProfileExportOptions) IsInteractive() bool { return o != nil && o.Interactive }
+
+// ProfileTestOptions - Options untuk test koneksi sebuah profile atau banyak profile sekaligus.
+type ProfileTestOptions struct {
+	ProfileInfo domain.ProfileInfo
+	Selector    string // Selector --profiles ("all" untuk --all); kosong berarti satu profile
+	Concurrency int    // Jumlah profile yang dites paralel (0 = profile.fleet.concurrency)
+	Format      string // Format laporan: table atau json
+	Interactive bool
+}
+
+// IsMulti bernilai true jika test dijalankan ke banyak profile (--all/--profiles).
+func (o *ProfileTestOptions) IsMulti() bool { return o != nil && o.Selector != "" }
+
+func (o *ProfileTestOptions) Mode() string { return consts.ProfileModeTest }
+
+func (o *ProfileTestOptions) IsInteractive() bool { return o != nil && o.Interactive }
+
+// ProfileSSHTrustOptions - Options untuk memindai dan mem-pin host key SSH sebuah profile.
+type ProfileSSHTrustOptions struct {
+	ProfileInfo  domain.ProfileInfo
+	Fingerprints []string // Fingerprint SHA256 yang diharapkan; hop yang cocok di-pin tanpa konfirmasi
+	SkipConfirm  bool     // Pin host key yang belum dikenal tanpa konfirmasi (mismatch tetap wajib --fingerprint)
+	Interactive  bool
+}
+
+func (o *ProfileSSHTrustOptions) Mode() string { return consts.ProfileModeSSHTrust }
+
+func (o *ProfileSSHTrustOptions) IsInteractive() bool { return o != nil && o.Interactive }
+
+// ProfileHistoryOptions - Options untuk menampilkan daftar versi lama sebuah profile.
+type ProfileHistoryOptions struct {
+	ProfileName string
+	Interactive bool
+}
+
+func (o *ProfileHistoryOptions) Mode() string { return consts.ProfileModeHistory }
+
+func (o *ProfileHistoryOptions) IsInteractive() bool { return o != nil && o.Interactive }
+
+// ProfileDiffOptions - Options untuk membandingkan dua versi profile.
+type ProfileDiffOptions struct {
+	ProfileName string
+	From        int    // Nomor versi; 0 = file profile aktif (current)
+	To          int    // Nomor versi; 0 = file profile aktif (current)
+	ProfileKey  string // Kunci untuk mendekripsi versi (boleh referensi secret)
+	Interactive bool
+}
+
+func (o *ProfileDiffOptions) Mode() string { return consts.ProfileModeDiff }
+
+func (o *ProfileDiffOptions) IsInteractive() bool { return o != nil && o.Interactive }
+
+// ProfileRollbackOptions - Options untuk mengembalikan profile ke versi lama.
+type ProfileRollbackOptions struct {
+	ProfileName string
+	Version     int
+	ProfileKey  string // Kunci untuk mendekripsi versi (boleh referensi secret)
+	SkipConfirm bool
+	Interactive bool
+}
+
+func (o *ProfileRollbackOptions) Mode() string { return consts.ProfileModeRollback }
+
+func (o *ProfileRollbackOptions) IsInteractive() bool { return o != nil && o.Interactive }
+
+// ProfileSyncOptions - Options untuk menyamakan direktori profile dengan manifest YAML/JSON/CSV.
+type ProfileSyncOptions struct {
+	Input           string // Path manifest (YAML/JSON) atau CSV
+	ProfileKey      string // Kunci default untuk profile tanpa profile_key di manifest
+	Prune           bool   // Hapus profile yang tidak ada di manifest (tetap minta konfirmasi di mode interaktif)
+	DryRun          bool   // Hanya tampilkan rencana
+	SkipConfirm     bool
+	SkipConnTest    bool
+	ContinueOnError bool
+	Interactive     bool
+}
+
+func (o *ProfileSyncOptions) Mode() string { return consts.ProfileModeSync }
+
+func (o *ProfileSyncOptions) IsInteractive() bool { return o != nil && o.Interactive }
+
 // ProfileEntryConfig menyimpan konfigurasi untuk entry point profile operations
 type ProfileEntryConfig struct {
 	HeaderTitle string // UI header title
@@ -146,6 +269,12 @@ func (s *ProfileState) HasMeaningfulChanges() bool {
 	if orig.DBInfo.Password != cur.DBInfo.Password {
 		return true
 	}
+	if orig.DBInfo.TLS != cur.DBInfo.TLS {
+		return true
+	}
+	if orig.DBInfo.Socket != cur.DBInfo.Socket {
+		return true
+	}
 
 	if orig.SSHTunnel.Enabled != cur.SSHTunnel.Enabled {
 		return true
@@ -168,6 +297,15 @@ func (s *ProfileState) HasMeaningfulChanges() bool {
 	if orig.SSHTunnel.LocalPort != cur.SSHTunnel.LocalPort {
 		return true
 	}
+	if !slices.Equal(orig.SSHTunnel.JumpHosts, cur.SSHTunnel.JumpHosts) {
+		return true
+	}
+	if orig.SSHTunnel.UseAgent != cur.SSHTunnel.UseAgent {
+		return true
+	}
+	if !tags.Equal(orig.Tags, orig.Groups, cur.Tags, cur.Groups) {
+		return true
+	}
 
 	return false
 }
@@ -215,3 +353,43 @@ func (s *ProfileState) Mode() string {
 	}
 	return s.Options.Mode()
 }
+
+func (s *ProfileState) RekeyOptions() (*ProfileRekeyOptions, bool) {
+	o, ok := s.Options.(*ProfileRekeyOptions)
+	return o, ok
+}
+
+func (s *ProfileState) ExportOptions() (*ProfileExportOptions, bool) {
+	o, ok := s.Options.(*ProfileExportOptions)
+	return o, ok
+}
+
+func (s *ProfileState) TestOptions() (*ProfileTestOptions, bool) {
+	o, ok := s.Options.(*ProfileTestOptions)
+	return o, ok
+}
+
+func (s *ProfileState) SSHTrustOptions() (*ProfileSSHTrustOptions, bool) {
+	o, ok := s.Options.(*ProfileSSHTrustOptions)
+	return o, ok
+}
+
+func (s *ProfileState) HistoryOptions() (*ProfileHistoryOptions, bool) {
+	o, ok := s.Options.(*ProfileHistoryOptions)
+	return o, ok
+}
+
+func (s *ProfileState) DiffOptions() (*ProfileDiffOptions, bool) {
+	o, ok := s.Options.(*ProfileDiffOptions)
+	return o, ok
+}
+
+func (s *ProfileState) RollbackOptions() (*ProfileRollbackOptions, bool) {
+	o, ok := s.Options.(*ProfileRollbackOptions)
+	return o, ok
+}
+
+func (s *ProfileState) SyncOptions() (*ProfileSyncOptions, bool) {
+	o, ok := s.Options.(*ProfileSyncOptions)
+	return o, ok
+}
@@ -2,12 +2,15 @@
 // Deskripsi : Auth method builder untuk SSH tunnel
 // Author : Hadiyatna Muflihun
 // Tanggal : 14 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package process
 
 import (
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
@@ -17,45 +20,132 @@ import (
 	"golang.org/x/crypto/ssh/agent"
 )
 
-func buildAuthMethods(opts SSHTunnelOptions) ([]ssh.AuthMethod, error) {
-	methods := []ssh.AuthMethod{}
+// ErrIdentityPassphraseRequired dikembalikan jika identity file terenkripsi tetapi passphrase kosong.
+var ErrIdentityPassphraseRequired = errors.New("identity file SSH terenkripsi dan membutuhkan passphrase")
 
-	if strings.TrimSpace(opts.IdentityFile) != "" {
-		keyPath := opts.IdentityFile
-		if !filepath.IsAbs(keyPath) {
-			if wd, err := os.Getwd(); err == nil {
-				keyPath = filepath.Join(wd, keyPath)
-			}
+func resolveIdentityPath(path string) string {
+	keyPath := strings.TrimSpace(path)
+	if !filepath.IsAbs(keyPath) {
+		if wd, err := os.Getwd(); err == nil {
+			keyPath = filepath.Join(wd, keyPath)
 		}
-		keyPath = filepath.Clean(keyPath)
-		b, err := os.ReadFile(keyPath)
-		if err != nil {
-			return nil, fmt.Errorf("gagal membaca identity file '%s': %w", keyPath, err)
+	}
+	return filepath.Clean(keyPath)
+}
+
+// IdentityNeedsPassphrase true jika identity file adalah private key terenkripsi.
+func IdentityNeedsPassphrase(path string) (bool, error) {
+	if strings.TrimSpace(path) == "" {
+		return false, nil
+	}
+	keyPath := resolveIdentityPath(path)
+	b, err := os.ReadFile(keyPath)
+	if err != nil {
+		return false, fmt.Errorf("gagal membaca identity file '%s': %w", keyPath, err)
+	}
+	_, err = ssh.ParsePrivateKey(b)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		return true, nil
+	}
+	return false, nil
+}
+
+func loadIdentitySigner(path string, passphrase string) (ssh.Signer, error) {
+	keyPath := resolveIdentityPath(path)
+	b, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca identity file '%s': %w", keyPath, err)
+	}
+	signer, err := ssh.ParsePrivateKey(b)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		if passphrase == "" {
+			return nil, fmt.Errorf("%w: %s", ErrIdentityPassphraseRequired, keyPath)
 		}
-		signer, err := ssh.ParsePrivateKey(b)
-		if err != nil {
-			return nil, fmt.Errorf("gagal parse identity file '%s': %w", keyPath, err)
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(b, []byte(passphrase))
+		if errors.Is(err, x509.IncorrectPasswordError) {
+			return nil, fmt.Errorf("passphrase identity file '%s' salah", keyPath)
 		}
-		methods = append(methods, ssh.PublicKeys(signer))
 	}
+	if err != nil {
+		return nil, fmt.Errorf("gagal parse identity file '%s': %w", keyPath, err)
+	}
+	return signer, nil
+}
 
-	if strings.TrimSpace(opts.Password) != "" {
-		methods = append(methods, ssh.Password(opts.Password))
+// buildAuthMethods menyusun metode autentikasi: public key (identity file lalu ssh-agent), lalu password.
+// Closer yang dikembalikan (koneksi ke agent) harus ditutup setelah client SSH selesai dipakai.
+func buildAuthMethods(opts SSHTunnelOptions) ([]ssh.AuthMethod, io.Closer, error) {
+	var fileSigner ssh.Signer
+	if strings.TrimSpace(opts.IdentityFile) != "" {
+		signer, err := loadIdentitySigner(opts.IdentityFile, opts.Passphrase)
+		if err != nil {
+			return nil, nil, err
+		}
+		fileSigner = signer
 	}
 
-	// ssh-agent (jika tersedia)
-	if sock := strings.TrimSpace(os.Getenv("SSH_AUTH_SOCK")); sock != "" {
-		if conn, err := net.Dial("unix", sock); err == nil {
-			ag := agent.NewClient(conn)
-			if signers, serr := ag.Signers(); serr == nil && len(signers) > 0 {
-				methods = append(methods, ssh.PublicKeys(signers...))
+	// ssh-agent: wajib jika UseAgent, selain itu dipakai jika tersedia.
+	// Koneksi agent dibiarkan terbuka karena signing terjadi saat handshake setiap hop.
+	var agentConn io.Closer
+	var agentSigners func() ([]ssh.Signer, error)
+	sock := strings.TrimSpace(os.Getenv("SSH_AUTH_SOCK"))
+	switch {
+	case sock != "":
+		conn, err := net.Dial("unix", sock)
+		if err != nil {
+			if opts.UseAgent {
+				return nil, nil, fmt.Errorf("gagal konek ke ssh-agent (%s): %w", sock, err)
 			}
+			break
+		}
+		ag := agent.NewClient(conn)
+		signers, serr := ag.Signers()
+		if serr != nil || len(signers) == 0 {
 			_ = conn.Close()
+			if opts.UseAgent {
+				return nil, nil, fmt.Errorf("ssh-agent (%s) tidak memiliki key yang dapat dipakai", sock)
+			}
+			break
 		}
+		agentSigners = ag.Signers
+		agentConn = conn
+	case opts.UseAgent:
+		return nil, nil, fmt.Errorf("ssh agent diaktifkan tetapi SSH_AUTH_SOCK tidak diset")
+	}
+
+	methods := []ssh.AuthMethod{}
+	// x/crypto/ssh hanya mencoba setiap nama metode sekali, jadi identity file dan key agent
+	// harus digabung dalam satu metode "publickey".
+	if fileSigner != nil || agentSigners != nil {
+		methods = append(methods, ssh.PublicKeysCallback(publicKeySigners(fileSigner, agentSigners)))
+	}
+	if strings.TrimSpace(opts.Password) != "" {
+		methods = append(methods, ssh.Password(opts.Password))
 	}
 
 	if len(methods) == 0 {
-		return nil, fmt.Errorf("metode autentikasi SSH tidak tersedia (isi ssh_password atau identity_file atau gunakan ssh-agent)")
+		return nil, nil, fmt.Errorf("metode autentikasi SSH tidak tersedia (isi ssh_password atau identity_file atau gunakan ssh-agent)")
+	}
+	return methods, agentConn, nil
+}
+
+// publicKeySigners mengembalikan callback yang menawarkan key identity file lebih dulu, lalu key ssh-agent.
+// Kegagalan membaca agent tidak menggagalkan key identity file.
+func publicKeySigners(fileSigner ssh.Signer, agentSigners func() ([]ssh.Signer, error)) func() ([]ssh.Signer, error) {
+	return func() ([]ssh.Signer, error) {
+		var signers []ssh.Signer
+		if fileSigner != nil {
+			signers = append(signers, fileSigner)
+		}
+		if agentSigners != nil {
+			fromAgent, err := agentSigners()
+			if err != nil && len(signers) == 0 {
+				return nil, err
+			}
+			signers = append(signers, fromAgent...)
+		}
+		return signers, nil
 	}
-	return methods, nil
 }
@@ -0,0 +1,53 @@
+package process
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"slices"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func testSSHSigner(t *testing.T, seed byte) ssh.Signer {
+	t.Helper()
+	signer, err := ssh.NewSignerFromKey(ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func TestPublicKeySigners(t *testing.T) {
+	file := testSSHSigner(t, 1)
+	agentKey := testSSHSigner(t, 2)
+	agentOK := func() ([]ssh.Signer, error) { return []ssh.Signer{agentKey}, nil }
+	agentErr := func() ([]ssh.Signer, error) { return nil, errors.New("agent putus") }
+
+	tests := []struct {
+		name    string
+		file    ssh.Signer
+		agent   func() ([]ssh.Signer, error)
+		want    []ssh.Signer
+		wantErr bool
+	}{
+		{name: "identity file only", file: file, want: []ssh.Signer{file}},
+		{name: "agent only", agent: agentOK, want: []ssh.Signer{agentKey}},
+		{name: "identity file before agent keys", file: file, agent: agentOK, want: []ssh.Signer{file, agentKey}},
+		{name: "agent error keeps identity file", file: file, agent: agentErr, want: []ssh.Signer{file}},
+		{name: "agent error without identity file", agent: agentErr, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := publicKeySigners(tt.file, tt.agent)()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("publicKeySigners err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("signers = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
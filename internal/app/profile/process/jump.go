@@ -0,0 +1,192 @@
+// File : internal/app/profile/process/jump.go
+// Deskripsi : Rantai ProxyJump (multi-hop) untuk SSH tunnel
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package process
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHHop adalah satu host SSH dalam rantai koneksi (jump host atau bastion terakhir).
+type SSHHop struct {
+	User string
+	Host string
+	Port int
+}
+
+// Addr mengembalikan alamat host:port hop.
+func (h SSHHop) Addr() string {
+	return net.JoinHostPort(h.Host, strconv.Itoa(h.Port))
+}
+
+// String mengembalikan bentuk user@host:port.
+func (h SSHHop) String() string {
+	if h.User == "" {
+		return h.Addr()
+	}
+	return h.User + "@" + h.Addr()
+}
+
+// ParseJumpHost mem-parse spesifikasi jump host format [user@]host[:port] (seperti ssh -J).
+// User kosong memakai defaultUser, port kosong memakai 22.
+func ParseJumpHost(spec string, defaultUser string) (SSHHop, error) {
+	s := strings.TrimSpace(spec)
+	if s == "" {
+		return SSHHop{}, fmt.Errorf("jump host kosong")
+	}
+	hop := SSHHop{User: strings.TrimSpace(defaultUser), Port: 22}
+	if i := strings.LastIndex(s, "@"); i >= 0 {
+		hop.User = strings.TrimSpace(s[:i])
+		s = s[i+1:]
+		if hop.User == "" {
+			return SSHHop{}, fmt.Errorf("jump host %q: user kosong sebelum '@'", spec)
+		}
+	}
+
+	host := s
+	if h, p, err := net.SplitHostPort(s); err == nil {
+		port, perr := strconv.Atoi(p)
+		if perr != nil || port <= 0 || port > 65535 {
+			return SSHHop{}, fmt.Errorf("jump host %q: port tidak valid", spec)
+		}
+		host, hop.Port = h, port
+	} else if strings.Contains(s, ":") && net.ParseIP(strings.Trim(s, "[]")) == nil {
+		// Tanpa port, host berisi ':' hanya valid sebagai alamat IPv6.
+		return SSHHop{}, fmt.Errorf("jump host %q: format tidak valid (gunakan [user@]host[:port])", spec)
+	}
+	host = strings.Trim(strings.TrimSpace(host), "[]")
+	if host == "" {
+		return SSHHop{}, fmt.Errorf("jump host %q: host kosong", spec)
+	}
+	hop.Host = host
+	return hop, nil
+}
+
+// SplitJumpHosts memecah daftar jump host (dipisah koma/spasi) menjadi slice tanpa entry kosong.
+func SplitJumpHosts(s string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// ValidateJumpHosts memastikan setiap spesifikasi jump host dapat di-parse.
+func ValidateJumpHosts(specs []string) error {
+	for _, spec := range specs {
+		if _, err := ParseJumpHost(spec, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildHops menyusun rantai hop: jump host berurutan lalu bastion (SSHHost) sebagai hop terakhir.
+func BuildHops(opts SSHTunnelOptions) ([]SSHHop, error) {
+	user := resolveSSHUser(opts.SSHUser)
+	hops := make([]SSHHop, 0, len(opts.JumpHosts)+1)
+	for _, spec := range opts.JumpHosts {
+		if strings.TrimSpace(spec) == "" {
+			continue
+		}
+		hop, err := ParseJumpHost(spec, user)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, hop)
+	}
+	port := opts.SSHPort
+	if port == 0 {
+		port = 22
+	}
+	hops = append(hops, SSHHop{User: user, Host: strings.TrimSpace(opts.SSHHost), Port: port})
+	return hops, nil
+}
+
+// hopConfigFunc membuat ssh.ClientConfig untuk hop tertentu.
+type hopConfigFunc func(hop SSHHop) (*ssh.ClientConfig, error)
+
+// dialHop membuka koneksi SSH ke hop. prev nil berarti dial TCP langsung,
+// selain itu koneksi dibuat lewat hop sebelumnya (ProxyJump).
+func dialHop(prev *ssh.Client, hop SSHHop, cfg *ssh.ClientConfig, timeout time.Duration) (*ssh.Client, error) {
+	addr := hop.Addr()
+	var conn net.Conn
+	var err error
+	if prev == nil {
+		conn, err = net.DialTimeout("tcp", addr, timeout)
+	} else {
+		conn, err = prev.Dial("tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("gagal konek TCP ke ssh %s: %w", addr, err)
+	}
+	cc, chans, reqs, err := handshakeWithTimeout(conn, addr, cfg, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return ssh.NewClient(cc, chans, reqs), nil
+}
+
+// handshakeWithTimeout menjalankan handshake SSH dengan batas waktu. Koneksi hasil prev.Dial (channel
+// lewat jump host) tidak mendukung SetDeadline, jadi timeout ditegakkan dengan timer yang menutup conn.
+// timeout 0 berarti tanpa batas, sama seperti net.DialTimeout.
+func handshakeWithTimeout(conn net.Conn, addr string, cfg *ssh.ClientConfig, timeout time.Duration) (ssh.Conn, <-chan ssh.NewChannel, <-chan *ssh.Request, error) {
+	var timer *time.Timer
+	if timeout > 0 {
+		timer = time.AfterFunc(timeout, func() { _ = conn.Close() })
+	}
+	cc, chans, reqs, err := ssh.NewClientConn(conn, addr, cfg)
+	if timer != nil && !timer.Stop() {
+		// Timer sudah menutup conn; handshake yang sempat selesai pun tidak bisa dipakai.
+		if err == nil {
+			_ = cc.Close()
+		}
+		return nil, nil, nil, fmt.Errorf("ssh handshake ke %s melebihi timeout %s", addr, timeout)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, nil, fmt.Errorf("ssh handshake gagal ke %s: %w", addr, err)
+	}
+	return cc, chans, reqs, nil
+}
+
+// dialChain membuka koneksi ke semua hop secara berurutan.
+// Jika gagal, client yang sudah terbuka ditutup dan error menyebut hop yang gagal.
+func dialChain(hops []SSHHop, cfgFor hopConfigFunc, timeout time.Duration) ([]*ssh.Client, error) {
+	clients := make([]*ssh.Client, 0, len(hops))
+	var prev *ssh.Client
+	for i, hop := range hops {
+		cfg, err := cfgFor(hop)
+		if err != nil {
+			closeClients(clients)
+			return nil, err
+		}
+		client, err := dialHop(prev, hop, cfg, timeout)
+		if err != nil {
+			closeClients(clients)
+			if len(hops) > 1 {
+				return nil, fmt.Errorf("hop %d/%d (%s): %w", i+1, len(hops), hop.String(), err)
+			}
+			return nil, err
+		}
+		clients = append(clients, client)
+		prev = client
+	}
+	return clients, nil
+}
+
+// closeClients menutup client dari hop terakhir ke hop pertama.
+func closeClients(clients []*ssh.Client) {
+	for i := len(clients) - 1; i >= 0; i-- {
+		_ = clients[i].Close()
+	}
+}
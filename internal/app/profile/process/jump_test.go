@@ -0,0 +1,134 @@
+package process
+
+import (
+	"errors"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParseJumpHost(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        string
+		defaultUser string
+		want        SSHHop
+		wantErr     bool
+	}{
+		{name: "host only", spec: "jump1", defaultUser: "ops", want: SSHHop{User: "ops", Host: "jump1", Port: 22}},
+		{name: "user and port", spec: "admin@jump1:2222", defaultUser: "ops", want: SSHHop{User: "admin", Host: "jump1", Port: 2222}},
+		{name: "trimmed", spec: "  jump1:2200 ", want: SSHHop{Host: "jump1", Port: 2200}},
+		{name: "ipv4 with port", spec: "10.0.0.1:22", want: SSHHop{Host: "10.0.0.1", Port: 22}},
+		{name: "bracketed ipv6 with port", spec: "ops@[2001:db8::1]:2222", want: SSHHop{User: "ops", Host: "2001:db8::1", Port: 2222}},
+		{name: "bare ipv6", spec: "2001:db8::1", want: SSHHop{Host: "2001:db8::1", Port: 22}},
+		{name: "user containing at", spec: "a@b@jump1", want: SSHHop{User: "a@b", Host: "jump1", Port: 22}},
+		{name: "empty", spec: " ", wantErr: true},
+		{name: "empty user", spec: "@jump1", wantErr: true},
+		{name: "empty host", spec: "ops@:22", wantErr: true},
+		{name: "port zero", spec: "jump1:0", wantErr: true},
+		{name: "port out of range", spec: "jump1:70000", wantErr: true},
+		{name: "port not numeric", spec: "jump1:ssh", wantErr: true},
+		{name: "too many colons", spec: "jump1:22:33", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseJumpHost(tt.spec, tt.defaultUser)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseJumpHost(%q) err = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseJumpHost(%q) = %+v, want %+v", tt.spec, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitJumpHosts(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{in: "", want: nil},
+		{in: " , ", want: nil},
+		{in: "jump1,jump2", want: []string{"jump1", "jump2"}},
+		{in: "ops@jump1:2222, jump2\tjump3", want: []string{"ops@jump1:2222", "jump2", "jump3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := SplitJumpHosts(tt.in); !slices.Equal(got, tt.want) {
+				t.Fatalf("SplitJumpHosts(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildHops(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    SSHTunnelOptions
+		want    []SSHHop
+		wantErr bool
+	}{
+		{
+			name: "bastion only with default port",
+			opts: SSHTunnelOptions{SSHHost: "bastion", SSHUser: "ops"},
+			want: []SSHHop{{User: "ops", Host: "bastion", Port: 22}},
+		},
+		{
+			name: "jump hosts before bastion inherit user",
+			opts: SSHTunnelOptions{SSHHost: "bastion", SSHPort: 2222, SSHUser: "ops", JumpHosts: []string{"jump1", "", "admin@jump2:2200"}},
+			want: []SSHHop{
+				{User: "ops", Host: "jump1", Port: 22},
+				{User: "admin", Host: "jump2", Port: 2200},
+				{User: "ops", Host: "bastion", Port: 2222},
+			},
+		},
+		{
+			name:    "invalid jump host",
+			opts:    SSHTunnelOptions{SSHHost: "bastion", SSHUser: "ops", JumpHosts: []string{"jump1:bad"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildHops(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildHops err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("BuildHops = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// noDeadlineConn meniru channel hasil ssh.Client.Dial yang menolak SetDeadline.
+type noDeadlineConn struct{ net.Conn }
+
+func (noDeadlineConn) SetDeadline(time.Time) error {
+	return errors.New("ssh: tcpChan: deadline not supported")
+}
+
+func TestHandshakeWithTimeout(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+	// Server diam: handshake hanya bisa berhenti karena timeout.
+	go func() { _, _ = server.Read(make([]byte, 1)) }()
+
+	cfg := &ssh.ClientConfig{User: "u", HostKeyCallback: ssh.InsecureIgnoreHostKey()}
+	start := time.Now()
+	_, _, _, err := handshakeWithTimeout(noDeadlineConn{client}, "jump:22", cfg, 100*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Fatalf("handshakeWithTimeout() error = %v, want timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("handshakeWithTimeout() berhenti setelah %s", elapsed)
+	}
+}
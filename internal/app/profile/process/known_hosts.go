@@ -2,7 +2,7 @@
 // Deskripsi : Known hosts handling untuk SSH tunnel
 // Author : Hadiyatna Muflihun
 // Tanggal : 14 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package process
 
@@ -41,6 +41,26 @@ func ensureFile(path string) error {
 	return f.Close()
 }
 
+// HostKeyMismatchError menandakan host key server berbeda dengan yang sudah di-pin.
+type HostKeyMismatchError struct {
+	Host           string
+	Port           int
+	Fingerprint    string   // Fingerprint SHA256 yang dipresentasikan server
+	Known          []string // Fingerprint SHA256 yang tercatat di known_hosts
+	KnownHostsPath string
+	Err            error
+}
+
+func (e *HostKeyMismatchError) Error() string {
+	return fmt.Sprintf(
+		"known_hosts key mismatch untuk %s:%d (server: %s, tercatat: %s di %s). "+
+			"Pastikan host benar lalu jalankan 'sfdbtools profile ssh trust' untuk pin ulang host key",
+		e.Host, e.Port, e.Fingerprint, strings.Join(e.Known, ", "), e.KnownHostsPath,
+	)
+}
+
+func (e *HostKeyMismatchError) Unwrap() error { return e.Err }
+
 func selectKnownHostsPath() (string, error) {
 	// 1) Prefer /etc/sfDBTools/known_hosts
 	if err := ensureFile(defaultSfDBToolsKnownHostsPath); err == nil {
@@ -110,21 +130,36 @@ func buildHostKeyCallbackFor(host string, port int) (ssh.HostKeyCallback, string
 		return nil, knownHostsPath, fmt.Errorf("gagal membaca known_hosts (%s): %w", knownHostsPath, kerr)
 	}
 
-	// Auto-trust seperti client GUI: jika host key belum ada / mismatch,
-	// tambahkan key yang sedang dipresentasikan ke file known_hosts sfdbtools.
+	// Trust-on-first-use: host yang belum dikenal otomatis di-pin ke file known_hosts sfdbtools.
+	// Host key yang berubah (mismatch) TIDAK pernah diterima otomatis; gunakan `profile ssh trust`.
 	wrapped := func(hostname string, remote net.Addr, key ssh.PublicKey) error {
-		if err := cb(hostname, remote, key); err == nil {
+		err := cb(hostname, remote, key)
+		if err == nil {
 			return nil
-		} else {
-			var keyErr *knownhosts.KeyError
-			if errors.As(err, &keyErr) {
-				// Jangan sentuh ~/.ssh/known_hosts; tulis ke file khusus sfdbtools.
-				if werr := appendKnownHost(knownHostsPath, host, port, key); werr == nil {
-					return nil
-				}
+		}
+		var keyErr *knownhosts.KeyError
+		if !errors.As(err, &keyErr) {
+			return err
+		}
+		if len(keyErr.Want) == 0 {
+			// Jangan sentuh ~/.ssh/known_hosts; tulis ke file khusus sfdbtools.
+			if werr := appendKnownHost(knownHostsPath, host, port, key); werr == nil {
+				return nil
 			}
 			return err
 		}
+		known := make([]string, 0, len(keyErr.Want))
+		for _, w := range keyErr.Want {
+			known = append(known, ssh.FingerprintSHA256(w.Key))
+		}
+		return &HostKeyMismatchError{
+			Host:           host,
+			Port:           port,
+			Fingerprint:    ssh.FingerprintSHA256(key),
+			Known:          known,
+			KnownHostsPath: knownHostsPath,
+			Err:            err,
+		}
 	}
 
 	return wrapped, knownHostsPath, nil
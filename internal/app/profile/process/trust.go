@@ -0,0 +1,180 @@
+// File : internal/app/profile/process/trust.go
+// Deskripsi : Pemindaian dan pin host key SSH (profile ssh trust)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package process
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// Status host key hasil pemindaian.
+const (
+	HostKeyTrusted  = "trusted"  // sudah cocok dengan known_hosts
+	HostKeyUnknown  = "unknown"  // belum tercatat
+	HostKeyMismatch = "mismatch" // tercatat dengan key berbeda
+)
+
+// HostKeyScan adalah host key yang dipresentasikan satu hop beserta statusnya di known_hosts.
+type HostKeyScan struct {
+	Hop         SSHHop
+	Key         ssh.PublicKey
+	KeyType     string
+	Fingerprint string
+	Status      string
+	Known       []string // Fingerprint yang tercatat (untuk status mismatch)
+}
+
+// ErrHopNotTrusted menandakan pemindaian berhenti di hop perantara yang host key-nya belum dipercaya.
+// Hop tersebut harus di-pin dulu sebelum hop berikutnya dipindai.
+var ErrHopNotTrusted = errors.New("host key hop perantara belum dipercaya")
+
+// errHostKeyCaptured menghentikan handshake setelah host key didapat, sebelum kredensial dikirim.
+var errHostKeyCaptured = errors.New("host key captured")
+
+// ScanHostKeys menghubungi setiap hop (jump host lalu bastion) dan mengumpulkan host key-nya
+// tanpa menulis known_hosts. Kredensial hanya dikirim ke hop perantara yang host key-nya sudah
+// dipercaya (agar hop berikutnya dapat dijangkau). Pemindaian berhenti di hop perantara pertama
+// yang belum dipercaya dengan ErrHopNotTrusted; hop terakhir tidak pernah diautentikasi.
+func ScanHostKeys(opts SSHTunnelOptions) ([]HostKeyScan, string, error) {
+	if strings.TrimSpace(opts.SSHHost) == "" {
+		return nil, "", fmt.Errorf("ssh host kosong")
+	}
+	timeout := opts.ConnectTimeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+
+	knownHostsPath, err := selectKnownHostsPath()
+	if err != nil {
+		return nil, "", fmt.Errorf("tidak bisa menentukan known_hosts path: %w", err)
+	}
+	cb, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, knownHostsPath, fmt.Errorf("gagal membaca known_hosts (%s): %w", knownHostsPath, err)
+	}
+
+	hops, err := BuildHops(opts)
+	if err != nil {
+		return nil, knownHostsPath, err
+	}
+	authMethods, agentConn, err := buildAuthMethods(opts)
+	if err != nil {
+		return nil, knownHostsPath, err
+	}
+	if agentConn != nil {
+		defer agentConn.Close()
+	}
+
+	scans := make([]HostKeyScan, 0, len(hops))
+	var clients []*ssh.Client
+	defer func() { closeClients(clients) }()
+
+	var prev *ssh.Client
+	for i, hop := range hops {
+		var scan *HostKeyScan
+		last := i == len(hops)-1
+		cfg := &ssh.ClientConfig{
+			User:    hop.User,
+			Auth:    authMethods,
+			Timeout: timeout,
+			HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+				scan = &HostKeyScan{Hop: hop, Key: key, KeyType: key.Type(), Fingerprint: ssh.FingerprintSHA256(key), Status: HostKeyTrusted}
+				var keyErr *knownhosts.KeyError
+				if err := cb(hostname, remote, key); errors.As(err, &keyErr) {
+					scan.Status = HostKeyUnknown
+					for _, w := range keyErr.Want {
+						scan.Status = HostKeyMismatch
+						scan.Known = append(scan.Known, ssh.FingerprintSHA256(w.Key))
+					}
+				} else if err != nil {
+					return err
+				}
+				// Handshake dihentikan sebelum autentikasi kecuali hop perantara yang sudah dipercaya.
+				if last || scan.Status != HostKeyTrusted {
+					return errHostKeyCaptured
+				}
+				return nil
+			},
+		}
+
+		client, err := dialHop(prev, hop, cfg, timeout)
+		if scan != nil {
+			scans = append(scans, *scan)
+			if last {
+				break
+			}
+			if scan.Status != HostKeyTrusted {
+				return scans, knownHostsPath, fmt.Errorf("hop %d/%d (%s): %w", i+1, len(hops), hop.String(), ErrHopNotTrusted)
+			}
+		}
+		if err != nil {
+			return scans, knownHostsPath, fmt.Errorf("hop %d/%d (%s): %w", i+1, len(hops), hop.String(), err)
+		}
+		clients = append(clients, client)
+		prev = client
+	}
+	return scans, knownHostsPath, nil
+}
+
+// PinHostKey mengganti entry host di known_hosts sfdbtools dengan key yang diberikan.
+// Baris lain (termasuk host lain dan baris hashed) dibiarkan apa adanya.
+func PinHostKey(path string, hop SSHHop, key ssh.PublicKey) error {
+	patterns := hostPatterns(hop.Host, hop.Port)
+	if len(patterns) == 0 {
+		return fmt.Errorf("host kosong")
+	}
+	if err := ensureFile(path); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var kept []string
+	sc := bufio.NewScanner(strings.NewReader(string(data)))
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		if lineMatchesHost(line, patterns[0]) {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	kept = append(kept, knownhosts.Line(patterns, key))
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strings.Join(kept, "\n")+"\n"), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// lineMatchesHost true jika baris known_hosts (non-hashed, non-marker) memuat pattern host.
+func lineMatchesHost(line string, pattern string) bool {
+	fields := strings.Fields(line)
+	if len(fields) < 3 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], "@") {
+		return false
+	}
+	for _, h := range strings.Split(fields[0], ",") {
+		if h == pattern {
+			return true
+		}
+	}
+	return false
+}
@@ -2,14 +2,14 @@
 // Deskripsi : SSH tunnel native Go (port forwarding)
 // Author : Hadiyatna Muflihun
 // Tanggal : 2 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package process
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"strconv"
@@ -18,7 +18,6 @@ import (
 	"time"
 
 	"golang.org/x/crypto/ssh"
-	"golang.org/x/crypto/ssh/knownhosts"
 )
 
 type SSHTunnelOptions struct {
@@ -27,6 +26,9 @@ type SSHTunnelOptions struct {
 	SSHUser        string
 	Password       string
 	IdentityFile   string
+	Passphrase     string   // Passphrase identity file terenkripsi (opsional)
+	JumpHosts      []string // Rantai ProxyJump berurutan, format [user@]host[:port]
+	UseAgent       bool     // Wajibkan autentikasi via ssh-agent
 	LocalPort      int
 	RemoteHost     string
 	RemotePort     int
@@ -40,6 +42,8 @@ type SSHTunnel struct {
 	LocalPort int
 	listener  net.Listener
 	sshClient *ssh.Client
+	hops      []*ssh.Client // Client jump host (tanpa hop terakhir), ditutup setelah sshClient
+	agentConn io.Closer
 	cancel    context.CancelFunc
 	once      sync.Once
 }
@@ -65,7 +69,19 @@ func (o SSHTunnelOptions) validate() error {
 	return nil
 }
 
+func resolveSSHUser(user string) string {
+	user = strings.TrimSpace(user)
+	if user == "" {
+		user = os.Getenv("USER")
+	}
+	if strings.TrimSpace(user) == "" {
+		user = "root"
+	}
+	return user
+}
+
 // StartSSHTunnel memulai SSH tunnel (local port forwarding) menggunakan native Go.
+// Jika JumpHosts diisi, koneksi ke bastion dibuat melewati setiap jump host secara berurutan.
 func StartSSHTunnel(ctx context.Context, opts SSHTunnelOptions) (*SSHTunnel, error) {
 	if err := opts.validate(); err != nil {
 		return nil, err
@@ -76,80 +92,60 @@ func StartSSHTunnel(ctx context.Context, opts SSHTunnelOptions) (*SSHTunnel, err
 		return nil, fmt.Errorf("gagal menentukan local port: %w", err)
 	}
 
-	sshPort := opts.SSHPort
-	if sshPort == 0 {
-		sshPort = 22
-	}
-
 	connectTimeout := opts.ConnectTimeout
 	if connectTimeout == 0 {
 		connectTimeout = 10 * time.Second
 	}
 
-	cb, usedKnownHosts, err := buildHostKeyCallbackFor(opts.SSHHost, sshPort)
+	hops, err := BuildHops(opts)
 	if err != nil {
-		return nil, fmt.Errorf("gagal membuat host key callback: %w", err)
+		return nil, err
 	}
 
-	authMethods, err := buildAuthMethods(opts)
+	authMethods, agentConn, err := buildAuthMethods(opts)
 	if err != nil {
 		return nil, err
 	}
-
-	user := strings.TrimSpace(opts.SSHUser)
-	if user == "" {
-		user = os.Getenv("USER")
-	}
-	if strings.TrimSpace(user) == "" {
-		user = "root"
-	}
-
-	sshCfg := &ssh.ClientConfig{
-		User:            user,
-		Auth:            authMethods,
-		HostKeyCallback: cb,
-		Timeout:         connectTimeout,
-	}
-
-	sshAddr := net.JoinHostPort(opts.SSHHost, strconv.Itoa(sshPort))
-
-	ctx2, cancel := context.WithCancel(ctx)
-	// Dial TCP dulu agar honor connectTimeout.
-	tcpConn, err := net.DialTimeout("tcp", sshAddr, connectTimeout)
-	if err != nil {
-		cancel()
-		return nil, fmt.Errorf("gagal konek TCP ke ssh %s: %w", sshAddr, err)
+	closeAgent := func() {
+		if agentConn != nil {
+			_ = agentConn.Close()
+		}
 	}
 
-	cc, chans, reqs, err := ssh.NewClientConn(tcpConn, sshAddr, sshCfg)
-	if err != nil {
-		_ = tcpConn.Close()
-		cancel()
-		var keyErr *knownhosts.KeyError
-		if errors.As(err, &keyErr) {
-			kh := usedKnownHosts
-			if strings.TrimSpace(kh) == "" {
-				kh = defaultSfDBToolsKnownHostsPath
-			}
-			return nil, fmt.Errorf(
-				"ssh handshake gagal ke %s: known_hosts key mismatch. sfdbtools akan memakai file: %s. Pastikan host yang dituju benar lalu update host key di file tsb: %w",
-				sshAddr,
-				kh,
-				err,
-			)
+	clients, err := dialChain(hops, func(hop SSHHop) (*ssh.ClientConfig, error) {
+		cb, _, err := buildHostKeyCallbackFor(hop.Host, hop.Port)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membuat host key callback: %w", err)
 		}
-		return nil, fmt.Errorf("ssh handshake gagal ke %s: %w", sshAddr, err)
+		return &ssh.ClientConfig{
+			User:            hop.User,
+			Auth:            authMethods,
+			HostKeyCallback: cb,
+			Timeout:         connectTimeout,
+		}, nil
+	}, connectTimeout)
+	if err != nil {
+		closeAgent()
+		return nil, err
 	}
-	client := ssh.NewClient(cc, chans, reqs)
+	client := clients[len(clients)-1]
 
 	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", localPort))
 	if err != nil {
-		_ = client.Close()
-		cancel()
+		closeClients(clients)
+		closeAgent()
 		return nil, fmt.Errorf("gagal listen local port 127.0.0.1:%d: %w", localPort, err)
 	}
 
-	t := &SSHTunnel{LocalPort: localPort, listener: ln, sshClient: client, cancel: cancel}
+	ctx2, cancel := context.WithCancel(ctx)
+	t := &SSHTunnel{
+		LocalPort: localPort,
+		listener:  ln,
+		sshClient: client,
+		hops:      clients[:len(clients)-1],
+		agentConn: agentConn,
+		cancel:    cancel,
+	}
 
 	remoteAddr := net.JoinHostPort(opts.RemoteHost, strconv.Itoa(opts.RemotePort))
 	go forward(ctx2, ln, client, remoteAddr)
@@ -172,6 +168,10 @@ func (t *SSHTunnel) Stop(ctx context.Context) error {
 		if t.sshClient != nil {
 			_ = t.sshClient.Close()
 		}
+		closeClients(t.hops)
+		if t.agentConn != nil {
+			_ = t.agentConn.Close()
+		}
 		select {
 		case <-time.After(50 * time.Millisecond):
 		case <-ctx.Done():
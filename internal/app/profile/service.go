@@ -2,7 +2,7 @@
 // Deskripsi : Service utama implementation untuk profile operations
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 25 Januari 2026
+// Last Modified : 18 Oktober 2026
 package profile
 
 import (
@@ -74,6 +74,36 @@ func NewProfileService(cfg *appconfig.Config, logs applog.Logger, profile interf
 			// Import akan mengisi ProfileInfo per-row saat eksekusi.
 			svc.State.ProfileInfo = &domain.ProfileInfo{}
 			svc.DBInfo = &svc.State.ProfileInfo.DBInfo
+		case *profilemodel.ProfileRekeyOptions:
+			svc.State.Options = v
+			svc.State.ProfileInfo = &domain.ProfileInfo{}
+			svc.DBInfo = &svc.State.ProfileInfo.DBInfo
+		case *profilemodel.ProfileExportOptions:
+			svc.State.Options = v
+			svc.State.ProfileInfo = &domain.ProfileInfo{}
+			svc.DBInfo = &svc.State.ProfileInfo.DBInfo
+		case *profilemodel.ProfileTestOptions:
+			svc.State.Options = v
+			setProfileRefs(&v.ProfileInfo)
+		case *profilemodel.ProfileSSHTrustOptions:
+			svc.State.Options = v
+			setProfileRefs(&v.ProfileInfo)
+		case *profilemodel.ProfileHistoryOptions:
+			svc.State.Options = v
+			svc.State.ProfileInfo = &domain.ProfileInfo{}
+			svc.DBInfo = &svc.State.ProfileInfo.DBInfo
+		case *profilemodel.ProfileDiffOptions:
+			svc.State.Options = v
+			svc.State.ProfileInfo = &domain.ProfileInfo{}
+			svc.DBInfo = &svc.State.ProfileInfo.DBInfo
+		case *profilemodel.ProfileRollbackOptions:
+			svc.State.Options = v
+			svc.State.ProfileInfo = &domain.ProfileInfo{}
+			svc.DBInfo = &svc.State.ProfileInfo.DBInfo
+		case *profilemodel.ProfileSyncOptions:
+			svc.State.Options = v
+			svc.State.ProfileInfo = &domain.ProfileInfo{}
+			svc.DBInfo = &svc.State.ProfileInfo.DBInfo
 		default:
 			logs.Warn(consts.ProfileLogUnknownProfileTypeInService)
 			svc.State.Options = nil
@@ -109,6 +139,22 @@ func (s *Service) ExecuteProfileCommand(config profilemodel.ProfileEntryConfig)
 		return s.CloneProfile()
 	case consts.ProfileModeImport:
 		return s.ImportProfiles()
+	case consts.ProfileModeRekey:
+		return s.RekeyProfiles()
+	case consts.ProfileModeExport:
+		return s.ExportProfiles()
+	case consts.ProfileModeTest:
+		return s.TestProfile()
+	case consts.ProfileModeSSHTrust:
+		return s.TrustSSHHostKeys()
+	case consts.ProfileModeHistory:
+		return s.ShowProfileHistory()
+	case consts.ProfileModeDiff:
+		return s.DiffProfileVersions()
+	case consts.ProfileModeRollback:
+		return s.RollbackProfile()
+	case consts.ProfileModeSync:
+		return s.SyncProfiles()
 	default:
 		return profileerrors.ErrInvalidProfileMode
 	}
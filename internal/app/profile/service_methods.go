@@ -2,7 +2,7 @@
 // Deskripsi : Service methods for wizard/executor integration (P2 refactored)
 // Author : Hadiyatna Muflihun
 // Tanggal : 14 Januari 2026
-// Last Modified : 25 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package profile
 
@@ -51,3 +51,59 @@ func (s *Service) ImportProfiles() error {
 	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
 	return e.ImportProfiles()
 }
+
+// RekeyProfiles merotasi kunci enkripsi profile secara atomic.
+func (s *Service) RekeyProfiles() error {
+	ops := newExecutorOps(s.Config, s.Log, s.State)
+	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
+	return e.RekeyProfiles()
+}
+
+// ExportProfiles meng-export profile ke XLSX/CSV dengan schema profile import.
+func (s *Service) ExportProfiles() error {
+	ops := newExecutorOps(s.Config, s.Log, s.State)
+	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
+	return e.ExportProfiles()
+}
+
+// TestProfile menjalankan test koneksi untuk satu profile.
+func (s *Service) TestProfile() error {
+	ops := newExecutorOps(s.Config, s.Log, s.State)
+	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
+	return e.TestProfile()
+}
+
+// TrustSSHHostKeys memindai dan mem-pin host key SSH (jump host dan bastion) sebuah profile.
+func (s *Service) TrustSSHHostKeys() error {
+	ops := newExecutorOps(s.Config, s.Log, s.State)
+	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
+	return e.TrustSSHHostKeys()
+}
+
+// ShowProfileHistory menampilkan daftar versi lama sebuah profile.
+func (s *Service) ShowProfileHistory() error {
+	ops := newExecutorOps(s.Config, s.Log, s.State)
+	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
+	return e.ShowProfileHistory()
+}
+
+// DiffProfileVersions membandingkan dua versi profile (password disamarkan).
+func (s *Service) DiffProfileVersions() error {
+	ops := newExecutorOps(s.Config, s.Log, s.State)
+	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
+	return e.DiffProfileVersions()
+}
+
+// RollbackProfile mengembalikan profile ke versi lama dari history.
+func (s *Service) RollbackProfile() error {
+	ops := newExecutorOps(s.Config, s.Log, s.State)
+	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
+	return e.RollbackProfile()
+}
+
+// SyncProfiles menyamakan direktori profile dengan manifest.
+func (s *Service) SyncProfiles() error {
+	ops := newExecutorOps(s.Config, s.Log, s.State)
+	e := executor.New(s.Log, s.Config, ops.configDir(), s.State, ops)
+	return e.SyncProfiles()
+}
@@ -2,14 +2,16 @@
 // Deskripsi : Setup, configuration helpers, and path management
 // Author : Hadiyatna Muflihun
 // Tanggal : 16 Desember 2025
-// Last Modified : 14 Januari 2026
+// Last Modified : 18 Oktober 2026
 package profile
 
 import (
 	"fmt"
 	"path/filepath"
 	"sfdbtools/internal/app/profile/helpers/snapshot"
+	"sfdbtools/internal/app/profile/helpers/tags"
 	"sfdbtools/internal/app/profile/merger"
+	"sfdbtools/internal/domain"
 	"strings"
 )
 
@@ -50,10 +52,16 @@ password=%s
 `
 
 	base := fmt.Sprintf(content, s.State.ProfileInfo.DBInfo.Host, s.State.ProfileInfo.DBInfo.Port, s.State.ProfileInfo.DBInfo.User, s.State.ProfileInfo.DBInfo.Password)
+	if socket := strings.TrimSpace(s.State.ProfileInfo.DBInfo.Socket); socket != "" {
+		base += "socket=" + socket + "\n"
+	}
+	base += formatTLSINI(s.State.ProfileInfo.DBInfo.TLS)
+
+	meta := formatMetaINI(s.State.ProfileInfo.Tags, s.State.ProfileInfo.Groups)
 
 	ssh := s.State.ProfileInfo.SSHTunnel
 	if !ssh.Enabled && strings.TrimSpace(ssh.Host) == "" {
-		return base
+		return base + meta
 	}
 
 	if ssh.Port == 0 {
@@ -78,5 +86,38 @@ local_port=%d
 		ssh.Password,
 		ssh.IdentityFile,
 		ssh.LocalPort,
-	)
+	) + formatSSHExtraINI(ssh) + meta
+}
+
+// formatSSHExtraINI menyusun baris opsional section [ssh] (jump host, agent).
+// Hanya ditulis jika diisi agar profile lama tetap sama.
+func formatSSHExtraINI(ssh domain.SSHTunnelConfig) string {
+	var b strings.Builder
+	if len(ssh.JumpHosts) > 0 {
+		fmt.Fprintf(&b, "jump_hosts=%s\n", strings.Join(ssh.JumpHosts, ","))
+	}
+	if ssh.UseAgent {
+		b.WriteString("use_agent=true\n")
+	}
+	return b.String()
+}
+
+// formatTLSINI menyusun baris TLS di section [client]. Hanya field yang diisi yang ditulis
+// agar profile tanpa TLS tetap sama seperti sebelumnya.
+func formatTLSINI(t domain.TLSConfig) string {
+	var b strings.Builder
+	for _, kv := range [][2]string{{"ssl_mode", t.Mode}, {"ssl_ca", t.CA}, {"ssl_cert", t.Cert}, {"ssl_key", t.Key}} {
+		if v := strings.TrimSpace(kv[1]); v != "" {
+			fmt.Fprintf(&b, "%s=%s\n", kv[0], v)
+		}
+	}
+	return b.String()
+}
+
+// formatMetaINI menyusun section [meta] (tag dan grup). Kosong jika profile tidak punya tag/grup.
+func formatMetaINI(tagMap map[string]string, groups []string) string {
+	if len(tagMap) == 0 && len(groups) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n[meta]\ntags=%s\ngroups=%s\n", tags.FormatTags(tagMap), strings.Join(groups, ","))
 }
@@ -2,7 +2,7 @@
 // Deskripsi : Validasi SSH tunnel (opsional)
 // Author : Hadiyatna Muflihun
 // Tanggal : 14 Januari 2026
-// Last Modified : 14 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package validation
 
@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	profileerrors "sfdbtools/internal/app/profile/errors"
+	"sfdbtools/internal/app/profile/process"
 	"sfdbtools/internal/domain"
 )
 
@@ -30,6 +31,9 @@ func ValidateSSHTunnel(ssh *domain.SSHTunnelConfig) error {
 	if ssh.Port <= 0 || ssh.Port > 65535 {
 		return profileerrors.SSHPortInvalidError(ssh.Port)
 	}
+	if err := process.ValidateJumpHosts(ssh.JumpHosts); err != nil {
+		return err
+	}
 	// Validate identity file if provided
 	if ssh.IdentityFile != "" {
 		if err := ValidateSSHIdentityFile(ssh.IdentityFile); err != nil {
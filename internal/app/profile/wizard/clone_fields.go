@@ -2,7 +2,7 @@
 // Deskripsi : Prompt edit field untuk clone (multi-select; tanpa ubah nama)
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package wizard
 
@@ -27,12 +27,16 @@ func (r *Runner) promptCloneSelectedFields() error {
 		consts.ProfileLabelDBPort,
 		consts.ProfileLabelDBUser,
 		consts.ProfileLabelDBPassword,
+		consts.ProfileLabelDBSocket,
+		consts.ProfileFieldTLS,
 		consts.ProfileFieldSSHTunnelToggle,
 		consts.ProfileLabelSSHHost,
 		consts.ProfileLabelSSHPort,
 		consts.ProfileLabelSSHUser,
 		consts.ProfileLabelSSHPassword,
 		consts.ProfileLabelSSHIdentityFile,
+		consts.ProfileLabelSSHJumpHosts,
+		consts.ProfileLabelSSHAgent,
 		consts.ProfileLabelSSHLocalPort,
 	}
 
@@ -104,6 +108,18 @@ func (r *Runner) promptCloneSelectedFields() error {
 		}
 	}
 
+	if selected[consts.ProfileLabelDBSocket] {
+		if err := r.promptDBSocket(); err != nil {
+			return err
+		}
+	}
+
+	if selected[consts.ProfileFieldTLS] {
+		if err := r.promptTLSSettings(); err != nil {
+			return err
+		}
+	}
+
 	if selected[consts.ProfileFieldSSHTunnelToggle] {
 		enable, err := prompt.Confirm(consts.ProfilePromptUseSSHTunnel, r.State.ProfileInfo.SSHTunnel.Enabled)
 		if err != nil {
@@ -196,6 +212,18 @@ func (r *Runner) promptCloneSelectedFields() error {
 		r.State.ProfileInfo.SSHTunnel.IdentityFile = strings.TrimSpace(v)
 	}
 
+	if selected[consts.ProfileLabelSSHJumpHosts] {
+		if err := r.promptSSHJumpHosts(); err != nil {
+			return err
+		}
+	}
+
+	if selected[consts.ProfileLabelSSHAgent] {
+		if err := r.promptSSHAgent(); err != nil {
+			return err
+		}
+	}
+
 	if selected[consts.ProfileLabelSSHLocalPort] {
 		validator := validatePortRange(1, 65535, true, consts.ProfileLabelSSHLocalPort)
 		v, err := prompt.AskInt(consts.ProfilePromptSSHLocalPort, r.State.ProfileInfo.SSHTunnel.LocalPort, validator)
@@ -2,7 +2,7 @@
 // Deskripsi : Prompt retry create profile (pilih field yang ingin diubah)
 // Author : Hadiyatna Muflihun
 // Tanggal : 9 Januari 2026
-// Last Modified : 9 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package wizard
 
@@ -19,7 +19,7 @@ import (
 // setelah koneksi database gagal ketika proses save (mode create).
 //
 // UX requirement:
-// - Tampilkan multi-select field (7 item) seperti screenshot: nama, kunci enkripsi, DB host/port/user/password, dan toggle SSH.
+// - Tampilkan multi-select field (8 item): nama, kunci enkripsi, DB host/port/user/password, TLS, dan toggle SSH.
 // - Hanya re-prompt field yang dipilih.
 func (r *Runner) PromptCreateRetrySelectedFields() error {
 	if r.State.ProfileInfo == nil {
@@ -33,6 +33,8 @@ func (r *Runner) PromptCreateRetrySelectedFields() error {
 		consts.ProfileLabelDBPort,
 		consts.ProfileLabelDBUser,
 		consts.ProfileLabelDBPassword,
+		consts.ProfileLabelDBSocket,
+		consts.ProfileFieldTLS,
 		consts.ProfileFieldSSHTunnelToggle,
 	}
 
@@ -96,6 +98,18 @@ func (r *Runner) PromptCreateRetrySelectedFields() error {
 		}
 	}
 
+	if selected[consts.ProfileLabelDBSocket] {
+		if err := r.promptDBSocket(); err != nil {
+			return err
+		}
+	}
+
+	if selected[consts.ProfileFieldTLS] {
+		if err := r.promptTLSSettings(); err != nil {
+			return err
+		}
+	}
+
 	if selected[consts.ProfileFieldSSHTunnelToggle] {
 		enable, err := prompt.Confirm(consts.ProfilePromptUseSSHTunnel, r.State.ProfileInfo.SSHTunnel.Enabled)
 		if err != nil {
@@ -2,7 +2,7 @@
 // Deskripsi : Prompt edit field secara interaktif (multi-select)
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package wizard
 
@@ -26,12 +26,16 @@ func (r *Runner) promptEditSelectedFields() error {
 		consts.ProfileLabelDBPort,
 		consts.ProfileLabelDBUser,
 		consts.ProfileLabelDBPassword,
+		consts.ProfileLabelDBSocket,
+		consts.ProfileFieldTLS,
 		consts.ProfileFieldSSHTunnelToggle,
 		consts.ProfileLabelSSHHost,
 		consts.ProfileLabelSSHPort,
 		consts.ProfileLabelSSHUser,
 		consts.ProfileLabelSSHPassword,
 		consts.ProfileLabelSSHIdentityFile,
+		consts.ProfileLabelSSHJumpHosts,
+		consts.ProfileLabelSSHAgent,
 		consts.ProfileLabelSSHLocalPort,
 	}
 
@@ -98,6 +102,18 @@ func (r *Runner) promptEditSelectedFields() error {
 		}
 	}
 
+	if selected[consts.ProfileLabelDBSocket] {
+		if err := r.promptDBSocket(); err != nil {
+			return err
+		}
+	}
+
+	if selected[consts.ProfileFieldTLS] {
+		if err := r.promptTLSSettings(); err != nil {
+			return err
+		}
+	}
+
 	if selected[consts.ProfileFieldSSHTunnelToggle] {
 		enable, err := prompt.Confirm(consts.ProfilePromptUseSSHTunnel, r.State.ProfileInfo.SSHTunnel.Enabled)
 		if err != nil {
@@ -190,6 +206,18 @@ func (r *Runner) promptEditSelectedFields() error {
 		r.State.ProfileInfo.SSHTunnel.IdentityFile = strings.TrimSpace(v)
 	}
 
+	if selected[consts.ProfileLabelSSHJumpHosts] {
+		if err := r.promptSSHJumpHosts(); err != nil {
+			return err
+		}
+	}
+
+	if selected[consts.ProfileLabelSSHAgent] {
+		if err := r.promptSSHAgent(); err != nil {
+			return err
+		}
+	}
+
 	if selected[consts.ProfileLabelSSHLocalPort] {
 		validator := validatePortRange(1, 65535, true, consts.ProfileLabelSSHLocalPort)
 		v, err := prompt.AskInt(consts.ProfilePromptSSHLocalPort, r.State.ProfileInfo.SSHTunnel.LocalPort, validator)
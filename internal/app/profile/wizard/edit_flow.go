@@ -2,7 +2,7 @@
 // Deskripsi : Flow wizard untuk edit profile (honor flag overrides)
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 package wizard
 
 import (
@@ -71,6 +71,9 @@ func (r *Runner) runEditFlow() error {
 	merger.ApplySnapshotAsBaseline(r.State.ProfileInfo, r.State.OriginalProfileInfo)
 	merger.ApplyDBOverrides(r.State.ProfileInfo, overrideDB)
 	merger.ApplySSHOverrides(r.State.ProfileInfo, overrideSSH)
+	if editOpts, ok := r.State.EditOptions(); ok && editOpts != nil {
+		merger.ApplyTagOverrides(r.State.ProfileInfo, editOpts.SetTags, editOpts.UnsetTags, editOpts.AddGroups, editOpts.RemoveGroups)
+	}
 
 	// Tampilkan isi profil terlebih dahulu (seperti profile show), lalu beri opsi ubah/batal.
 	// Ini tetap dijalankan walaupun ada override flag/env, supaya user selalu lihat kondisi awal.
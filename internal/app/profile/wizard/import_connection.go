@@ -2,7 +2,7 @@
 // Deskripsi : Import connection test wizard
 // Author : Hadiyatna Muflihun
 // Tanggal : 26 Januari 2026
-// Last Modified : 26 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package wizard
 
@@ -12,8 +12,11 @@ import (
 
 	profileconn "sfdbtools/internal/app/profile/connection"
 	"sfdbtools/internal/app/profile/helpers/importer"
+	"sfdbtools/internal/app/profile/helpers/loader"
 	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/domain"
 	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
 	sharedvalidation "sfdbtools/internal/shared/validation"
 	"sfdbtools/internal/ui/prompt"
 )
@@ -35,6 +38,12 @@ func (w *ImportWizard) promptConnectionTest(opts *profilemodel.ProfileImportOpti
 	return nil
 }
 
+// TestRow menjalankan conn-test satu row dengan perilaku yang sama seperti import
+// (prompt saat gagal, atau skip/error sesuai --continue-on-error pada automation).
+func (w *ImportWizard) TestRow(r profilemodel.ImportRow, idx, total int, opts *profilemodel.ProfileImportOptions) (profilemodel.ImportRow, error) {
+	return w.testConnection(r, idx, total, opts)
+}
+
 // testConnection melakukan connection test untuk satu row
 // Handles interactive prompt saat conn-test gagal
 func (w *ImportWizard) testConnection(
@@ -46,7 +55,7 @@ func (w *ImportWizard) testConnection(
 		idx, total, importer.SafeName(r.PlannedName), r.RowNum, strings.TrimSpace(r.Host))
 
 	info := importer.BuildProfileInfoFromRow(r)
-	conn, err := profileconn.ConnectWithProfile(w.Config, &info, consts.DefaultInitialDatabase)
+	conn, err := connectImportRow(w.Config, &info)
 	if err != nil {
 		w.Log.Warnf("[profile-import] %d/%d koneksi gagal '%s' (row %d): %v",
 			idx, total, importer.SafeName(r.PlannedName), r.RowNum, err)
@@ -94,3 +103,13 @@ func (w *ImportWizard) testConnection(
 
 	return r, nil
 }
+
+// connectImportRow membuka koneksi test untuk row import. Referensi secret row tetap disimpan apa adanya;
+// koneksi memakai salinan yang sudah di-resolve.
+func connectImportRow(cfg interface{}, info *domain.ProfileInfo) (*database.Client, error) {
+	resolved, err := loader.WithResolvedSecrets(info)
+	if err != nil {
+		return nil, err
+	}
+	return profileconn.ConnectWithProfile(cfg, resolved, consts.DefaultInitialDatabase)
+}
@@ -2,7 +2,7 @@
 // Deskripsi : Import wizard orchestration (main flow)
 // Author : Hadiyatna Muflihun
 // Tanggal : 26 Januari 2026
-// Last Modified : 26 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package wizard
 
@@ -33,16 +33,8 @@ func NewImportWizard(log applog.Logger, cfg *appconfig.Config, configDir string)
 // Run executes the complete import workflow
 // Returns planned rows yang siap untuk disave oleh executor
 func (w *ImportWizard) Run(opts *profilemodel.ProfileImportOptions) ([]profilemodel.ImportRow, error) {
-	// PHASE 1: Read source (interactive source selection)
-	headers, dataRows, warnings, srcLabel, err := w.readSource(opts)
-	if err != nil {
-		w.Log.Warnf("[profile-import] Gagal membaca sumber %s: %v", srcLabel, err)
-		return nil, err
-	}
-	w.logSourceInfo(srcLabel, headers, dataRows, warnings)
-
-	// PHASE 2: Schema validation + row parsing
-	parsedRows, err := w.validateAndParse(headers, dataRows, srcLabel, opts)
+	// PHASE 1-2: Read source + validasi
+	parsedRows, err := w.ReadAndValidate(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +51,21 @@ func (w *ImportWizard) Run(opts *profilemodel.ProfileImportOptions) ([]profilemo
 	return planned, nil
 }
 
+// ReadAndValidate membaca sumber lalu menjalankan validasi schema + per-row (fase 1-2), tanpa conflict/conn-test.
+// Dipakai juga oleh profile sync yang menentukan aksi per profile sendiri.
+func (w *ImportWizard) ReadAndValidate(opts *profilemodel.ProfileImportOptions) ([]profilemodel.ImportRow, error) {
+	// PHASE 1: Read source (interactive source selection)
+	headers, dataRows, warnings, srcLabel, err := w.readSource(opts)
+	if err != nil {
+		w.Log.Warnf("[profile-import] Gagal membaca sumber %s: %v", srcLabel, err)
+		return nil, err
+	}
+	w.logSourceInfo(srcLabel, headers, dataRows, warnings)
+
+	// PHASE 2: Schema validation + row parsing
+	return w.validateAndParse(headers, dataRows, srcLabel, opts)
+}
+
 // logSourceInfo logs informasi sumber data yang dibaca
 func (w *ImportWizard) logSourceInfo(srcLabel string, headers []string, dataRows [][]string, warnings []string) {
 	nonEmpty := importer.CountNonEmptyRows(dataRows)
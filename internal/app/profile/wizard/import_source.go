@@ -1,8 +1,8 @@
 // File : internal/app/profile/wizard/import_source.go
-// Deskripsi : Import source selection wizard (XLSX/GSheet)
+// Deskripsi : Import source selection wizard (XLSX/CSV/manifest/GSheet/option file)
 // Author : Hadiyatna Muflihun
 // Tanggal : 26 Januari 2026
-// Last Modified : 26 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package wizard
 
@@ -14,6 +14,8 @@ import (
 
 	"sfdbtools/internal/app/profile/helpers/reader"
 	profilemodel "sfdbtools/internal/app/profile/model"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/shared/consts"
 	sharedvalidation "sfdbtools/internal/shared/validation"
 	"sfdbtools/internal/ui/prompt"
 
@@ -23,6 +25,10 @@ import (
 // readSource membaca data dari XLSX lokal atau Google Spreadsheet
 // Handles interactive source selection, sheet/tab selection
 func (w *ImportWizard) readSource(opts *profilemodel.ProfileImportOptions) ([]string, [][]string, []string, string, error) {
+	if strings.TrimSpace(opts.FromMyCnf) != "" || strings.TrimSpace(opts.FromLoginPath) != "" {
+		return w.readOptionFileSource(opts)
+	}
+
 	// Pilih Source (full-interaktif) jika kedua source kosong
 	if strings.TrimSpace(opts.Input) == "" && strings.TrimSpace(opts.GSheetURL) == "" {
 		if opts.SkipConfirm {
@@ -48,7 +54,7 @@ func (w *ImportWizard) readSource(opts *profilemodel.ProfileImportOptions) ([]st
 func (w *ImportWizard) promptSourceType(opts *profilemodel.ProfileImportOptions) error {
 	choice, _, err := prompt.SelectOne(
 		"Pilih sumber import profile:",
-		[]string{"XLSX lokal", "Google Spreadsheet", "Batal"},
+		[]string{"File lokal (XLSX/CSV/YAML/JSON)", "Google Spreadsheet", "Batal"},
 		0,
 	)
 	if err != nil {
@@ -56,8 +62,8 @@ func (w *ImportWizard) promptSourceType(opts *profilemodel.ProfileImportOptions)
 	}
 
 	switch choice {
-	case "XLSX lokal":
-		selected, selErr := prompt.SelectFile(".", "Pilih file XLSX untuk import profile", []string{".xlsx"})
+	case "File lokal (XLSX/CSV/YAML/JSON)":
+		selected, selErr := prompt.SelectFile(".", "Pilih file XLSX/CSV/YAML/JSON untuk import profile", []string{".xlsx", ".csv", ".yaml", ".yml", ".json"})
 		if selErr != nil {
 			return sharedvalidation.HandleInputError(selErr)
 		}
@@ -87,9 +93,28 @@ func (w *ImportWizard) promptSourceType(opts *profilemodel.ProfileImportOptions)
 
 // readXLSXSource reads from local XLSX file (dengan interactive sheet selection)
 func (w *ImportWizard) readXLSXSource(opts *profilemodel.ProfileImportOptions) ([]string, [][]string, []string, string, error) {
+	if reader.IsManifestPath(opts.Input) {
+		w.Log.Infof("[profile-import] Membaca manifest: %s", opts.Input)
+		// Kunci default untuk profile tanpa profile_key di manifest (flag/ENV, tanpa prompt).
+		key, _, _ := crypto.ResolveKey(opts.ProfileKey, consts.ENV_TARGET_PROFILE_KEY, false)
+		res, err := reader.ReadManifest(opts.Input, key)
+		if err != nil {
+			return nil, nil, nil, "manifest", err
+		}
+		return res.Headers, res.DataRows, res.Warnings, res.Source, nil
+	}
+	if reader.IsCSVPath(opts.Input) {
+		w.Log.Infof("[profile-import] Membaca CSV lokal: %s", opts.Input)
+		res, err := reader.ReadCSVFile(opts.Input)
+		if err != nil {
+			return nil, nil, nil, "CSV", err
+		}
+		return res.Headers, res.DataRows, res.Warnings, res.Source, nil
+	}
+
 	// Pilih Sheet (XLSX) - hanya jika --sheet kosong
 	if strings.TrimSpace(opts.Sheet) == "" && !opts.SkipConfirm {
-		sheets, err := reader.ListXLSXSheets(opts.Input)
+		sheets, err := reader.ListXLSXSheets(opts.Input, opts.InputPassword)
 		if err != nil {
 			return nil, nil, nil, "XLSX", err
 		}
@@ -106,7 +131,7 @@ func (w *ImportWizard) readXLSXSource(opts *profilemodel.ProfileImportOptions) (
 	}
 
 	w.Log.Infof("[profile-import] Membaca XLSX lokal: %s (sheet=%s)", opts.Input, opts.Sheet)
-	res, err := reader.ReadXLSX(opts.Input, opts.Sheet)
+	res, err := reader.ReadXLSX(opts.Input, opts.Sheet, opts.InputPassword)
 	if err != nil {
 		return nil, nil, nil, "XLSX", err
 	}
@@ -215,3 +240,33 @@ func extractGIDFromLabel(s string) int {
 	}
 	return n
 }
+
+// readOptionFileSource membaca option file MySQL (--from-mycnf) atau login path (--from-login-path).
+// Semua profile hasil import dienkripsi dengan satu kunci (--profile-key/ENV, atau prompt).
+func (w *ImportWizard) readOptionFileSource(opts *profilemodel.ProfileImportOptions) ([]string, [][]string, []string, string, error) {
+	label := "option file"
+	if strings.TrimSpace(opts.FromLoginPath) != "" {
+		label = "login path"
+	}
+	key, _, err := crypto.ResolveKey(opts.ProfileKey, consts.ENV_TARGET_PROFILE_KEY, !opts.SkipConfirm)
+	if err != nil {
+		return nil, nil, nil, label, fmt.Errorf("kunci enkripsi profile dibutuhkan untuk import %s: %w", label, err)
+	}
+
+	var res *reader.ImportTableResult
+	if strings.TrimSpace(opts.FromMyCnf) != "" {
+		w.Log.Infof("[profile-import] Membaca option file: %s", opts.FromMyCnf)
+		res, err = reader.ReadMyCnf(opts.FromMyCnf, key)
+	} else {
+		path := opts.FromLoginPath
+		if path == consts.ProfileImportLoginPathDefault {
+			path = reader.DefaultLoginPathFile()
+		}
+		w.Log.Infof("[profile-import] Membaca login path: %s", path)
+		res, err = reader.ReadLoginPath(path, key)
+	}
+	if err != nil {
+		return nil, nil, nil, label, err
+	}
+	return res.Headers, res.DataRows, res.Warnings, res.Source, nil
+}
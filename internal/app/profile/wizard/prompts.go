@@ -2,7 +2,7 @@
 // Deskripsi : Prompt wizard untuk nama/config, DB info, dan SSH tunnel
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package wizard
 
@@ -12,7 +12,10 @@ import (
 	"strings"
 
 	"sfdbtools/internal/app/profile/merger"
+	"sfdbtools/internal/app/profile/process"
+	"sfdbtools/internal/domain"
 	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
 	"sfdbtools/internal/shared/validation"
 	"sfdbtools/internal/ui/print"
 	"sfdbtools/internal/ui/prompt"
@@ -75,7 +78,18 @@ func (r *Runner) promptProfileInfo() error {
 		}
 	}
 
-	// Port
+	// Unix socket hanya relevan untuk host lokal
+	if strings.TrimSpace(r.State.ProfileInfo.DBInfo.Socket) == "" && isLocalDBHost(r.State.ProfileInfo.DBInfo.Host) {
+		if err := r.promptDBSocket(); err != nil {
+			return err
+		}
+	}
+	useSocket := strings.TrimSpace(r.State.ProfileInfo.DBInfo.Socket) != ""
+
+	// Port (tidak dipakai koneksi socket; tetap disimpan dengan default 3306)
+	if useSocket && r.State.ProfileInfo.DBInfo.Port == 0 {
+		r.State.ProfileInfo.DBInfo.Port = 3306
+	}
 	if r.State.ProfileInfo.DBInfo.Port == 0 {
 		validator := prompt.ComposeValidators(
 			survey.Required,
@@ -116,30 +130,171 @@ func (r *Runner) promptProfileInfo() error {
 		if !isEditFlow && envPassword != "" {
 			r.State.ProfileInfo.DBInfo.Password = envPassword
 		} else {
-			if !isEditFlow {
-				print.PrintWarning(fmt.Sprintf(consts.ProfileWarnEnvVarMissingOrEmptyFmt, consts.ENV_TARGET_DB_PASSWORD, consts.ENV_TARGET_DB_PASSWORD))
+			validators := []survey.Validator{
+				validateOptionalNoControlChars(consts.ProfileLabelDBPassword),
+				validateOptionalNoLeadingTrailingSpaces(consts.ProfileLabelDBPassword),
 			}
-			if isEditFlow {
+			switch {
+			case useSocket:
+				print.PrintInfo(consts.ProfileTipDBPasswordSocket)
+			case isEditFlow:
 				print.PrintInfo(consts.ProfileTipKeepCurrentDBPasswordUpdate)
+			default:
+				print.PrintWarning(fmt.Sprintf(consts.ProfileWarnEnvVarMissingOrEmptyFmt, consts.ENV_TARGET_DB_PASSWORD, consts.ENV_TARGET_DB_PASSWORD))
+			}
+			// Password wajib kecuali koneksi socket (auth unix_socket tidak memakai password).
+			if !useSocket {
+				validators = append([]survey.Validator{validateNotBlank(consts.ProfileLabelDBPassword)}, validators...)
 			}
 			if err := r.askAndAssignPassword(
 				&r.State.ProfileInfo.DBInfo.Password,
 				consts.ProfileLabelDBPassword,
-				prompt.ComposeValidators(
-					validateNotBlank(consts.ProfileLabelDBPassword),
-					validateNoControlChars(consts.ProfileLabelDBPassword),
-					validateOptionalNoLeadingTrailingSpaces(consts.ProfileLabelDBPassword),
-				),
+				prompt.ComposeValidators(validators...),
 			); err != nil {
 				return err
 			}
 		}
 	}
 
-	// SSH tunnel
+	// TLS/SSL (lewati jika ssl_mode sudah diberikan via flag)
+	if strings.TrimSpace(r.State.ProfileInfo.DBInfo.TLS.Mode) == "" {
+		if err := r.promptTLSSettings(); err != nil {
+			return err
+		}
+	}
+
+	// SSH tunnel (tidak berlaku untuk koneksi socket lokal)
+	if useSocket {
+		r.State.ProfileInfo.SSHTunnel.Enabled = false
+		return nil
+	}
 	return r.promptSSHTunnelDetailsIfEnabledOrAsk()
 }
 
+// isLocalDBHost true jika host menunjuk ke mesin lokal (socket dapat dipakai).
+func isLocalDBHost(host string) bool {
+	switch strings.ToLower(strings.TrimSpace(host)) {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	}
+	return false
+}
+
+// promptDBSocket menanyakan koneksi lewat unix socket lokal.
+// Path hasil deteksi (my.cnf / --print-defaults) dipakai sebagai default.
+func (r *Runner) promptDBSocket() error {
+	db := &r.State.ProfileInfo.DBInfo
+	detected, _ := database.DetectSocketPath()
+	use := strings.TrimSpace(db.Socket) != "" || detected != ""
+	if err := r.askAndAssignBool(&use, consts.ProfilePromptUseDBSocket, use); err != nil {
+		return err
+	}
+	if !use {
+		db.Socket = ""
+		return nil
+	}
+
+	def := strings.TrimSpace(db.Socket)
+	if def == "" {
+		def = detected
+	}
+	validator := prompt.ComposeValidators(
+		validateNotBlank(consts.ProfileLabelDBSocket),
+		validateNoControlChars(consts.ProfileLabelDBSocket),
+		func(ans interface{}) error {
+			s, _ := ans.(string)
+			return database.ValidateSocketPath(s)
+		},
+	)
+	v, err := prompt.AskText(consts.ProfilePromptDBSocket, prompt.WithDefault(def), prompt.WithValidator(validator))
+	if err != nil {
+		return validation.HandleInputError(err)
+	}
+	db.Socket = strings.TrimSpace(v)
+	return nil
+}
+
+// promptSSHJumpHosts meminta rantai jump host; nilai saat ini dipakai sebagai default.
+func (r *Runner) promptSSHJumpHosts() error {
+	ssh := &r.State.ProfileInfo.SSHTunnel
+	validator := prompt.ComposeValidators(
+		validateOptionalNoControlChars(consts.ProfileLabelSSHJumpHosts),
+		func(ans interface{}) error {
+			s, _ := ans.(string)
+			return process.ValidateJumpHosts(process.SplitJumpHosts(s))
+		},
+	)
+	v, err := prompt.AskText(consts.ProfilePromptSSHJumpHosts, prompt.WithDefault(strings.Join(ssh.JumpHosts, ",")), prompt.WithValidator(validator))
+	if err != nil {
+		return validation.HandleInputError(err)
+	}
+	ssh.JumpHosts = process.SplitJumpHosts(v)
+	return nil
+}
+
+// promptSSHAgent menanyakan apakah autentikasi SSH memakai ssh-agent.
+func (r *Runner) promptSSHAgent() error {
+	ssh := &r.State.ProfileInfo.SSHTunnel
+	def := ssh.UseAgent || strings.TrimSpace(os.Getenv("SSH_AUTH_SOCK")) != ""
+	return r.askAndAssignBool(&ssh.UseAgent, consts.ProfilePromptSSHUseAgent, def)
+}
+
+// promptTLSSettings meminta ssl_mode lalu file sertifikat yang relevan untuk mode tersebut.
+// Nilai saat ini dipakai sebagai default (dipakai ulang oleh flow edit).
+func (r *Runner) promptTLSSettings() error {
+	t := &r.State.ProfileInfo.DBInfo.TLS
+	items := append([]string{consts.ProfileSSLModeDefaultOption}, database.SSLModes...)
+	def := 0
+	for i, it := range items {
+		if it == t.Mode {
+			def = i
+		}
+	}
+	choice, _, err := prompt.SelectOne(consts.ProfilePromptSSLMode, items, def)
+	if err != nil {
+		return validation.HandleInputError(err)
+	}
+
+	switch choice {
+	case consts.ProfileSSLModeDefaultOption:
+		*t = domain.TLSConfig{}
+		return nil
+	case consts.SSLModeDisabled, consts.SSLModePreferred:
+		*t = domain.TLSConfig{Mode: choice}
+		return nil
+	}
+	t.Mode = choice
+
+	caLabel := consts.ProfilePromptSSLCAOptional
+	caRequired := survey.Validator(nil)
+	if choice == consts.SSLModeVerifyCA {
+		caLabel = consts.ProfilePromptSSLCARequired
+		caRequired = validateNotBlank(consts.ProfileLabelSSLCA)
+	}
+	if err := r.askAndAssignText(&t.CA, caLabel, prompt.WithDefault(t.CA), prompt.WithValidator(prompt.ComposeValidators(
+		caRequired,
+		validateOptionalNoControlChars(consts.ProfileLabelSSLCA),
+		validateOptionalExistingFilePath(consts.ProfileLabelSSLCA),
+	))); err != nil {
+		return err
+	}
+
+	if err := r.askAndAssignText(&t.Cert, consts.ProfilePromptSSLCertOptional, prompt.WithDefault(t.Cert), prompt.WithValidator(prompt.ComposeValidators(
+		validateOptionalNoControlChars(consts.ProfileLabelSSLCert),
+		validateOptionalExistingFilePath(consts.ProfileLabelSSLCert),
+	))); err != nil {
+		return err
+	}
+	if t.Cert == "" {
+		t.Key = ""
+		return nil
+	}
+	return r.askAndAssignText(&t.Key, consts.ProfilePromptSSLKey, prompt.WithDefault(t.Key), prompt.WithValidator(prompt.ComposeValidators(
+		validateNotBlank(consts.ProfileLabelSSLKey),
+		validateOptionalExistingFilePath(consts.ProfileLabelSSLKey),
+	)))
+}
+
 // promptSSHTunnelDetailsIfEnabledOrAsk akan:
 // - jika sudah Enabled (mis. via flag --ssh), langsung prompt detail yang belum ada
 // - jika belum Enabled, akan tanya Yes/No dulu
@@ -210,6 +365,13 @@ func (r *Runner) promptSSHTunnelDetailsIfEnabled() error {
 		}
 	}
 
+	// Jump host opsional (ProxyJump sebelum bastion)
+	if len(ssh.JumpHosts) == 0 {
+		if err := r.promptSSHJumpHosts(); err != nil {
+			return err
+		}
+	}
+
 	// Password opsional
 	if strings.TrimSpace(ssh.Password) == "" {
 		print.PrintInfo(consts.ProfileTipSSHPasswordOptional)
@@ -246,6 +408,13 @@ func (r *Runner) promptSSHTunnelDetailsIfEnabled() error {
 		}
 	}
 
+	// Tanpa password/key: tawarkan ssh-agent (default ya jika SSH_AUTH_SOCK tersedia)
+	if !ssh.UseAgent && strings.TrimSpace(ssh.Password) == "" && strings.TrimSpace(ssh.IdentityFile) == "" {
+		if err := r.promptSSHAgent(); err != nil {
+			return err
+		}
+	}
+
 	// Local port opsional
 	if ssh.LocalPort == 0 {
 		validator := validatePortRange(1, 65535, true, consts.ProfileLabelSSHLocalPort)
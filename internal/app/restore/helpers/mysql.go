@@ -2,7 +2,7 @@
 // Deskripsi : Helper functions untuk MySQL restore operations
 // Author : Hadiyatna Muflihun
 // Tanggal : 17 Desember 2025
-// Last Modified : 26 Januari 2026
+// Last Modified : 18 Oktober 2026
 package helpers
 
 import (
@@ -19,22 +19,12 @@ import (
 	"sfdbtools/internal/domain"
 	"sfdbtools/internal/shared/compress"
 	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
+	"sfdbtools/internal/shared/execx"
 	"sfdbtools/internal/ui/progress"
 	"strings"
 )
 
-func resolveMariaDBOrMySQLClient() (binPath string, binName string, err error) {
-	// Default: mariadb client (mysql CLI compatible)
-	if p, e := exec.LookPath("mariadb"); e == nil {
-		return p, "mariadb", nil
-	}
-	// Fallback: mysql client
-	if p, e := exec.LookPath("mysql"); e == nil {
-		return p, "mysql", nil
-	}
-	return "", "", fmt.Errorf("binary client database tidak ditemukan: butuh 'mariadb' atau 'mysql' di PATH")
-}
-
 func isSSLMismatchServerNotSupport(err error) bool {
 	if err == nil {
 		return false
@@ -52,14 +42,57 @@ func hasSkipSSLArg(args []string) bool {
 	return false
 }
 
+// AllowSkipSSLRetry true jika restore boleh diulang dengan --skip-ssl.
+// Profile dengan ssl_mode yang mewajibkan TLS tidak pernah diturunkan ke koneksi tanpa enkripsi.
+func AllowSkipSSLRetry(profile *domain.ProfileInfo) bool {
+	return profile == nil || !database.SSLModeRequiresTLS(profile.DBInfo.TLS.Mode)
+}
+
+// ValidateClientTLS memastikan ssl_mode profile bisa dipenuhi client mysql CLI lewat SSH tunnel
+// (lihat database.ValidateClientTLSTunnel). Dipanggil sebelum menjalankan client.
+func ValidateClientTLS(profile *domain.ProfileInfo) error {
+	if profile == nil || !profile.SSHTunnel.Enabled {
+		return nil
+	}
+	mariadb := false
+	if bin, err := execx.ResolveMariaDBOrMySQLClient(); err == nil {
+		mariadb = execx.IsMariaDBClient(bin)
+	}
+	return database.ValidateClientTLSTunnel(profile.DBInfo.TLS, profile.SSHTunnel.Enabled, mariadb)
+}
+
+// clientTLSArgs membuat argumen TLS dari profile sesuai flavor client (mariadb/mysql).
+func clientTLSArgs(profile *domain.ProfileInfo) []string {
+	if profile == nil || strings.TrimSpace(profile.DBInfo.TLS.Mode) == "" {
+		return nil
+	}
+	bin, err := execx.ResolveMariaDBOrMySQLClient()
+	if err != nil {
+		return nil
+	}
+	return database.ClientTLSArgs(profile.DBInfo.TLS, execx.IsMariaDBClient(bin))
+}
+
 // BuildMySQLArgs membuat argument list untuk mysql command
 func BuildMySQLArgs(profile *domain.ProfileInfo, database string, extraArgs ...string) []string {
 	eff := profileconn.EffectiveDBInfo(profile)
-	args := []string{
-		fmt.Sprintf("--host=%s", eff.Host),
-		fmt.Sprintf("--port=%d", eff.Port),
-		fmt.Sprintf("--user=%s", profile.DBInfo.User),
-		fmt.Sprintf("--password=%s", profile.DBInfo.Password),
+	// Socket lokal menggantikan host/port.
+	args := []string{fmt.Sprintf("--socket=%s", eff.Socket)}
+	if strings.TrimSpace(eff.Socket) == "" {
+		args = []string{
+			fmt.Sprintf("--host=%s", eff.Host),
+			fmt.Sprintf("--port=%d", eff.Port),
+		}
+	}
+	args = append(args, fmt.Sprintf("--user=%s", profile.DBInfo.User))
+	// Koneksi socket tanpa password (auth unix_socket) tidak mengirim --password. Koneksi TCP selalu
+	// mengirimnya (juga saat kosong) agar client tidak memakai password dari ~/.my.cnf atau [client].
+	if strings.TrimSpace(eff.Socket) == "" || profile.DBInfo.Password != "" {
+		args = append(args, fmt.Sprintf("--password=%s", profile.DBInfo.Password))
+	}
+	// Argumen TLS profile dilewati saat retry --skip-ssl.
+	if !hasSkipSSLArg(extraArgs) {
+		args = append(args, clientTLSArgs(profile)...)
 	}
 
 	// Tambahkan extra args jika ada
@@ -75,12 +108,12 @@ func BuildMySQLArgs(profile *domain.ProfileInfo, database string, extraArgs ...s
 
 // ExecuteMySQLCommand menjalankan mysql command dengan stdin reader
 func ExecuteMySQLCommand(ctx context.Context, args []string, stdin io.Reader) error {
-	binPath, binName, err := resolveMariaDBOrMySQLClient()
+	bin, err := execx.ResolveMariaDBOrMySQLClient()
 	if err != nil {
 		return err
 	}
 
-	cmd := exec.CommandContext(ctx, binPath, args...)
+	cmd := exec.CommandContext(ctx, bin.Path, args...)
 	cmd.Stdin = stdin
 
 	var stderr strings.Builder
@@ -90,9 +123,9 @@ func ExecuteMySQLCommand(ctx context.Context, args []string, stdin io.Reader) er
 	if err := cmd.Run(); err != nil {
 		stderrMsg := stderr.String()
 		if stderrMsg != "" {
-			return fmt.Errorf("%s command error: %w (stderr: %s)", binName, err, stderrMsg)
+			return fmt.Errorf("%s command error: %w (stderr: %s)", bin.Name, err, stderrMsg)
 		}
-		return fmt.Errorf("%s command error: %w", binName, err)
+		return fmt.Errorf("%s command error: %w", bin.Name, err)
 	}
 
 	return nil
@@ -100,6 +133,9 @@ func ExecuteMySQLCommand(ctx context.Context, args []string, stdin io.Reader) er
 
 // RestoreFromFile melakukan restore database dari file backup
 func RestoreFromFile(ctx context.Context, filePath string, targetDB string, profile *domain.ProfileInfo, encryptionKey string) error {
+	if err := ValidateClientTLS(profile); err != nil {
+		return err
+	}
 	spin := progress.NewSpinnerWithElapsed(fmt.Sprintf("Restore database %s dari %s", targetDB, filepath.Base(filePath)))
 	spin.Start()
 	defer spin.Stop()
@@ -121,7 +157,7 @@ func RestoreFromFile(ctx context.Context, filePath string, targetDB string, prof
 	if err := execRestore(args); err != nil {
 		// Fallback: beberapa environment punya default SSL=ON/REQUIRED di client config.
 		// Jika target server tidak support SSL, retry sekali dengan SSL dimatikan.
-		if isSSLMismatchServerNotSupport(err) && !hasSkipSSLArg(args) {
+		if isSSLMismatchServerNotSupport(err) && !hasSkipSSLArg(args) && AllowSkipSSLRetry(profile) {
 			retryArgs := BuildMySQLArgs(profile, targetDB, "--skip-ssl", "-f")
 			if err2 := execRestore(retryArgs); err2 == nil {
 				return nil
@@ -188,6 +224,9 @@ func RestoreUserGrants(ctx context.Context, grantsFile string, profile *domain.P
 	if grantsFile == "" {
 		return nil
 	}
+	if err := ValidateClientTLS(profile); err != nil {
+		return err
+	}
 
 	spin := progress.NewSpinnerWithElapsed(fmt.Sprintf("Restore user grants dari %s", filepath.Base(grantsFile)))
 	spin.Start()
@@ -203,7 +242,7 @@ func RestoreUserGrants(ctx context.Context, grantsFile string, profile *domain.P
 
 	// Execute mysql restore
 	if err := ExecuteMySQLCommand(ctx, args, strings.NewReader(string(grantsSQL))); err != nil {
-		if isSSLMismatchServerNotSupport(err) && !hasSkipSSLArg(args) {
+		if isSSLMismatchServerNotSupport(err) && !hasSkipSSLArg(args) && AllowSkipSSLRetry(profile) {
 			retryArgs := BuildMySQLArgs(profile, "", "--skip-ssl")
 			if err2 := ExecuteMySQLCommand(ctx, retryArgs, strings.NewReader(string(grantsSQL))); err2 == nil {
 				return nil
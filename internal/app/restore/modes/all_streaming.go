@@ -2,7 +2,7 @@
 // Deskripsi : Streaming restore execution untuk AllExecutor
 // Author : Hadiyatna Muflihun
 // Tanggal : 30 Desember 2025
-// Last Modified : 26 Januari 2026
+// Last Modified : 18 Oktober 2026
 package modes
 
 import (
@@ -117,6 +117,9 @@ func (e *AllExecutor) performStreamingRestore(ctx context.Context, opts *restore
 	logger := e.service.GetLogger()
 
 	profile := e.service.GetProfile()
+	if err := helpers.ValidateClientTLS(profile); err != nil {
+		return err
+	}
 	// Catatan: "--force" di sini adalah argumen client mysql/mariadb (agar lanjut saat error SQL),
 	// BUKAN flag CLI sfdbtools.
 	baseExtraArgs := []string{"--force", "--reconnect", "--max_allowed_packet=1073741824"}
@@ -189,7 +192,7 @@ func (e *AllExecutor) performStreamingRestore(ctx context.Context, opts *restore
 
 	stats, dur, err := runOnce(false)
 	if err != nil {
-		if isSSLMismatchServerNotSupport(err) {
+		if isSSLMismatchServerNotSupport(err) && helpers.AllowSkipSSLRetry(profile) {
 			logger.Warn("Restore gagal karena SSL mismatch; mencoba ulang dengan --skip-ssl")
 			stats2, dur2, err2 := runOnce(true)
 			if err2 == nil {
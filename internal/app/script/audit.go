@@ -0,0 +1,68 @@
+// File : internal/app/script/audit.go
+// Deskripsi : Audit log eksekusi script bundle (bundle, profile, ticket, hasil)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package script
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	applog "sfdbtools/internal/services/log"
+)
+
+// logRunAudit mencatat satu kali run bundle untuk audit trail (format sama dengan audit crypto).
+// Non-fatal: gagal logging tidak menggagalkan run.
+func logRunAudit(logger applog.Logger, opts RunOptions, err error) {
+	if logger == nil {
+		return
+	}
+
+	profile := "-"
+	if opts.Profile != nil {
+		profile = opts.Profile.Name
+	} else if strings.TrimSpace(opts.ProfilePath) != "" {
+		profile = strings.TrimSpace(opts.ProfilePath)
+	}
+
+	msg := fmt.Sprintf("AUDIT: operation=script_run user=%s bundle=%s profile=%s database=%s ticket=%s success=%t",
+		executedBy(),
+		filepath.Base(opts.FilePath),
+		profile,
+		auditValue(opts.Database),
+		auditValue(opts.Ticket),
+		err == nil,
+	)
+	if err != nil {
+		msg += fmt.Sprintf(" error=%q", err.Error())
+		logger.Warn(msg)
+		return
+	}
+	logger.Info(msg)
+}
+
+func auditValue(v string) string {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%q", v)
+}
+
+// executedBy mengembalikan user@host pelaksana (best effort), dipakai audit log dan tabel tracking SQL.
+func executedBy() string {
+	who := "unknown"
+	if u, err := user.Current(); err == nil {
+		who = u.Username
+	}
+	host := "unknown"
+	if h, err := os.Hostname(); err == nil {
+		host = h
+	}
+	return who + "@" + host
+}
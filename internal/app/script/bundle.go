@@ -2,7 +2,7 @@
 // Deskripsi : Bundle creation and execution untuk encrypted script bundles
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
@@ -11,7 +11,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	"os/exec"
@@ -20,6 +19,7 @@ import (
 	"sfdbtools/internal/crypto"
 	"sfdbtools/internal/shared/consts"
 	"sfdbtools/internal/shared/envx"
+	"sfdbtools/internal/ui/prompt"
 	"strings"
 	"time"
 )
@@ -30,6 +30,8 @@ import (
 //   - "bundle": Package entire directory with entrypoint
 //   - "single": Package only the entrypoint file
 //
+// Entrypoint .sql, atau folder berisi file .sql (mode bundle), menghasilkan bundle SQL.
+//
 // Output format: encrypted tar archive with manifest + script files
 // Context dapat digunakan untuk cancellation.
 func EncryptBundle(ctx context.Context, opts EncryptOptions) error {
@@ -47,12 +49,21 @@ func EncryptBundle(ctx context.Context, opts EncryptOptions) error {
 	if err != nil {
 		return fmt.Errorf("gagal membaca file entrypoint: %w", err)
 	}
-	if entryInfo.IsDir() {
-		return fmt.Errorf("--file harus file, bukan folder")
-	}
 
 	rootDir := filepath.Dir(entryPath)
 	entryBase := filepath.Base(entryPath)
+	if entryInfo.IsDir() {
+		// Folder hanya didukung untuk bundle SQL: semua .sql di folder dijalankan berurutan menurut nama.
+		entryPath = filepath.Clean(entryPath)
+		if _, err := collectSQLScripts(entryPath, sqlDirEntrypoint); err != nil {
+			return fmt.Errorf("--file folder harus berisi file .sql: %w", err)
+		}
+		if strings.EqualFold(strings.TrimSpace(opts.Mode), "single") {
+			return fmt.Errorf("folder SQL hanya bisa dienkripsi dengan mode bundle")
+		}
+		rootDir = entryPath
+		entryBase = sqlDirEntrypoint
+	}
 	outputPath := strings.TrimSpace(opts.OutputPath)
 	if outputPath == "" {
 		outputPath = defaultBundleOutputPath(entryPath)
@@ -74,6 +85,19 @@ func EncryptBundle(ctx context.Context, opts EncryptOptions) error {
 		return fmt.Errorf("gagal mendapatkan encryption key: %w", err)
 	}
 
+	signKey, err := resolveSigningKey(opts.SignKey)
+	if err != nil {
+		return err
+	}
+
+	specPath, spec, err := resolveBundleSpec(opts.SpecPath, rootDir)
+	if err != nil {
+		return err
+	}
+	if spec != nil && len(spec.Params) > 0 && isSQLEntrypoint(entryBase) {
+		return fmt.Errorf("bundle SQL tidak mendukung deklarasi params (nilai tidak bisa diteruskan ke client SQL)")
+	}
+
 	mode := strings.ToLower(strings.TrimSpace(opts.Mode))
 	if mode == "" {
 		mode = "bundle"
@@ -89,11 +113,16 @@ func EncryptBundle(ctx context.Context, opts EncryptOptions) error {
 		}
 		var outAbs2 string
 		outAbs2, _ = filepath.Abs(outputPath)
+		specAbs, _ := filepath.Abs(specPath)
 		for _, f := range files {
 			abs, _ := filepath.Abs(f)
 			if outAbs2 != "" && abs == outAbs2 {
 				continue
 			}
+			// File spec sudah masuk manifest, tidak perlu ikut dibundle.
+			if specPath != "" && abs == specAbs {
+				continue
+			}
 			filtered = append(filtered, f)
 		}
 	case "single":
@@ -102,6 +131,44 @@ func EncryptBundle(ctx context.Context, opts EncryptOptions) error {
 		return fmt.Errorf("mode tidak valid: %s (pilih: bundle|single)", opts.Mode)
 	}
 
+	// Digest dihitung sebelum menulis karena manifest (berisi digest + signature) adalah entry pertama tar.
+	fileHashes := map[string]string{}
+	for _, filePath := range filtered {
+		rel, err := filepath.Rel(rootDir, filePath)
+		if err != nil {
+			return fmt.Errorf("gagal resolve relative path: %w", err)
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			continue
+		}
+		if info, err := os.Stat(filePath); err != nil || info.IsDir() {
+			continue
+		}
+		if fileHashes[rel], err = hashFile(filePath); err != nil {
+			return err
+		}
+	}
+
+	m := manifest{
+		Entrypoint: entryBase,
+		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
+		Mode:       mode,
+		RootDir:    filepath.Base(rootDir),
+		Digest:     bundleDigest(fileHashes),
+	}
+	if spec != nil {
+		m.Description = strings.TrimSpace(spec.Description)
+		m.Params = spec.Params
+		m.Requires = spec.Requires
+	}
+	m.Version = manifestVersion(m)
+	if signKey != nil {
+		if err := signManifest(&m, signKey); err != nil {
+			return err
+		}
+	}
+
 	outFile, err := os.OpenFile(outputPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, SecureFilePermission)
 	if err != nil {
 		return fmt.Errorf("gagal membuat output file: %w", err)
@@ -119,13 +186,6 @@ func EncryptBundle(ctx context.Context, opts EncryptOptions) error {
 
 	tarWriter := tar.NewWriter(ew)
 
-	m := manifest{
-		Version:    bundleVersion,
-		Entrypoint: entryBase,
-		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
-		Mode:       mode,
-		RootDir:    filepath.Base(rootDir),
-	}
 	manifestBytes, _ := json.MarshalIndent(m, "", "  ")
 	if err := writeTarBytes(tarWriter, manifestFilename, manifestBytes, SecureFilePermission); err != nil {
 		_ = tarWriter.Close()
@@ -173,14 +233,54 @@ func EncryptBundle(ctx context.Context, opts EncryptOptions) error {
 	return nil
 }
 
+// manifestVersion mengembalikan versi format terendah yang dibutuhkan manifest, agar bundle shell biasa
+// tetap bisa dijalankan binary lama: 1 untuk bundle biasa, 2 jika ada parameter/requirement,
+// 3 jika entrypoint SQL. Description, digest, dan signature diabaikan binary lama sehingga tidak menaikkan versi.
+func manifestVersion(m manifest) int {
+	switch {
+	case isSQLEntrypoint(m.Entrypoint):
+		return 3
+	case len(m.Params) > 0 || m.Requires != nil:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// checkRunMode memeriksa opsi run terhadap jenis bundle. Bundle SQL dijalankan lewat client database ke
+// --database milik --profile, bukan lewat shell, sehingga tidak menerima args maupun params (nilainya tidak
+// bisa diteruskan ke client SQL). Manifest lama yang terlanjur berisi params ditolak agar nilai tidak hilang diam-diam.
+func checkRunMode(m manifest, opts RunOptions) error {
+	if !isSQLEntrypoint(m.Entrypoint) {
+		if opts.Transaction {
+			return fmt.Errorf("--transaction hanya berlaku untuk bundle SQL")
+		}
+		if opts.ForceRerun {
+			return fmt.Errorf("--force-rerun hanya berlaku untuk bundle SQL")
+		}
+		return nil
+	}
+	if len(m.Params) > 0 {
+		return fmt.Errorf("bundle SQL tidak mendukung params; encrypt ulang bundle tanpa deklarasi params")
+	}
+	if opts.Profile == nil || strings.TrimSpace(opts.Database) == "" {
+		return fmt.Errorf("bundle SQL wajib dijalankan dengan --profile dan --database")
+	}
+	if len(opts.Args) > 0 {
+		return fmt.Errorf("bundle SQL tidak menerima args")
+	}
+	return nil
+}
+
 // RunBundle decrypts and executes script bundle in temporary directory.
 //
 // Steps:
-//  1. Decrypt bundle to temp dir
-//  2. Extract tar archive
-//  3. Read manifest for entrypoint
-//  4. Execute entrypoint with bash
-//  5. Cleanup temp dir
+//  1. Verify digest + signer (pass 1, tanpa menulis ke disk), lalu cek requirement + parameter
+//  2. Decrypt bundle to temp dir
+//  3. Extract tar archive, setiap file dicocokkan dengan hash hasil verifikasi
+//  4. Read manifest for entrypoint
+//  5. Execute entrypoint with bash (bundle SQL: lewat client database, lihat runSQLBundle)
+//  6. Cleanup temp dir
 //
 // Context dapat digunakan untuk cancellation.
 func RunBundle(ctx context.Context, opts RunOptions) error {
@@ -214,48 +314,13 @@ func RunBundle(ctx context.Context, opts RunOptions) error {
 		}
 	}()
 
-	decReader, err := crypto.NewStreamDecryptor(f, key)
-	if err != nil {
-		return fmt.Errorf("gagal membuat decrypting reader: %w", err)
-	}
-
-	tmpDir, err := os.MkdirTemp("", "sfdbtools-script-*")
+	scan, _, err := verifyBundleFile(f, key, opts.TrustedSigners, opts.AllowUntrusted)
 	if err != nil {
-		return fmt.Errorf("gagal membuat temp dir: %w", err)
-	}
-	defer func() {
-		if rmErr := os.RemoveAll(tmpDir); rmErr != nil {
-			log.Printf("Warning: gagal cleanup temp dir %s: %v", tmpDir, rmErr)
-		}
-	}()
-
-	tr := tar.NewReader(decReader)
-	// P2 #4: Use shared extraction logic dengan zip bomb protection
-	var totalExtracted int64
-	for {
-		hdr, err := tr.Next()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return fmt.Errorf("gagal membaca tar: %w", err)
-		}
-		if err := extractTarEntry(hdr, tr, tmpDir, &totalExtracted); err != nil {
-			return err
-		}
-	}
-
-	manifestPath := filepath.Join(tmpDir, manifestFilename)
-	mb, err := os.ReadFile(manifestPath)
-	if err != nil {
-		return fmt.Errorf("manifest tidak ditemukan dalam bundle")
+		return err
 	}
 
-	var m manifest
-	if err := json.Unmarshal(mb, &m); err != nil {
-		return fmt.Errorf("manifest invalid: %w", err)
-	}
-	if m.Version != bundleVersion {
+	m := scan.Manifest
+	if m.Version < minBundleVersion || m.Version > bundleVersion {
 		return fmt.Errorf("versi bundle tidak didukung: %d", m.Version)
 	}
 	if strings.TrimSpace(m.Entrypoint) == "" {
@@ -274,10 +339,61 @@ func RunBundle(ctx context.Context, opts RunOptions) error {
 		}
 	}
 
+	sqlBundle := isSQLEntrypoint(m.Entrypoint)
+	if err := checkRunMode(m, opts); err != nil {
+		return err
+	}
+
+	// Requirement dan parameter dicek sebelum extract agar bundle yang tidak bisa jalan gagal lebih awal.
+	if err := checkRequirements(m.Requires); err != nil {
+		return err
+	}
+	var paramEnv []string
+	if len(m.Params) > 0 {
+		if err := validateParams(m.Params); err != nil {
+			return fmt.Errorf("manifest params tidak valid: %w", err)
+		}
+		paramEnv, err = resolveParams(m.Params, opts.Args, opts.Interactive)
+		if err != nil {
+			return err
+		}
+		opts.Args = nil
+	} else if opts.PromptArgs && len(opts.Args) == 0 && !sqlBundle {
+		line, err := prompt.AskText("Masukkan args untuk script (opsional, pisahkan dengan spasi; kosongkan jika tidak ada)")
+		if err != nil {
+			return err
+		}
+		opts.Args = strings.Fields(line)
+	}
+
+	decReader, err := newBundleDecryptor(f, key)
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "sfdbtools-script-*")
+	if err != nil {
+		return fmt.Errorf("gagal membuat temp dir: %w", err)
+	}
+	defer func() {
+		if rmErr := os.RemoveAll(tmpDir); rmErr != nil {
+			log.Printf("Warning: gagal cleanup temp dir %s: %v", tmpDir, rmErr)
+		}
+	}()
+
+	// P2 #4: Use shared extraction logic dengan zip bomb protection.
+	// Isi dicocokkan lagi dengan hasil verifikasi (file bisa diganti setelah pass 1).
+	if err := extractVerifiedBundle(decReader, tmpDir, scan); err != nil {
+		return err
+	}
+
 	entry := filepath.Join(tmpDir, filepath.FromSlash(m.Entrypoint))
 	if _, err := os.Stat(entry); err != nil {
 		return fmt.Errorf("entrypoint tidak ditemukan: %w", err)
 	}
+	if sqlBundle {
+		return runSQLBundle(ctx, opts, tmpDir, m.Entrypoint)
+	}
 
 	// P0 #1: Sanitize args untuk prevent command injection
 	for i, arg := range opts.Args {
@@ -286,11 +402,25 @@ func RunBundle(ctx context.Context, opts RunOptions) error {
 		}
 	}
 
+	// Koneksi profile dibuka paling akhir agar tunnel dan defaults-file hanya hidup selama script berjalan.
+	var profileEnv []string
+	if opts.Profile != nil {
+		session, err := openProfileSession(ctx, opts)
+		if err != nil {
+			return err
+		}
+		defer session.Close()
+		profileEnv = session.Env
+	}
+
 	cmdArgs := append([]string{entry}, opts.Args...)
 	// P2 #5: Use detected shell (bash preferred, fallback to sh)
 	shell := detectShell()
 	cmd := exec.CommandContext(ctx, shell, cmdArgs...)
 	cmd.Dir = tmpDir
+	// Parameter dikirim lewat env (SFDB_PARAM_<NAMA>) agar secret tidak muncul di argv/ps;
+	// kunci profile milik sfdbtools tidak ikut diwariskan ke script.
+	cmd.Env = scriptEnv(paramEnv, profileEnv)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
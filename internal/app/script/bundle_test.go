@@ -0,0 +1,64 @@
+package script
+
+import (
+	"strings"
+	"testing"
+
+	"sfdbtools/internal/domain"
+)
+
+func TestManifestVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		m    manifest
+		want int
+	}{
+		{name: "plain shell bundle", m: manifest{Entrypoint: "main.sh"}, want: 1},
+		{name: "signed shell bundle stays v1", m: manifest{Entrypoint: "main.sh", Description: "x", Digest: "sha256:a", Signature: "sig"}, want: 1},
+		{name: "params need v2", m: manifest{Entrypoint: "main.sh", Params: []BundleParam{{}}}, want: 2},
+		{name: "requires need v2", m: manifest{Entrypoint: "main.sh", Requires: &BundleRequirements{}}, want: 2},
+		{name: "sql file needs v3", m: manifest{Entrypoint: "migrate.SQL"}, want: 3},
+		{name: "sql folder needs v3", m: manifest{Entrypoint: sqlDirEntrypoint, Params: []BundleParam{{}}}, want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := manifestVersion(tt.m); got != tt.want {
+				t.Fatalf("manifestVersion = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRunMode(t *testing.T) {
+	profile := &domain.ProfileInfo{Name: "prod-db"}
+	tests := []struct {
+		name    string
+		m       manifest
+		opts    RunOptions
+		wantErr string
+	}{
+		{name: "shell bundle", m: manifest{Entrypoint: "main.sh", Params: []BundleParam{{Name: "tenant"}}}, opts: RunOptions{Args: []string{"tenant=a"}}},
+		{name: "shell bundle rejects transaction", m: manifest{Entrypoint: "main.sh"}, opts: RunOptions{Transaction: true}, wantErr: "--transaction"},
+		{name: "shell bundle rejects force rerun", m: manifest{Entrypoint: "main.sh"}, opts: RunOptions{ForceRerun: true}, wantErr: "--force-rerun"},
+		{name: "sql bundle", m: manifest{Entrypoint: "001.sql"}, opts: RunOptions{Profile: profile, Database: "app", Transaction: true}},
+		{name: "sql bundle rejects params", m: manifest{Entrypoint: sqlDirEntrypoint, Params: []BundleParam{{Name: "tenant"}}}, opts: RunOptions{Profile: profile, Database: "app"}, wantErr: "tidak mendukung params"},
+		{name: "sql bundle needs database", m: manifest{Entrypoint: "001.sql"}, opts: RunOptions{Profile: profile}, wantErr: "--database"},
+		{name: "sql bundle rejects args", m: manifest{Entrypoint: "001.sql"}, opts: RunOptions{Profile: profile, Database: "app", Args: []string{"x"}}, wantErr: "args"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRunMode(tt.m, tt.opts)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkRunMode() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkRunMode() error = %v, want mengandung %q", err, tt.wantErr)
+			}
+		})
+	}
+}
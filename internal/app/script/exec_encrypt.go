@@ -2,7 +2,7 @@
 // Deskripsi : Execute layer untuk encrypt bundle (dengan interactive prompts)
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
@@ -31,7 +31,7 @@ func ExecuteEncryptBundle(logger applog.Logger, cfg *appconfig.Config, opts Encr
 
 	// Interaktif: pilih file jika kosong
 	if strings.TrimSpace(opts.FilePath) == "" {
-		p, err := prompt.SelectFile(".", "Pilih entrypoint script (.sh) yang akan dienkripsi", []string{".sh"})
+		p, err := prompt.SelectFile(".", "Pilih entrypoint script (.sh/.sql) yang akan dienkripsi", []string{".sh", ".sql"})
 		if err != nil {
 			return err
 		}
@@ -45,6 +45,13 @@ func ExecuteEncryptBundle(logger applog.Logger, cfg *appconfig.Config, opts Encr
 	if entryExt != "" {
 		baseName = strings.TrimSuffix(entryBase, entryExt)
 	}
+	// Folder SQL: folder itu sendiri adalah sumber, dan output default diletakkan di sebelahnya.
+	sqlDir := false
+	if st, err := os.Stat(opts.FilePath); err == nil && st.IsDir() {
+		sqlDir = true
+		sourceDir = filepath.Clean(opts.FilePath)
+		baseName = filepath.Base(sourceDir)
+	}
 	defaultOutputName := baseName
 
 	mode := strings.ToLower(strings.TrimSpace(opts.Mode))
@@ -54,6 +61,9 @@ func ExecuteEncryptBundle(logger applog.Logger, cfg *appconfig.Config, opts Encr
 
 	// Tentukan default output directory
 	outDir := sourceDir
+	if sqlDir {
+		outDir = filepath.Dir(sourceDir)
+	}
 	outDirFromConfig := false
 	if cfg != nil {
 		cfgOutDir := strings.TrimSpace(cfg.Script.BundleOutputDir)
@@ -151,6 +161,9 @@ func ExecuteEncryptBundle(logger applog.Logger, cfg *appconfig.Config, opts Encr
 	}
 
 	logger.Infof("✓ Bundle terenkripsi dibuat dari: %s", opts.FilePath)
+	if strings.TrimSpace(opts.SignKey) == "" {
+		logger.Warn("Bundle tidak ditandatangani; script run/extract/info akan menolaknya tanpa --allow-untrusted (gunakan --sign-key)")
+	}
 	if strings.TrimSpace(opts.OutputPath) != "" {
 		logger.Infof("Output: %s", opts.OutputPath)
 	} else {
@@ -2,7 +2,7 @@
 // Deskripsi : Execute layer untuk extract bundle (dengan interactive prompts)
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
@@ -48,6 +48,8 @@ func ExecuteExtractBundle(logger applog.Logger, cfg *appconfig.Config, opts Extr
 		opts.OutDir = strings.TrimSpace(out)
 	}
 
+	opts.TrustedSigners = trustedSignersFromConfig(cfg)
+
 	// Core operation: extract bundle dengan context
 	ctx := context.Background()
 	if err := ExtractBundle(ctx, opts); err != nil {
@@ -2,7 +2,7 @@
 // Deskripsi : Execute layer untuk bundle info (dengan interactive prompts)
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	appconfig "sfdbtools/internal/services/config"
 	applog "sfdbtools/internal/services/log"
+	"sfdbtools/internal/ui/table"
 	"strings"
 )
 
@@ -36,6 +37,8 @@ func ExecuteGetBundleInfo(logger applog.Logger, cfg *appconfig.Config, opts Info
 		opts.FilePath = normalizeSFToolsFlagPath(opts.FilePath, configuredDir)
 	}
 
+	opts.TrustedSigners = trustedSignersFromConfig(cfg)
+
 	// Core operation: get bundle info dengan context
 	ctx := context.Background()
 	info, err := GetBundleInfo(ctx, opts)
@@ -47,16 +50,41 @@ func ExecuteGetBundleInfo(logger applog.Logger, cfg *appconfig.Config, opts Info
 	fmt.Printf("File      : %s\n", opts.FilePath)
 	fmt.Printf("Version   : %d\n", info.Version)
 	fmt.Printf("Mode      : %s\n", info.Mode)
-	fmt.Printf("Entrypoint: %s\n", info.Entrypoint)
+	switch {
+	case info.SQL && info.Entrypoint == sqlDirEntrypoint:
+		fmt.Println("Entrypoint: (folder SQL, file .sql dijalankan berurutan menurut nama)")
+	default:
+		fmt.Printf("Entrypoint: %s\n", info.Entrypoint)
+	}
+	if info.SQL {
+		fmt.Println("Tipe      : SQL (jalankan dengan --profile dan --database)")
+	}
 	if strings.TrimSpace(info.RootDir) != "" {
 		fmt.Printf("Folder    : %s\n", info.RootDir)
 	}
 	fmt.Printf("CreatedAt : %s\n", info.CreatedAt)
 	fmt.Printf("Files     : %d\n", info.FileCount)
+	fmt.Printf("Digest    : %s\n", info.Trust.Digest)
+	switch {
+	case info.Trust.Trusted:
+		fmt.Printf("Signer    : %s (%s) [trusted]\n", info.Trust.SignerName, info.Trust.SignerID)
+	case info.Trust.Signed:
+		fmt.Printf("Signer    : %s [TIDAK DIPERCAYA]\n", info.Trust.SignerID)
+	default:
+		fmt.Println("Signer    : (tidak ditandatangani)")
+	}
+
+	if strings.TrimSpace(info.Description) != "" {
+		fmt.Printf("Deskripsi : %s\n", info.Description)
+	}
+	printBundleRequirements(info.Requires)
+	printBundleParams(info.Params)
 
 	if info.Mode == "bundle" {
 		fmt.Println("Scripts   :")
-		if len(info.Scripts) == 0 {
+		if len(info.Scripts) == 0 && info.SQL {
+			fmt.Println("  (tidak ada file .sql ditemukan)")
+		} else if len(info.Scripts) == 0 {
 			fmt.Println("  (tidak ada file .sh ditemukan)")
 		} else {
 			for _, s := range info.Scripts {
@@ -71,3 +99,41 @@ func ExecuteGetBundleInfo(logger applog.Logger, cfg *appconfig.Config, opts Info
 
 	return nil
 }
+
+// printBundleRequirements menampilkan requirement host bundle (manifest v2).
+func printBundleRequirements(r *BundleRequirements) {
+	if r == nil {
+		return
+	}
+	if len(r.OS) > 0 {
+		fmt.Printf("OS        : %s\n", strings.Join(r.OS, ", "))
+	}
+	if strings.TrimSpace(r.MinVersion) != "" {
+		fmt.Printf("Min versi : sfdbtools >= %s\n", r.MinVersion)
+	}
+	if len(r.Binaries) > 0 {
+		fmt.Printf("Binary    : %s\n", strings.Join(r.Binaries, ", "))
+	}
+}
+
+// printBundleParams menampilkan dokumentasi parameter bundle beserta cara mengisinya.
+func printBundleParams(params []BundleParam) {
+	if len(params) == 0 {
+		return
+	}
+	rows := make([][]string, 0, len(params))
+	for _, p := range params {
+		required := "tidak"
+		if p.Required {
+			required = "ya"
+		}
+		def := p.Default
+		if p.Secret {
+			def = "(secret)"
+		}
+		rows = append(rows, []string{p.Name, p.Type, required, def, p.Description})
+	}
+	fmt.Println("Parameter :")
+	table.Render([]string{"Nama", "Tipe", "Wajib", "Default", "Keterangan"}, rows)
+	fmt.Printf("Isi parameter: script run -f <bundle> -- nama=nilai ... (atau env %s<NAMA>); script menerimanya sebagai env %s<NAMA>\n", paramEnvPrefix, paramEnvPrefix)
+}
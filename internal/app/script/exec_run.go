@@ -2,7 +2,7 @@
 // Deskripsi : Execute layer untuk run bundle (dengan interactive prompts)
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
@@ -10,12 +10,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	profileconn "sfdbtools/internal/app/profile/connection"
 	appconfig "sfdbtools/internal/services/config"
 	applog "sfdbtools/internal/services/log"
 	"sfdbtools/internal/ui/prompt"
 	"sort"
 	"strings"
+	"syscall"
 )
 
 // ExecuteRunBundle handles run workflow dengan interactive prompts.
@@ -32,17 +35,9 @@ func ExecuteRunBundle(logger applog.Logger, cfg *appconfig.Config, opts RunOptio
 		}
 		opts.FilePath = p
 
-		// Interaktif: jika user belum provide args via CLI (-- ...), tanyakan args opsional.
-		if len(opts.Args) == 0 {
-			line, err := prompt.AskText("Masukkan args untuk script (opsional, pisahkan dengan spasi; kosongkan jika tidak ada)")
-			if err != nil {
-				return err
-			}
-			line = strings.TrimSpace(line)
-			if line != "" {
-				opts.Args = strings.Fields(line)
-			}
-		}
+		// Interaktif: jika user belum provide args via CLI (-- ...), args opsional ditanyakan setelah
+		// manifest terbaca (bundle dengan deklarasi parameter memakai prompt per parameter).
+		opts.PromptArgs = len(opts.Args) == 0
 	} else {
 		// Normalize path jika user provide via flag
 		configuredDir := ""
@@ -52,9 +47,40 @@ func ExecuteRunBundle(logger applog.Logger, cfg *appconfig.Config, opts RunOptio
 		opts.FilePath = normalizeSFToolsFlagPath(opts.FilePath, configuredDir)
 	}
 
-	// Core operation: run bundle dengan context
-	ctx := context.Background()
-	return RunBundle(ctx, opts)
+	opts.TrustedSigners = trustedSignersFromConfig(cfg)
+
+	if opts.Profile != nil {
+		if err := resolveRunTicket(&opts); err != nil {
+			logRunAudit(logger, opts, err)
+			return err
+		}
+		opts.ConnectTimeout = profileconn.ProfileConnectTimeout(cfg)
+	}
+
+	// Core operation: run bundle dengan context. Ctrl+C/SIGTERM membatalkan script sehingga
+	// cleanup (temp dir, defaults-file, SSH tunnel) tetap berjalan.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	err := RunBundle(ctx, opts)
+	logRunAudit(logger, opts, err)
+	return err
+}
+
+// resolveRunTicket memastikan ticket terisi saat script run memakai --profile (script akan menyentuh
+// database); di mode non-interaktif ticket harus lewat --ticket.
+func resolveRunTicket(opts *RunOptions) error {
+	if strings.TrimSpace(opts.Ticket) != "" {
+		return nil
+	}
+	if !opts.Interactive {
+		return fmt.Errorf("ticket number wajib diisi (--ticket) saat script run memakai --profile pada mode non-interaktif")
+	}
+	t, err := prompt.AskTicket("script run")
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan ticket number: %w", err)
+	}
+	opts.Ticket = strings.TrimSpace(t)
+	return nil
 }
 
 // normalizeSFToolsFlagPath normalizes file path dengan auto-append .sftools dan config dir resolution.
@@ -147,3 +173,11 @@ func listSFToolsFiles(dir string) ([]string, error) {
 	sort.Strings(files)
 	return files, nil
 }
+
+// trustedSignersFromConfig mengambil daftar signer terpercaya dari config (script.trusted_signers).
+func trustedSignersFromConfig(cfg *appconfig.Config) []appconfig.ScriptTrustedSigner {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Script.TrustedSigners
+}
@@ -2,16 +2,14 @@
 // Deskripsi : Bundle extraction untuk encrypted script bundles
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
 import (
-	"archive/tar"
 	"context"
 	"crypto/subtle"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -26,6 +24,7 @@ import (
 //
 // Security:
 //   - Requires encryption key + application password
+//   - Bundle harus ditandatangani signer terpercaya (kecuali AllowUntrusted)
 //   - Output directory must be empty
 //   - Path traversal protection via safeTarPath()
 //
@@ -58,6 +57,21 @@ func ExtractBundle(ctx context.Context, opts ExtractOptions) error {
 		return fmt.Errorf("gagal mendapatkan encryption key: %w", err)
 	}
 
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return fmt.Errorf("gagal membuka .sftools: %w", err)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			log.Printf("Warning: gagal menutup bundle file: %v", closeErr)
+		}
+	}()
+
+	scan, _, err := verifyBundleFile(f, key, opts.TrustedSigners, opts.AllowUntrusted)
+	if err != nil {
+		return err
+	}
+
 	// Proteksi ganda: setelah key, wajib password aplikasi.
 	password, err := prompt.AskPassword("Masukkan password aplikasi untuk melanjutkan extract:", nil)
 	if err != nil {
@@ -74,35 +88,15 @@ func ExtractBundle(ctx context.Context, opts ExtractOptions) error {
 		return err
 	}
 
-	f, err := os.Open(bundlePath)
+	decReader, err := newBundleDecryptor(f, key)
 	if err != nil {
-		return fmt.Errorf("gagal membuka .sftools: %w", err)
-	}
-	defer func() {
-		if closeErr := f.Close(); closeErr != nil {
-			log.Printf("Warning: gagal menutup bundle file: %v", closeErr)
-		}
-	}()
-
-	decReader, err := crypto.NewStreamDecryptor(f, key)
-	if err != nil {
-		return fmt.Errorf("gagal membuat decrypting reader: %w", err)
+		return err
 	}
 
-	tr := tar.NewReader(decReader)
-	// P2 #4: Use shared extraction logic dengan zip bomb protection
-	var totalExtracted int64
-	for {
-		hdr, err := tr.Next()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return fmt.Errorf("gagal membaca tar: %w", err)
-		}
-		if err := extractTarEntry(hdr, tr, outDir, &totalExtracted); err != nil {
-			return err
-		}
+	// P2 #4: Use shared extraction logic dengan zip bomb protection.
+	// Isi dicocokkan lagi dengan hasil verifikasi (file bisa diganti setelah pass 1).
+	if err := extractVerifiedBundle(decReader, outDir, scan); err != nil {
+		return err
 	}
 
 	return nil
@@ -2,7 +2,7 @@
 // Deskripsi : Helper utilities untuk script operations
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
@@ -16,14 +16,15 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"sfdbtools/internal/crypto"
 	"strings"
 )
 
 // defaultBundleOutputPath generates default output path for bundle.
-// Converts "script.sh" → "script.sftools"
+// Converts "script.sh" → "script.sftools", "fix.sql" → "fix.sftools", folder "migrasi" → "migrasi.sftools"
 func defaultBundleOutputPath(entryPath string) string {
 	ext := strings.ToLower(filepath.Ext(entryPath))
-	if ext == ".sh" {
+	if ext == ".sh" || ext == ".sql" {
 		return strings.TrimSuffix(entryPath, filepath.Ext(entryPath)) + ".sftools"
 	}
 	return entryPath + ".sftools"
@@ -98,7 +99,7 @@ func addFileToTar(tw *tar.Writer, srcPath string, tarName string, mode int64) er
 
 // extractTarEntry extracts single tar entry dengan zip bomb protection.
 // Digunakan oleh RunBundle dan ExtractBundle untuk DRY.
-func extractTarEntry(hdr *tar.Header, tr *tar.Reader, baseDir string, totalExtracted *int64) error {
+func extractTarEntry(hdr *tar.Header, r io.Reader, baseDir string, totalExtracted *int64) error {
 	// P2 #4: Centralized zip bomb protection
 	if hdr.Size > MaxFileSize {
 		return fmt.Errorf("file terlalu besar: %s (%d bytes, max %d)", hdr.Name, hdr.Size, MaxFileSize)
@@ -127,7 +128,7 @@ func extractTarEntry(hdr *tar.Header, tr *tar.Reader, baseDir string, totalExtra
 		if err != nil {
 			return fmt.Errorf("gagal membuat file extract: %w", err)
 		}
-		if _, err := io.Copy(wf, tr); err != nil {
+		if _, err := io.Copy(wf, r); err != nil {
 			_ = wf.Close()
 			return fmt.Errorf("gagal menulis file extract: %w", err)
 		}
@@ -170,6 +171,15 @@ func safeTarPath(name string) (string, error) {
 	return clean, nil
 }
 
+// newBundleDecryptor membuat decrypting reader untuk isi bundle .sftools.
+func newBundleDecryptor(r io.Reader, key string) (io.Reader, error) {
+	decReader, err := crypto.NewStreamDecryptor(r, key)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membuat decrypting reader: %w", err)
+	}
+	return decReader, nil
+}
+
 // validateBundleExtension checks if file has valid .sftools extension.
 func validateBundleExtension(filePath string) error {
 	if !strings.HasSuffix(strings.ToLower(filePath), BundleExtension) {
@@ -2,16 +2,13 @@
 // Deskripsi : Bundle metadata inspection
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
 import (
-	"archive/tar"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -26,7 +23,8 @@ import (
 //
 // Reads without extracting:
 //   - Manifest (version, entrypoint, created_at, mode)
-//   - File count and list of .sh scripts
+//   - File count and list of .sh scripts (.sql untuk bundle SQL)
+//   - Digest isi bundle dan signer (ditolak jika tidak terpercaya, kecuali AllowUntrusted)
 //
 // Does NOT require application password (read-only operation).
 // Context dapat digunakan untuk cancellation.
@@ -61,67 +59,35 @@ func GetBundleInfo(ctx context.Context, opts InfoOptions) (BundleInfo, error) {
 		}
 	}()
 
-	decReader, err := crypto.NewStreamDecryptor(f, key)
+	scan, trust, err := readBundleTrust(f, key, opts.TrustedSigners)
 	if err != nil {
-		return BundleInfo{}, fmt.Errorf("gagal membuat decrypting reader: %w", err)
+		return BundleInfo{}, err
 	}
-
-	// Scan seluruh tar: ambil manifest + list script .sh tanpa harus extract.
-	type manifestLite struct {
-		Version    int    `json:"version"`
-		Entrypoint string `json:"entrypoint"`
-		CreatedAt  string `json:"created_at"`
-		Mode       string `json:"mode"`
-		RootDir    string `json:"root_dir"`
+	if err := enforceTrust(trust, opts.AllowUntrusted); err != nil {
+		return BundleInfo{}, err
 	}
 
-	var m manifestLite
-	manifestFound := false
-	fileCount := 0
-	var regFiles []string
+	m := scan.Manifest
+	regFiles := scan.Files
+	fileCount := len(regFiles)
+	// Bundle SQL menampilkan file .sql yang dijalankan (folder SQL: hanya level teratas); selain itu file .sh.
+	sqlBundle := isSQLEntrypoint(m.Entrypoint)
 	var scripts []string
-
-	tr := tar.NewReader(decReader)
-	for {
-		hdr, err := tr.Next()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return BundleInfo{}, fmt.Errorf("gagal membaca tar: %w", err)
-		}
-		cleanName, err := safeTarPath(hdr.Name)
-		if err != nil {
-			return BundleInfo{}, err
-		}
-
-		if hdr.Typeflag != tar.TypeReg {
-			continue
-		}
-
-		if cleanName == manifestFilename {
-			b, err := io.ReadAll(tr)
-			if err != nil {
-				return BundleInfo{}, fmt.Errorf("gagal membaca manifest: %w", err)
+	for _, name := range regFiles {
+		switch {
+		case sqlBundle && m.Entrypoint == sqlDirEntrypoint:
+			if strings.EqualFold(filepath.Ext(name), ".sql") && !strings.Contains(name, "/") {
+				scripts = append(scripts, name)
 			}
-			if err := json.Unmarshal(b, &m); err != nil {
-				return BundleInfo{}, fmt.Errorf("manifest invalid: %w", err)
+		case sqlBundle:
+			if name == m.Entrypoint {
+				scripts = append(scripts, name)
 			}
-			manifestFound = true
-			continue
-		}
-
-		fileCount++
-		regFiles = append(regFiles, cleanName)
-		if strings.EqualFold(filepath.Ext(cleanName), ".sh") {
-			scripts = append(scripts, cleanName)
+		case strings.EqualFold(filepath.Ext(name), ".sh"):
+			scripts = append(scripts, name)
 		}
 	}
 
-	if !manifestFound {
-		return BundleInfo{}, fmt.Errorf("manifest tidak ditemukan dalam bundle")
-	}
-
 	mode := strings.ToLower(strings.TrimSpace(m.Mode))
 	if mode == "" {
 		// Backward-compat: infer mode dari jumlah file.
@@ -149,5 +115,11 @@ func GetBundleInfo(ctx context.Context, opts InfoOptions) (BundleInfo, error) {
 		RootDir:    rootDir,
 		Scripts:    scripts,
 		FileCount:  fileCount,
+		Trust:      trust,
+		SQL:        sqlBundle,
+
+		Description: m.Description,
+		Params:      m.Params,
+		Requires:    m.Requires,
 	}, nil
 }
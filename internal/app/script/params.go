@@ -0,0 +1,389 @@
+// File : internal/app/script/params.go
+// Deskripsi : Deklarasi parameter dan requirement bundle (manifest v2): spec, validasi, prompt, dan env script
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package script
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sfdbtools/internal/app/version"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/shared/envx"
+	"sfdbtools/internal/ui/prompt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Tipe parameter bundle.
+const (
+	ParamTypeString = "string"
+	ParamTypeInt    = "int"
+	ParamTypeBool   = "bool"
+)
+
+// paramEnvPrefix adalah prefix env yang diterima script untuk setiap parameter (SFDB_PARAM_<NAMA>).
+const paramEnvPrefix = "SFDB_PARAM_"
+
+var paramNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// BundleParam adalah satu parameter yang dideklarasikan bundle.
+type BundleParam struct {
+	Name        string `yaml:"name" json:"name"`
+	Type        string `yaml:"type" json:"type,omitempty"`
+	Required    bool   `yaml:"required" json:"required,omitempty"`
+	Default     string `yaml:"default" json:"default,omitempty"`
+	Secret      bool   `yaml:"secret" json:"secret,omitempty"`
+	Description string `yaml:"description" json:"description,omitempty"`
+}
+
+// BundleRequirements adalah syarat host untuk menjalankan bundle.
+type BundleRequirements struct {
+	Binaries   []string `yaml:"binaries" json:"binaries,omitempty"`
+	MinVersion string   `yaml:"min_sfdbtools_version" json:"min_sfdbtools_version,omitempty"`
+	OS         []string `yaml:"os" json:"os,omitempty"`
+}
+
+// bundleSpec adalah isi file spec (default sftools.yaml di folder entrypoint) yang dibaca saat encrypt.
+//
+//	description: Perbaikan saldo tenant
+//	params:
+//	  - {name: tenant, type: string, required: true, description: Kode tenant}
+//	  - {name: db_password, secret: true}
+//	requires:
+//	  binaries: [mysql, jq]
+//	  min_sfdbtools_version: 1.6.0
+//	  os: [linux]
+type bundleSpec struct {
+	Description string              `yaml:"description"`
+	Params      []BundleParam       `yaml:"params"`
+	Requires    *BundleRequirements `yaml:"requires"`
+}
+
+// loadBundleSpec membaca dan memvalidasi file spec; field yang tidak dikenal ditolak.
+func loadBundleSpec(path string) (*bundleSpec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca spec bundle: %w", err)
+	}
+	spec := &bundleSpec{}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(spec); err != nil {
+		return nil, fmt.Errorf("spec bundle %s tidak valid: %w", path, err)
+	}
+	if err := validateParams(spec.Params); err != nil {
+		return nil, fmt.Errorf("spec bundle %s: %w", path, err)
+	}
+	if r := spec.Requires; r != nil {
+		if v := strings.TrimSpace(r.MinVersion); v != "" {
+			if _, ok := parseVersionTriplet(v); !ok {
+				return nil, fmt.Errorf("spec bundle %s: min_sfdbtools_version tidak valid: %s (format: 1.2.3)", path, v)
+			}
+		}
+		for i, o := range r.OS {
+			r.OS[i] = strings.ToLower(strings.TrimSpace(o))
+		}
+	}
+	return spec, nil
+}
+
+// validateParams memeriksa nama unik, tipe, dan default setiap parameter (tipe kosong = string).
+func validateParams(params []BundleParam) error {
+	seen := map[string]bool{}
+	for i := range params {
+		p := &params[i]
+		p.Name = strings.TrimSpace(p.Name)
+		if !paramNamePattern.MatchString(p.Name) {
+			return fmt.Errorf("nama parameter tidak valid: %q (huruf, angka, underscore)", p.Name)
+		}
+		key := strings.ToLower(p.Name)
+		if seen[key] {
+			return fmt.Errorf("parameter %s dideklarasikan lebih dari sekali", p.Name)
+		}
+		seen[key] = true
+
+		p.Type = strings.ToLower(strings.TrimSpace(p.Type))
+		if p.Type == "" {
+			p.Type = ParamTypeString
+		}
+		switch p.Type {
+		case ParamTypeString, ParamTypeInt, ParamTypeBool:
+		default:
+			return fmt.Errorf("parameter %s: tipe %q tidak didukung (string|int|bool)", p.Name, p.Type)
+		}
+		if p.Secret && p.Default != "" {
+			return fmt.Errorf("parameter %s: parameter secret tidak boleh punya default (tersimpan di bundle)", p.Name)
+		}
+		if p.Default != "" {
+			v, err := normalizeParamValue(*p, p.Default)
+			if err != nil {
+				return fmt.Errorf("default %w", err)
+			}
+			p.Default = v
+		}
+	}
+	return nil
+}
+
+// normalizeParamValue memvalidasi nilai sesuai tipe parameter (bool dinormalisasi ke true/false).
+func normalizeParamValue(p BundleParam, v string) (string, error) {
+	switch p.Type {
+	case ParamTypeInt:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return "", fmt.Errorf("parameter %s harus bilangan bulat: %q", p.Name, v)
+		}
+		return strconv.Itoa(n), nil
+	case ParamTypeBool:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return "", fmt.Errorf("parameter %s harus true/false: %q", p.Name, v)
+		}
+		return strconv.FormatBool(b), nil
+	default:
+		return v, nil
+	}
+}
+
+// paramEnvName adalah nama env yang berisi nilai parameter untuk script.
+func paramEnvName(name string) string {
+	return paramEnvPrefix + strings.ToUpper(name)
+}
+
+// parseParamArgs memetakan args CLI (name=value, --name=value, atau --flag untuk bool) ke parameter.
+func parseParamArgs(params []BundleParam, args []string) (map[string]string, error) {
+	byName := make(map[string]BundleParam, len(params))
+	names := make([]string, 0, len(params))
+	for _, p := range params {
+		byName[strings.ToLower(p.Name)] = p
+		names = append(names, p.Name)
+	}
+
+	values := map[string]string{}
+	for _, a := range args {
+		s := strings.TrimPrefix(a, "--")
+		name, v, hasValue := strings.Cut(s, "=")
+		p, ok := byName[strings.ToLower(name)]
+		if !ok {
+			if !hasValue && !strings.HasPrefix(a, "--") {
+				return nil, fmt.Errorf("bundle mendeklarasikan parameter; gunakan format nama=nilai (argumen: %q)", a)
+			}
+			return nil, fmt.Errorf("parameter tidak dikenal: %s (parameter bundle: %s)", name, strings.Join(names, ", "))
+		}
+		if !hasValue {
+			if p.Type != ParamTypeBool || !strings.HasPrefix(a, "--") {
+				return nil, fmt.Errorf("parameter %s membutuhkan nilai (%s=<nilai>)", p.Name, p.Name)
+			}
+			v = "true"
+		}
+		nv, err := normalizeParamValue(p, v)
+		if err != nil {
+			return nil, err
+		}
+		values[p.Name] = nv
+	}
+	return values, nil
+}
+
+// resolveParams menentukan nilai setiap parameter: argumen CLI, lalu env SFDB_PARAM_<NAMA> (cocok untuk
+// secret di automation), lalu default, lalu prompt (mode interaktif). Parameter secret boleh berupa
+// referensi secret (vault://, file://, cmd://). Hasilnya berupa entry env untuk proses script.
+func resolveParams(params []BundleParam, args []string, interactive bool) ([]string, error) {
+	values, err := parseParamArgs(params, args)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make([]string, 0, len(params))
+	var missing []string
+	for _, p := range params {
+		v, ok := values[p.Name]
+		if !ok {
+			if ev, set := os.LookupEnv(paramEnvName(p.Name)); set {
+				if v, err = normalizeParamValue(p, ev); err != nil {
+					return nil, fmt.Errorf("env %s: %w", paramEnvName(p.Name), err)
+				}
+				ok = true
+			}
+		}
+		if !ok && interactive && (p.Required || p.Default == "") {
+			if v, err = promptParam(p); err != nil {
+				return nil, err
+			}
+			ok = v != "" || !p.Required
+		}
+		if !ok && p.Default != "" {
+			v, ok = p.Default, true
+		}
+		if !ok || (p.Required && v == "") {
+			if p.Required {
+				missing = append(missing, p.Name)
+			}
+			continue
+		}
+		if p.Secret && crypto.IsSecretReference(v) {
+			resolved, err := crypto.ResolveSecret(v)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", p.Name, err)
+			}
+			v = resolved
+		}
+		env = append(env, paramEnvName(p.Name)+"="+v)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("parameter wajib belum diisi: %s (gunakan nama=nilai setelah -- atau env %s<NAMA>)", strings.Join(missing, ", "), paramEnvPrefix)
+	}
+	return env, nil
+}
+
+// promptParam meminta nilai parameter sesuai tipenya (secret = input tersembunyi).
+func promptParam(p BundleParam) (string, error) {
+	label := p.Name
+	if strings.TrimSpace(p.Description) != "" {
+		label = fmt.Sprintf("%s (%s)", p.Name, p.Description)
+	}
+	if !p.Required {
+		label += " [opsional]"
+	}
+
+	switch {
+	case p.Secret:
+		v, err := prompt.AskPassword(label+":", nil)
+		if err != nil {
+			return "", err
+		}
+		if p.Required && strings.TrimSpace(v) == "" {
+			return "", fmt.Errorf("parameter %s wajib diisi", p.Name)
+		}
+		return v, nil
+	case p.Type == ParamTypeBool:
+		def, _ := strconv.ParseBool(p.Default)
+		b, err := prompt.Confirm(label, def)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatBool(b), nil
+	default:
+		opts := []prompt.TextOption{prompt.WithValidator(func(ans interface{}) error {
+			s, _ := ans.(string)
+			if strings.TrimSpace(s) == "" {
+				if p.Required {
+					return fmt.Errorf("parameter %s wajib diisi", p.Name)
+				}
+				return nil
+			}
+			_, err := normalizeParamValue(p, s)
+			return err
+		})}
+		if p.Default != "" {
+			opts = append(opts, prompt.WithDefault(p.Default))
+		}
+		v, err := prompt.AskText(label, opts...)
+		if err != nil {
+			return "", err
+		}
+		if strings.TrimSpace(v) == "" {
+			return "", nil
+		}
+		return normalizeParamValue(p, v)
+	}
+}
+
+// checkRequirements memastikan host memenuhi requirement bundle (OS, versi sfdbtools, binary).
+func checkRequirements(r *BundleRequirements) error {
+	if r == nil {
+		return nil
+	}
+	if len(r.OS) > 0 {
+		matched := false
+		for _, o := range r.OS {
+			if o == runtime.GOOS {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("bundle hanya untuk OS %s (host: %s)", strings.Join(r.OS, ", "), runtime.GOOS)
+		}
+	}
+	if minV := strings.TrimSpace(r.MinVersion); minV != "" {
+		want, _ := parseVersionTriplet(minV)
+		have, ok := parseVersionTriplet(version.Version)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Warning: versi sfdbtools %q bukan semver; cek min_sfdbtools_version %s dilewati\n", version.Version, minV)
+		} else if compareVersionTriplet(have, want) < 0 {
+			return fmt.Errorf("bundle membutuhkan sfdbtools >= %s (terpasang: %s)", minV, version.Version)
+		}
+	}
+	var missing []string
+	for _, bin := range r.Binaries {
+		if _, err := exec.LookPath(bin); err != nil {
+			missing = append(missing, bin)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("binary yang dibutuhkan bundle tidak ditemukan di PATH: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// parseVersionTriplet mem-parse "v1.2.3" (suffix -dirty/+meta diabaikan).
+func parseVersionTriplet(v string) ([3]int, bool) {
+	var out [3]int
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+ "); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return out, false
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return out, false
+		}
+		out[i] = n
+	}
+	return out, true
+}
+
+func compareVersionTriplet(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] > b[i] {
+				return 1
+			}
+			return -1
+		}
+	}
+	return 0
+}
+
+// resolveBundleSpec memuat file spec: path eksplisit (--spec) wajib ada, sedangkan sftools.yaml di
+// folder entrypoint bersifat opsional. Mengembalikan path kosong jika tidak ada spec.
+func resolveBundleSpec(specPath, rootDir string) (string, *bundleSpec, error) {
+	specPath = strings.TrimSpace(specPath)
+	if specPath == "" {
+		candidate := filepath.Join(rootDir, bundleSpecFilename)
+		if _, err := os.Stat(candidate); err != nil {
+			return "", nil, nil
+		}
+		specPath = candidate
+	}
+	spec, err := loadBundleSpec(envx.ExpandPath(specPath))
+	if err != nil {
+		return "", nil, err
+	}
+	return specPath, spec, nil
+}
@@ -0,0 +1,230 @@
+package script
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"sfdbtools/internal/app/version"
+)
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  []BundleParam
+		want    []BundleParam
+		wantErr string
+	}{
+		{
+			name:   "defaults normalized",
+			params: []BundleParam{{Name: " tenant "}, {Name: "limit", Type: "INT", Default: " 007"}, {Name: "dry_run", Type: "bool", Default: "1"}},
+			want: []BundleParam{
+				{Name: "tenant", Type: ParamTypeString},
+				{Name: "limit", Type: ParamTypeInt, Default: "7"},
+				{Name: "dry_run", Type: ParamTypeBool, Default: "true"},
+			},
+		},
+		{name: "invalid name", params: []BundleParam{{Name: "1tenant"}}, wantErr: "nama parameter tidak valid"},
+		{name: "duplicate name", params: []BundleParam{{Name: "Tenant"}, {Name: "tenant"}}, wantErr: "lebih dari sekali"},
+		{name: "unknown type", params: []BundleParam{{Name: "a", Type: "float"}}, wantErr: "tidak didukung"},
+		{name: "secret with default", params: []BundleParam{{Name: "pw", Secret: true, Default: "x"}}, wantErr: "secret tidak boleh punya default"},
+		{name: "bad int default", params: []BundleParam{{Name: "n", Type: ParamTypeInt, Default: "abc"}}, wantErr: "bilangan bulat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateParams(tt.params)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("validateParams() error = %v, want mengandung %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateParams() error = %v", err)
+			}
+			if !slices.Equal(tt.params, tt.want) {
+				t.Fatalf("validateParams() = %+v, want %+v", tt.params, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseParamArgs(t *testing.T) {
+	params := []BundleParam{
+		{Name: "tenant", Type: ParamTypeString},
+		{Name: "limit", Type: ParamTypeInt},
+		{Name: "dry_run", Type: ParamTypeBool},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "name=value, --name=value and bool flag",
+			args: []string{"tenant=acme", "--LIMIT=10", "--dry_run"},
+			want: map[string]string{"tenant": "acme", "limit": "10", "dry_run": "true"},
+		},
+		{name: "value with equals sign", args: []string{"tenant=a=b"}, want: map[string]string{"tenant": "a=b"}},
+		{name: "positional argument", args: []string{"acme"}, wantErr: "format nama=nilai"},
+		{name: "unknown parameter", args: []string{"--region=eu"}, wantErr: "parameter tidak dikenal"},
+		{name: "missing value", args: []string{"--tenant"}, wantErr: "membutuhkan nilai"},
+		{name: "bool without dashes", args: []string{"dry_run"}, wantErr: "membutuhkan nilai"},
+		{name: "bad int", args: []string{"limit=ten"}, wantErr: "bilangan bulat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseParamArgs(params, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseParamArgs() error = %v, want mengandung %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseParamArgs() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseParamArgs() = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Fatalf("parseParamArgs()[%s] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveParams(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "db_password")
+	if err := os.WriteFile(secretFile, []byte("rahasia"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	params := []BundleParam{
+		{Name: "tenant", Type: ParamTypeString, Required: true},
+		{Name: "limit", Type: ParamTypeInt, Default: "100"},
+		{Name: "region", Type: ParamTypeString, Default: "id"},
+		{Name: "db_password", Type: ParamTypeString, Secret: true},
+		{Name: "note", Type: ParamTypeString},
+	}
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		args    []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "argument beats env and env beats default",
+			env:  map[string]string{"SFDB_PARAM_TENANT": "from-env", "SFDB_PARAM_LIMIT": "5"},
+			args: []string{"tenant=from-arg"},
+			want: []string{"SFDB_PARAM_TENANT=from-arg", "SFDB_PARAM_LIMIT=5", "SFDB_PARAM_REGION=id"},
+		},
+		{
+			name: "secret reference is resolved",
+			args: []string{"tenant=acme", "db_password=file://" + secretFile},
+			want: []string{"SFDB_PARAM_TENANT=acme", "SFDB_PARAM_LIMIT=100", "SFDB_PARAM_REGION=id", "SFDB_PARAM_DB_PASSWORD=rahasia"},
+		},
+		{name: "required missing without prompt", wantErr: "parameter wajib belum diisi: tenant"},
+		{name: "invalid env value", env: map[string]string{"SFDB_PARAM_LIMIT": "x"}, args: []string{"tenant=a"}, wantErr: "env SFDB_PARAM_LIMIT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, p := range params {
+				t.Setenv(paramEnvName(p.Name), "")
+				os.Unsetenv(paramEnvName(p.Name))
+			}
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			got, err := resolveParams(params, tt.args, false)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolveParams() error = %v, want mengandung %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveParams() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("resolveParams() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRequirements(t *testing.T) {
+	orig := version.Version
+	t.Cleanup(func() { version.Version = orig })
+	version.Version = "v1.6.2-dirty"
+
+	tests := []struct {
+		name    string
+		req     *BundleRequirements
+		wantErr string
+	}{
+		{name: "nil", req: nil},
+		{name: "satisfied", req: &BundleRequirements{OS: []string{"plan9", runtime.GOOS}, MinVersion: "1.6.0", Binaries: []string{"sh"}}},
+		{name: "wrong os", req: &BundleRequirements{OS: []string{"plan9"}}, wantErr: "hanya untuk OS plan9"},
+		{name: "version too old", req: &BundleRequirements{MinVersion: "1.10.0"}, wantErr: "sfdbtools >= 1.10.0"},
+		{name: "missing binary", req: &BundleRequirements{Binaries: []string{"sh", "sfdb-binary-yang-tidak-ada"}}, wantErr: "sfdb-binary-yang-tidak-ada"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRequirements(tt.req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkRequirements() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkRequirements() error = %v, want mengandung %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVersionTriplet(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		want   int
+		wantOK bool
+	}{
+		{a: "v1.2.3", b: "1.2.3", want: 0, wantOK: true},
+		{a: "1.10.0", b: "1.9.9", want: 1, wantOK: true},
+		{a: "1.2.3-rc1+meta", b: "1.2.4", want: -1, wantOK: true},
+		{a: "2.0.0", b: "10.0.0", want: -1, wantOK: true},
+		{a: "1.2", b: "1.2.0"},
+		{a: "dev", b: "1.0.0"},
+		{a: "1.x.0", b: "1.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			a, okA := parseVersionTriplet(tt.a)
+			b, okB := parseVersionTriplet(tt.b)
+			if okA != tt.wantOK || (tt.wantOK && !okB) {
+				t.Fatalf("parseVersionTriplet(%q) ok = %v, want %v", tt.a, okA, tt.wantOK)
+			}
+			if !tt.wantOK {
+				return
+			}
+			if got := compareVersionTriplet(a, b); got != tt.want {
+				t.Fatalf("compareVersionTriplet(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// File : internal/app/script/profile_env.go
+// Deskripsi : Koneksi profile database untuk script run --profile (SSH tunnel, env SFDB_DB_*, defaults-file sementara)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package script
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/app/profile/process"
+	"sfdbtools/internal/crypto"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
+	"sfdbtools/internal/shared/execx"
+)
+
+// scrubbedEnvKeys adalah env yang tidak boleh diwarisi proses script: kunci sfdbtools (profile, bundle,
+// enkripsi, passphrase SSH/signing), password database, variabel koneksi yang diset ulang oleh
+// profileSession, dan env provider secret (VAULT_TOKEN, dsb.) agar referensi vault:// tidak bisa dibaca script.
+var scrubbedEnvKeys = append([]string{
+	consts.ENV_SOURCE_PROFILE_KEY,
+	consts.ENV_TARGET_PROFILE_KEY,
+	consts.ENV_SCRIPT_KEY,
+	consts.ENV_SCRIPT_SIGN_KEY_PASSPHRASE,
+	consts.ENV_ENCRYPTION_KEY,
+	consts.ENV_BACKUP_ENCRYPTION_KEY,
+	consts.ENV_SSH_KEY_PASSPHRASE,
+	consts.ENV_SOURCE_DB_PASSWORD,
+	consts.ENV_TARGET_DB_PASSWORD,
+	consts.ENV_DB_HOST,
+	consts.ENV_DB_PORT,
+	consts.ENV_DB_USER,
+	consts.ENV_DB_PASSWORD,
+	consts.ENV_DB_NAME,
+	consts.ENV_DB_SOCKET,
+	consts.ENV_DB_DEFAULTS_FILE,
+	consts.ENV_SCRIPT_PROFILE,
+	consts.ENV_SCRIPT_TICKET,
+}, crypto.SecretProviderEnvKeys()...)
+
+// profileSession adalah koneksi profile yang hidup selama satu kali run script.
+type profileSession struct {
+	Env          []string
+	tunnel       *process.SSHTunnel
+	defaultsFile string
+}
+
+// openProfileSession membuka SSH tunnel (jika profile memakainya) lalu menulis defaults-file 0600
+// berisi kredensial koneksi. Script menerima host/port/user/database lewat env dan password hanya
+// lewat defaults-file; kunci profile tidak pernah diteruskan. Close wajib dipanggil setelah script selesai.
+func openProfileSession(ctx context.Context, opts RunOptions) (*profileSession, error) {
+	profile := opts.Profile
+	if err := profileconn.ValidateConnectPreflight(profile); err != nil {
+		return nil, err
+	}
+	// Script dan bundle SQL memakai client CLI lewat defaults-file.
+	if err := database.ValidateClientTLSTunnel(profile.DBInfo.TLS, profile.SSHTunnel.Enabled, isMariaDBClient()); err != nil {
+		return nil, err
+	}
+
+	s := &profileSession{}
+	if profile.SSHTunnel.Enabled {
+		timeout := opts.ConnectTimeout
+		if timeout <= 0 {
+			timeout = 15 * time.Second
+		}
+		tunnelOpts, err := profileconn.BuildSSHTunnelOptions(profile, timeout)
+		if err != nil {
+			return nil, err
+		}
+		tctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		t, err := process.StartSSHTunnel(tctx, tunnelOpts)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membuat SSH tunnel ke %s: %w", tunnelOpts.SSHHost, err)
+		}
+		s.tunnel = t
+		profile.SSHTunnel.ResolvedLocalPort = t.LocalPort
+	}
+
+	info := profileconn.EffectiveDBInfo(profile)
+	path, err := writeDefaultsFile(info, profile.DBInfo.Password, opts.Database)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	s.defaultsFile = path
+
+	s.Env = []string{
+		consts.ENV_DB_USER + "=" + info.User,
+		consts.ENV_DB_NAME + "=" + opts.Database,
+		consts.ENV_DB_DEFAULTS_FILE + "=" + path,
+		consts.ENV_SCRIPT_PROFILE + "=" + profile.Name,
+		consts.ENV_SCRIPT_TICKET + "=" + opts.Ticket,
+	}
+	if strings.TrimSpace(info.Socket) != "" {
+		s.Env = append(s.Env, consts.ENV_DB_SOCKET+"="+info.Socket)
+	} else {
+		s.Env = append(s.Env, consts.ENV_DB_HOST+"="+info.Host, consts.ENV_DB_PORT+"="+strconv.Itoa(info.Port))
+	}
+	return s, nil
+}
+
+// Close menghapus defaults-file dan menutup SSH tunnel. Aman dipanggil berulang.
+func (s *profileSession) Close() {
+	if s == nil {
+		return
+	}
+	if s.defaultsFile != "" {
+		if err := os.Remove(s.defaultsFile); err != nil && !os.IsNotExist(err) {
+			log.Printf("Warning: gagal menghapus defaults-file %s: %v", s.defaultsFile, err)
+		}
+		s.defaultsFile = ""
+	}
+	if s.tunnel != nil {
+		if err := s.tunnel.Stop(context.Background()); err != nil {
+			log.Printf("Warning: gagal menutup SSH tunnel: %v", err)
+		}
+		s.tunnel = nil
+	}
+}
+
+// writeDefaultsFile menulis option file client ([client] + [mysql] untuk database default)
+// dengan permission 0600 di temp dir sistem, di luar folder bundle.
+func writeDefaultsFile(info domain.DBInfo, password, dbName string) (string, error) {
+	var b strings.Builder
+	b.WriteString("[client]\n")
+	if strings.TrimSpace(info.Socket) != "" {
+		fmt.Fprintf(&b, "socket=%s\n", optionFileValue(info.Socket))
+	} else {
+		fmt.Fprintf(&b, "host=%s\n", optionFileValue(info.Host))
+		fmt.Fprintf(&b, "port=%d\n", info.Port)
+	}
+	fmt.Fprintf(&b, "user=%s\n", optionFileValue(info.User))
+	// Socket tanpa password (auth unix_socket) tidak menulis password. Koneksi TCP selalu menulisnya (juga saat
+	// kosong) agar file ini, saat dipakai sebagai --defaults-extra-file, tidak memakai password ~/.my.cnf.
+	if password != "" || strings.TrimSpace(info.Socket) == "" {
+		fmt.Fprintf(&b, "password=%s\n", optionFileValue(password))
+	}
+	for _, opt := range clientTLSOptions(info.TLS) {
+		b.WriteString(opt + "\n")
+	}
+	// "database" hanya dikenal client mysql/mariadb; di [client] opsi ini membuat mysqldump/mysqladmin gagal.
+	if strings.TrimSpace(dbName) != "" {
+		fmt.Fprintf(&b, "\n[mysql]\ndatabase=%s\n", optionFileValue(dbName))
+	}
+
+	f, err := os.CreateTemp("", "sfdbtools-client-*.cnf")
+	if err != nil {
+		return "", fmt.Errorf("gagal membuat defaults-file: %w", err)
+	}
+	path := f.Name()
+	if err := f.Chmod(SecureFilePermission); err == nil {
+		_, err = f.WriteString(b.String())
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err == nil {
+			return path, nil
+		}
+	} else {
+		_ = f.Close()
+	}
+	_ = os.Remove(path)
+	return "", fmt.Errorf("gagal menulis defaults-file: %w", err)
+}
+
+// clientTLSOptions mengubah argumen TLS client (--ssl-ca=...) menjadi baris option file.
+func clientTLSOptions(t domain.TLSConfig) []string {
+	if strings.TrimSpace(t.Mode) == "" {
+		return nil
+	}
+	args := database.ClientTLSArgs(t, isMariaDBClient())
+	out := make([]string, 0, len(args))
+	for _, a := range args {
+		out = append(out, strings.TrimPrefix(a, "--"))
+	}
+	return out
+}
+
+// isMariaDBClient true jika client database yang akan dipakai script adalah build MariaDB.
+func isMariaDBClient() bool {
+	bin, err := execx.ResolveMariaDBOrMySQLClient()
+	return err == nil && execx.IsMariaDBClient(bin)
+}
+
+// optionFileValue meng-quote nilai option file MySQL agar spasi, #, dan kutip tidak terpotong.
+func optionFileValue(v string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(v) + `"`
+}
+
+// scriptEnv menyusun env proses script: env parent tanpa scrubbedEnvKeys, ditambah extra.
+func scriptEnv(extra ...[]string) []string {
+	env := make([]string, 0, len(os.Environ()))
+	for _, kv := range os.Environ() {
+		name, _, _ := strings.Cut(kv, "=")
+		scrub := false
+		for _, k := range scrubbedEnvKeys {
+			if name == k {
+				scrub = true
+				break
+			}
+		}
+		if !scrub {
+			env = append(env, kv)
+		}
+	}
+	for _, e := range extra {
+		env = append(env, e...)
+	}
+	return env
+}
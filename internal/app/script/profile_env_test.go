@@ -0,0 +1,122 @@
+package script
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestScriptEnvScrubsSecrets(t *testing.T) {
+	t.Setenv(consts.ENV_SOURCE_PROFILE_KEY, "kunci-profile")
+	t.Setenv(consts.ENV_DB_PASSWORD, "rahasia")
+	t.Setenv(consts.ENV_DB_HOST, "host-lama")
+	t.Setenv("VAULT_TOKEN", "s.token-rahasia")
+	t.Setenv("VAULT_ADDR", "https://vault.example:8200")
+	t.Setenv("VAULT_NAMESPACE", "ops")
+	t.Setenv("SFDB_TEST_KEEP", "ya")
+
+	env := scriptEnv([]string{consts.ENV_DB_HOST + "=10.0.0.5"})
+	for _, kv := range env {
+		name, _, _ := strings.Cut(kv, "=")
+		switch name {
+		case consts.ENV_SOURCE_PROFILE_KEY, consts.ENV_DB_PASSWORD, "VAULT_TOKEN", "VAULT_ADDR", "VAULT_NAMESPACE":
+			t.Fatalf("scriptEnv() masih meneruskan %s", name)
+		}
+	}
+	if !slices.Contains(env, "SFDB_TEST_KEEP=ya") {
+		t.Fatal("scriptEnv() harus mempertahankan env lain")
+	}
+	if slices.Contains(env, consts.ENV_DB_HOST+"=host-lama") || !slices.Contains(env, consts.ENV_DB_HOST+"=10.0.0.5") {
+		t.Fatalf("scriptEnv() harus mengganti %s dengan nilai session", consts.ENV_DB_HOST)
+	}
+}
+
+func TestWriteDefaultsFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     domain.DBInfo
+		password string
+		dbName   string
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "tcp with password and database",
+			info:     domain.DBInfo{Host: "127.0.0.1", Port: 3307, User: "app"},
+			password: `p"a#ss`,
+			dbName:   "sales",
+			want:     []string{`host="127.0.0.1"`, "port=3307", `user="app"`, `password="p\"a#ss"`, "[mysql]\ndatabase=\"sales\""},
+		},
+		{
+			name:    "socket without password",
+			info:    domain.DBInfo{Socket: "/run/mysqld/mysqld.sock", User: "root"},
+			want:    []string{`socket="/run/mysqld/mysqld.sock"`, `user="root"`},
+			notWant: []string{"password=", "host=", "[mysql]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := writeDefaultsFile(tt.info, tt.password, tt.dbName)
+			if err != nil {
+				t.Fatalf("writeDefaultsFile() error = %v", err)
+			}
+			defer os.Remove(path)
+
+			st, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if st.Mode().Perm() != SecureFilePermission {
+				t.Fatalf("permission = %v, want %v", st.Mode().Perm(), os.FileMode(SecureFilePermission))
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			content := string(data)
+			if !strings.HasPrefix(content, "[client]\n") {
+				t.Fatalf("defaults-file harus diawali [client]:\n%s", content)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(content, w) {
+					t.Errorf("defaults-file tidak berisi %q:\n%s", w, content)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(content, w) {
+					t.Errorf("defaults-file tidak boleh berisi %q:\n%s", w, content)
+				}
+			}
+		})
+	}
+}
+
+func TestLogRunAudit(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+
+	opts := RunOptions{FilePath: "/srv/bundles/fix-orders.sftools", Profile: &domain.ProfileInfo{Name: "prod-db"}, Database: "sales", Ticket: "OPS-42"}
+	logRunAudit(logger, opts, nil)
+	logRunAudit(logger, RunOptions{FilePath: "b.sftools"}, errors.New("exit status 1"))
+
+	out := buf.String()
+	for _, w := range []string{
+		`bundle=fix-orders.sftools profile=prod-db database=\"sales\" ticket=\"OPS-42\" success=true`,
+		`bundle=b.sftools profile=- database=- ticket=- success=false`,
+		"exit status 1",
+	} {
+		if !strings.Contains(out, w) {
+			t.Errorf("audit log tidak berisi %q:\n%s", w, out)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+// File : internal/app/script/signing.go
+// Deskripsi : Tanda tangan ed25519 dan verifikasi signer terpercaya untuk bundle .sftools
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package script
+
+import (
+	"archive/tar"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	appconfig "sfdbtools/internal/services/config"
+	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/envx"
+	"sfdbtools/internal/ui/prompt"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// BundleTrust adalah hasil verifikasi tanda tangan bundle.
+type BundleTrust struct {
+	Digest     string
+	SignerID   string
+	SignerName string
+	Signed     bool
+	Trusted    bool
+}
+
+// bundleScan adalah isi bundle yang dibaca tanpa extract (manifest + hash setiap file).
+type bundleScan struct {
+	Manifest     manifest
+	Digest       string
+	Files        []string
+	fileHashes   map[string]string
+	manifestHash string
+}
+
+// scanBundle membaca seluruh tar dari r: parse manifest dan hitung sha256 setiap file.
+// Batas ukuran sama dengan extractTarEntry (zip bomb protection).
+func scanBundle(r io.Reader) (*bundleScan, error) {
+	scan := &bundleScan{fileHashes: map[string]string{}}
+	manifestFound := false
+	var total int64
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("gagal membaca tar: %w", err)
+		}
+		cleanName, err := safeTarPath(hdr.Name)
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if hdr.Size > MaxFileSize {
+			return nil, fmt.Errorf("file terlalu besar: %s (%d bytes, max %d)", hdr.Name, hdr.Size, MaxFileSize)
+		}
+		total += hdr.Size
+		if total > MaxExtractedSize {
+			return nil, fmt.Errorf("bundle terlalu besar: %d bytes (max %d, zip bomb protection)", total, MaxExtractedSize)
+		}
+
+		if cleanName == manifestFilename {
+			b, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, fmt.Errorf("gagal membaca manifest: %w", err)
+			}
+			if err := json.Unmarshal(b, &scan.Manifest); err != nil {
+				return nil, fmt.Errorf("manifest invalid: %w", err)
+			}
+			sum := sha256.Sum256(b)
+			scan.manifestHash = hex.EncodeToString(sum[:])
+			manifestFound = true
+			continue
+		}
+
+		h := sha256.New()
+		if _, err := io.Copy(h, tr); err != nil {
+			return nil, fmt.Errorf("gagal membaca %s: %w", cleanName, err)
+		}
+		if _, dup := scan.fileHashes[cleanName]; !dup {
+			scan.Files = append(scan.Files, cleanName)
+		}
+		scan.fileHashes[cleanName] = hex.EncodeToString(h.Sum(nil))
+	}
+
+	if !manifestFound {
+		return nil, fmt.Errorf("manifest tidak ditemukan dalam bundle")
+	}
+	scan.Digest = bundleDigest(scan.fileHashes)
+	return scan, nil
+}
+
+// bundleDigest menghitung digest isi bundle dari hash per file (format sha256sum, path terurut).
+// Manifest tidak ikut dihitung karena manifest berisi digest itu sendiri.
+func bundleDigest(fileHashes map[string]string) string {
+	names := make([]string, 0, len(fileHashes))
+	for n := range fileHashes {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, n := range names {
+		fmt.Fprintf(h, "%s  %s\n", fileHashes[n], n)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// hashFile mengembalikan sha256 hex dari isi file.
+func hashFile(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", fmt.Errorf("gagal membuka file: %w", err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("gagal membaca file: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// manifestSigningPayload adalah byte yang ditandatangani: manifest (JSON) dengan signature dikosongkan.
+// Seluruh field manifest (entrypoint, digest, signer, dst) ikut terlindungi.
+func manifestSigningPayload(m manifest) ([]byte, error) {
+	m.Signature = ""
+	return json.Marshal(m)
+}
+
+// signManifest mengisi signer_id dan signature manifest dengan private key ed25519.
+func signManifest(m *manifest, priv ed25519.PrivateKey) error {
+	id, err := signerID(priv.Public().(ed25519.PublicKey))
+	if err != nil {
+		return err
+	}
+	m.SignerID = id
+	payload, err := manifestSigningPayload(*m)
+	if err != nil {
+		return fmt.Errorf("gagal menyusun payload tanda tangan: %w", err)
+	}
+	m.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(priv, payload))
+	return nil
+}
+
+// signerID adalah fingerprint SHA256 public key (sama dengan output `ssh-keygen -lf`).
+func signerID(pub ed25519.PublicKey) (string, error) {
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return "", fmt.Errorf("public key signer tidak valid: %w", err)
+	}
+	return ssh.FingerprintSHA256(sshPub), nil
+}
+
+// loadSigningKey membaca private key ed25519 (OpenSSH atau PKCS#8 PEM) untuk menandatangani bundle.
+// Key terenkripsi memakai passphrase dari ENV SFDB_SCRIPT_SIGN_KEY_PASSPHRASE atau prompt.
+func loadSigningKey(keyPath string) (ed25519.PrivateKey, error) {
+	keyPath = envx.ExpandPath(strings.TrimSpace(keyPath))
+	b, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca sign key: %w", err)
+	}
+
+	raw, err := ssh.ParseRawPrivateKey(b)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		passphrase := strings.TrimSpace(os.Getenv(consts.ENV_SCRIPT_SIGN_KEY_PASSPHRASE))
+		if passphrase == "" {
+			passphrase, err = prompt.AskPassword(fmt.Sprintf("Passphrase sign key %s:", filepath.Base(keyPath)), nil)
+			if err != nil {
+				return nil, fmt.Errorf("gagal membaca passphrase sign key: %w", err)
+			}
+		}
+		raw, err = ssh.ParseRawPrivateKeyWithPassphrase(b, []byte(passphrase))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sign key tidak valid (%s): %w", keyPath, err)
+	}
+
+	switch k := raw.(type) {
+	case ed25519.PrivateKey:
+		return k, nil
+	case *ed25519.PrivateKey:
+		return *k, nil
+	default:
+		return nil, fmt.Errorf("sign key harus ed25519 (buat dengan: ssh-keygen -t ed25519 -f <file>)")
+	}
+}
+
+// parseTrustedPublicKey menerima format authorized_keys, PEM "PUBLIC KEY", base64 32 byte,
+// atau path file yang berisi salah satunya.
+func parseTrustedPublicKey(value string) (ed25519.PublicKey, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("public_key kosong")
+	}
+	if p := envx.ExpandPath(value); isRegularFile(p) {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membaca public key: %w", err)
+		}
+		value = strings.TrimSpace(string(b))
+	}
+
+	if strings.HasPrefix(value, "ssh-") {
+		pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(value))
+		if err != nil {
+			return nil, fmt.Errorf("public key authorized_keys tidak valid: %w", err)
+		}
+		cpk, ok := pub.(ssh.CryptoPublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key tidak didukung: %s", pub.Type())
+		}
+		if k, ok := cpk.CryptoPublicKey().(ed25519.PublicKey); ok {
+			return k, nil
+		}
+		return nil, fmt.Errorf("public key harus ed25519, bukan %s", pub.Type())
+	}
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("public key PEM tidak valid: %w", err)
+		}
+		if k, ok := parsed.(ed25519.PublicKey); ok {
+			return k, nil
+		}
+		return nil, fmt.Errorf("public key PEM harus ed25519")
+	}
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(b) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("format public key tidak dikenali (gunakan 'ssh-ed25519 AAAA...', PEM, atau base64 32 byte)")
+	}
+	return ed25519.PublicKey(b), nil
+}
+
+func isRegularFile(p string) bool {
+	st, err := os.Stat(p)
+	return err == nil && st.Mode().IsRegular()
+}
+
+// verifyBundleTrust memeriksa digest dan tanda tangan manifest terhadap daftar signer terpercaya.
+// Digest atau tanda tangan yang tidak cocok selalu error (bundle diubah); bundle tanpa tanda tangan
+// atau dari signer tak dikenal dikembalikan dengan Trusted=false untuk diputuskan oleh enforceTrust.
+func verifyBundleTrust(scan *bundleScan, signers []appconfig.ScriptTrustedSigner) (BundleTrust, error) {
+	m := scan.Manifest
+	t := BundleTrust{Digest: scan.Digest, SignerID: m.SignerID}
+	if m.Digest != "" && m.Digest != scan.Digest {
+		return t, fmt.Errorf("digest bundle tidak cocok dengan manifest (isi bundle diubah?): manifest=%s, aktual=%s", m.Digest, scan.Digest)
+	}
+	if strings.TrimSpace(m.Signature) == "" {
+		return t, nil
+	}
+	t.Signed = true
+	if m.Digest == "" {
+		return t, fmt.Errorf("bundle bertanda tangan tetapi manifest tidak berisi digest")
+	}
+
+	for _, s := range signers {
+		pub, err := parseTrustedPublicKey(s.PublicKey)
+		if err != nil {
+			return t, fmt.Errorf("script.trusted_signers %q: %w", s.Name, err)
+		}
+		id, err := signerID(pub)
+		if err != nil {
+			return t, err
+		}
+		if id != m.SignerID {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(m.Signature)
+		if err != nil {
+			return t, fmt.Errorf("signature manifest tidak valid: %w", err)
+		}
+		payload, err := manifestSigningPayload(m)
+		if err != nil {
+			return t, err
+		}
+		if !ed25519.Verify(pub, payload, sig) {
+			return t, fmt.Errorf("tanda tangan bundle tidak valid untuk signer %s (%s)", s.Name, m.SignerID)
+		}
+		t.Trusted = true
+		t.SignerName = s.Name
+		return t, nil
+	}
+	return t, nil
+}
+
+// enforceTrust menolak bundle yang tidak ditandatangani signer terpercaya kecuali allowUntrusted.
+func enforceTrust(t BundleTrust, allowUntrusted bool) error {
+	if t.Trusted {
+		return nil
+	}
+	reason := "bundle tidak ditandatangani"
+	if t.Signed {
+		reason = fmt.Sprintf("signer %s tidak ada di script.trusted_signers", t.SignerID)
+	}
+	if !allowUntrusted {
+		return fmt.Errorf("%s (digest %s); tambahkan signer ke config script.trusted_signers atau gunakan --allow-untrusted", reason, t.Digest)
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %s; dilanjutkan karena --allow-untrusted\n", reason)
+	return nil
+}
+
+// verifyBundleFile memverifikasi bundle (pass 1, tanpa menulis ke disk) lalu mengembalikan posisi
+// file ke awal agar bisa di-extract (pass 2) dari file handle yang sama. Pass 2 wajib lewat
+// extractVerifiedBundle agar isi yang di-extract sama dengan yang diverifikasi.
+func verifyBundleFile(f *os.File, key string, signers []appconfig.ScriptTrustedSigner, allowUntrusted bool) (*bundleScan, BundleTrust, error) {
+	scan, trust, err := readBundleTrust(f, key, signers)
+	if err != nil {
+		return nil, trust, err
+	}
+	if err := enforceTrust(trust, allowUntrusted); err != nil {
+		return nil, trust, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, trust, fmt.Errorf("gagal membaca ulang bundle: %w", err)
+	}
+	return scan, trust, nil
+}
+
+// extractVerifiedBundle meng-extract tar dari r ke baseDir sambil meng-hash setiap file dan
+// mencocokkannya dengan hasil scan yang sudah diverifikasi. File yang hilang, tambahan, atau
+// isinya berbeda ditolak, sehingga bundle yang diganti setelah verifikasi tidak pernah dipakai.
+func extractVerifiedBundle(r io.Reader, baseDir string, scan *bundleScan) error {
+	seen := make(map[string]bool, len(scan.fileHashes)+1)
+	var totalExtracted int64
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("gagal membaca tar: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			if err := extractTarEntry(hdr, tr, baseDir, &totalExtracted); err != nil {
+				return err
+			}
+			continue
+		}
+		cleanName, err := safeTarPath(hdr.Name)
+		if err != nil {
+			return err
+		}
+		want, ok := scan.fileHashes[cleanName]
+		if cleanName == manifestFilename {
+			want, ok = scan.manifestHash, true
+		}
+		if !ok || seen[cleanName] {
+			return fmt.Errorf("bundle berubah sejak diverifikasi: file tidak terduga %s", cleanName)
+		}
+		seen[cleanName] = true
+
+		h := sha256.New()
+		if err := extractTarEntry(hdr, io.TeeReader(tr, h), baseDir, &totalExtracted); err != nil {
+			return err
+		}
+		if got := hex.EncodeToString(h.Sum(nil)); got != want {
+			return fmt.Errorf("bundle berubah sejak diverifikasi: isi %s tidak cocok", cleanName)
+		}
+	}
+
+	if !seen[manifestFilename] {
+		return fmt.Errorf("bundle berubah sejak diverifikasi: manifest hilang")
+	}
+	for _, name := range scan.Files {
+		if !seen[name] {
+			return fmt.Errorf("bundle berubah sejak diverifikasi: file %s hilang", name)
+		}
+	}
+	return nil
+}
+
+// readBundleTrust mendekripsi bundle, membaca isinya, dan memverifikasi tanda tangan.
+func readBundleTrust(r io.Reader, key string, signers []appconfig.ScriptTrustedSigner) (*bundleScan, BundleTrust, error) {
+	decReader, err := newBundleDecryptor(r, key)
+	if err != nil {
+		return nil, BundleTrust{}, err
+	}
+	scan, err := scanBundle(decReader)
+	if err != nil {
+		return nil, BundleTrust{}, err
+	}
+	trust, err := verifyBundleTrust(scan, signers)
+	return scan, trust, err
+}
+
+// resolveSigningKey memuat sign key jika path diisi (flag --sign-key atau ENV SFDB_SCRIPT_SIGN_KEY).
+func resolveSigningKey(keyPath string) (ed25519.PrivateKey, error) {
+	if strings.TrimSpace(keyPath) == "" {
+		return nil, nil
+	}
+	return loadSigningKey(keyPath)
+}
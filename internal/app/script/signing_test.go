@@ -0,0 +1,224 @@
+package script
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	appconfig "sfdbtools/internal/services/config"
+)
+
+func testSigningKey(seed byte) ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+}
+
+func testSigner(name string, priv ed25519.PrivateKey) appconfig.ScriptTrustedSigner {
+	return appconfig.ScriptTrustedSigner{
+		Name:      name,
+		PublicKey: base64.StdEncoding.EncodeToString(priv.Public().(ed25519.PublicKey)),
+	}
+}
+
+func TestVerifyBundleTrust(t *testing.T) {
+	const digest = "sha256:abc"
+	dba := testSigningKey(1)
+	other := testSigningKey(2)
+
+	signed := func(priv ed25519.PrivateKey, mutate func(*manifest)) manifest {
+		m := manifest{Version: 1, Entrypoint: "run.sh", Digest: digest}
+		if err := signManifest(&m, priv); err != nil {
+			t.Fatal(err)
+		}
+		if mutate != nil {
+			mutate(&m)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name        string
+		manifest    manifest
+		digest      string
+		signers     []appconfig.ScriptTrustedSigner
+		wantSigned  bool
+		wantTrusted bool
+		wantSigner  string
+		wantErr     bool
+	}{
+		{
+			name:     "unsigned without digest",
+			manifest: manifest{Version: 1, Entrypoint: "run.sh"},
+			digest:   digest,
+		},
+		{
+			name:     "unsigned with matching digest",
+			manifest: manifest{Version: 1, Entrypoint: "run.sh", Digest: digest},
+			digest:   digest,
+		},
+		{
+			name:     "digest mismatch",
+			manifest: manifest{Version: 1, Entrypoint: "run.sh", Digest: digest},
+			digest:   "sha256:def",
+			wantErr:  true,
+		},
+		{
+			name:        "trusted signer",
+			manifest:    signed(dba, nil),
+			digest:      digest,
+			signers:     []appconfig.ScriptTrustedSigner{testSigner("lain", other), testSigner("tim-dba", dba)},
+			wantSigned:  true,
+			wantTrusted: true,
+			wantSigner:  "tim-dba",
+		},
+		{
+			name:       "unknown signer",
+			manifest:   signed(dba, nil),
+			digest:     digest,
+			signers:    []appconfig.ScriptTrustedSigner{testSigner("lain", other)},
+			wantSigned: true,
+		},
+		{
+			name:     "manifest changed after signing",
+			manifest: signed(dba, func(m *manifest) { m.Entrypoint = "evil.sh" }),
+			digest:   digest,
+			signers:  []appconfig.ScriptTrustedSigner{testSigner("tim-dba", dba)},
+			wantErr:  true,
+		},
+		{
+			name:     "signed without digest",
+			manifest: signed(dba, func(m *manifest) { m.Digest = "" }),
+			digest:   digest,
+			signers:  []appconfig.ScriptTrustedSigner{testSigner("tim-dba", dba)},
+			wantErr:  true,
+		},
+		{
+			name:     "signature not base64",
+			manifest: signed(dba, func(m *manifest) { m.Signature = "!!!" }),
+			digest:   digest,
+			signers:  []appconfig.ScriptTrustedSigner{testSigner("tim-dba", dba)},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid trusted public key",
+			manifest: signed(dba, nil),
+			digest:   digest,
+			signers:  []appconfig.ScriptTrustedSigner{{Name: "rusak", PublicKey: "bukan-key"}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scan := &bundleScan{Manifest: tt.manifest, Digest: tt.digest}
+			got, err := verifyBundleTrust(scan, tt.signers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verifyBundleTrust err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Signed != tt.wantSigned || got.Trusted != tt.wantTrusted || got.SignerName != tt.wantSigner {
+				t.Fatalf("trust = %+v, want signed=%v trusted=%v signer=%q", got, tt.wantSigned, tt.wantTrusted, tt.wantSigner)
+			}
+		})
+	}
+}
+
+func TestEnforceTrust(t *testing.T) {
+	tests := []struct {
+		name           string
+		trust          BundleTrust
+		allowUntrusted bool
+		wantErr        bool
+	}{
+		{name: "trusted", trust: BundleTrust{Signed: true, Trusted: true}},
+		{name: "unsigned rejected", trust: BundleTrust{}, wantErr: true},
+		{name: "unknown signer rejected", trust: BundleTrust{Signed: true, SignerID: "SHA256:x"}, wantErr: true},
+		{name: "unsigned allowed explicitly", trust: BundleTrust{}, allowUntrusted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := enforceTrust(tt.trust, tt.allowUntrusted); (err != nil) != tt.wantErr {
+				t.Fatalf("enforceTrust err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func testBundleTar(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	names := []string{manifestFilename, "run.sh", "lib/util.sh", "extra.sh"}
+	for _, name := range names {
+		body, ok := files[name]
+		if !ok {
+			continue
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(body)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestExtractVerifiedBundle(t *testing.T) {
+	verified := map[string]string{
+		manifestFilename: `{"version":1,"entrypoint":"run.sh"}`,
+		"run.sh":         "echo ok\n",
+		"lib/util.sh":    "true\n",
+	}
+	scan, err := scanBundle(bytes.NewReader(testBundleTar(t, verified)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	with := func(mutate func(map[string]string)) map[string]string {
+		files := map[string]string{}
+		for k, v := range verified {
+			files[k] = v
+		}
+		mutate(files)
+		return files
+	}
+
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{
+		{name: "same content", files: verified},
+		{name: "file changed", files: with(func(f map[string]string) { f["run.sh"] = "rm -rf /\n" }), wantErr: true},
+		{name: "file missing", files: with(func(f map[string]string) { delete(f, "lib/util.sh") }), wantErr: true},
+		{name: "extra file", files: with(func(f map[string]string) { f["extra.sh"] = "echo\n" }), wantErr: true},
+		{name: "manifest changed", files: with(func(f map[string]string) { f[manifestFilename] = `{"version":1,"entrypoint":"lib/util.sh"}` }), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := extractVerifiedBundle(bytes.NewReader(testBundleTar(t, tt.files)), dir, scan)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("extractVerifiedBundle err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			b, err := os.ReadFile(filepath.Join(dir, "run.sh"))
+			if err != nil || string(b) != verified["run.sh"] {
+				t.Fatalf("run.sh = %q, %v", b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,477 @@
+// File : internal/app/script/sqlrun.go
+// Deskripsi : Eksekusi bundle SQL (.sql atau folder .sql berurutan) lewat client mariadb/mysql jalur restore
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package script
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	restorehelpers "sfdbtools/internal/app/restore/helpers"
+)
+
+// sqlDirEntrypoint adalah entrypoint manifest untuk bundle folder SQL (semua .sql di root bundle).
+const sqlDirEntrypoint = "."
+
+// sqlScript adalah satu file SQL yang akan dijalankan.
+type sqlScript struct {
+	Name    string // path relatif di bundle (dipakai di pesan error dan tracking)
+	Path    string
+	Hash    string // sha256 hex isi file; identitas file di tabel tracking
+	Lines   int    // jumlah newline; di stream gabungan file berikutnya mulai Lines+1 baris setelahnya
+	Blocker string // statement pertama yang memicu implicit commit (mis. "ALTER"), kosong jika tidak ada
+}
+
+// sqlScriptError adalah error client yang bisa dipetakan ke file tertentu di stream gabungan.
+type sqlScriptError struct {
+	Index int // indeks file yang gagal; file sebelumnya sudah selesai dijalankan
+	Name  string
+	Line  int
+	Err   error
+}
+
+func (e *sqlScriptError) Error() string {
+	return fmt.Sprintf("script SQL gagal di %s baris %d: %v", e.Name, e.Line, e.Err)
+}
+
+func (e *sqlScriptError) Unwrap() error { return e.Err }
+
+// implicitCommitKeywords adalah awal statement yang memicu implicit commit (atau mengatur transaksi sendiri),
+// sehingga file yang memuatnya tidak bisa dibungkus satu transaksi.
+var implicitCommitKeywords = map[string]bool{
+	"ALTER": true, "CREATE": true, "DROP": true, "RENAME": true, "TRUNCATE": true,
+	"GRANT": true, "REVOKE": true, "LOCK": true, "UNLOCK": true,
+	"BEGIN": true, "START": true, "COMMIT": true, "ROLLBACK": true, "XA": true,
+	"ANALYZE": true, "OPTIMIZE": true, "REPAIR": true, "CHECK": true, "FLUSH": true, "RESET": true,
+	"INSTALL": true, "UNINSTALL": true, "CACHE": true, "LOAD": true, "DELIMITER": true,
+	// Isi procedure dan prepared statement tidak bisa diperiksa; anggap bisa memicu implicit commit.
+	"CALL": true, "EXECUTE": true,
+}
+
+// clientErrorLineRe mengambil nomor baris dari error client ("ERROR 1064 (42000) at line 12: ...").
+var clientErrorLineRe = regexp.MustCompile(`at line (\d+)`)
+
+// isSQLEntrypoint bernilai true untuk bundle SQL: entrypoint .sql atau folder SQL.
+func isSQLEntrypoint(entrypoint string) bool {
+	return entrypoint == sqlDirEntrypoint || strings.EqualFold(path.Ext(entrypoint), ".sql")
+}
+
+// collectSQLScripts mengembalikan file SQL yang akan dijalankan. Untuk folder, semua file .sql di level
+// teratas diurutkan menurut nama (gunakan prefix seperti 001_, 002_ untuk menentukan urutan).
+func collectSQLScripts(rootDir, entrypoint string) ([]sqlScript, error) {
+	if entrypoint != sqlDirEntrypoint {
+		return []sqlScript{{Name: entrypoint, Path: filepath.Join(rootDir, filepath.FromSlash(entrypoint))}}, nil
+	}
+	entries, err := os.ReadDir(rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca folder SQL: %w", err)
+	}
+	var scripts []sqlScript
+	for _, e := range entries {
+		if e.IsDir() || !strings.EqualFold(filepath.Ext(e.Name()), ".sql") {
+			continue
+		}
+		scripts = append(scripts, sqlScript{Name: e.Name(), Path: filepath.Join(rootDir, e.Name())})
+	}
+	if len(scripts) == 0 {
+		return nil, fmt.Errorf("folder SQL tidak berisi file .sql")
+	}
+	sort.Slice(scripts, func(i, j int) bool { return scripts[i].Name < scripts[j].Name })
+	return scripts, nil
+}
+
+// inspectSQLScripts memvalidasi setiap file (statement terakhir wajib diakhiri delimiter agar file
+// berikutnya tidak tersambung) lalu mengisi Hash, Lines, dan Blocker tiap file.
+func inspectSQLScripts(scripts []sqlScript) error {
+	for i := range scripts {
+		data, err := os.ReadFile(scripts[i].Path)
+		if err != nil {
+			return fmt.Errorf("gagal membaca %s: %w", scripts[i].Name, err)
+		}
+		sum := sha256.Sum256(data)
+		scripts[i].Hash = hex.EncodeToString(sum[:])
+		scripts[i].Lines = bytes.Count(data, []byte("\n"))
+		keywords, unterminated := scanSQLStatements(data)
+		if unterminated {
+			return fmt.Errorf("%s: statement terakhir tidak diakhiri ';'", scripts[i].Name)
+		}
+		scripts[i].Blocker = ""
+		for _, kw := range keywords {
+			if implicitCommitKeywords[kw.first] && !kw.temporary {
+				scripts[i].Blocker = kw.first
+				break
+			}
+			if kw.first == "SET" && kw.second == "AUTOCOMMIT" {
+				scripts[i].Blocker = "SET autocommit"
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// transactionBlocker mengembalikan file pertama yang tidak bisa dibungkus satu transaksi, mis. "002.sql (ALTER)".
+func transactionBlocker(scripts []sqlScript) string {
+	for _, sc := range scripts {
+		if sc.Blocker != "" {
+			return fmt.Sprintf("%s (%s)", sc.Name, sc.Blocker)
+		}
+	}
+	return ""
+}
+
+// sqlKeyword adalah dua kata pertama sebuah statement (uppercase).
+type sqlKeyword struct {
+	first, second string
+	temporary     bool // CREATE/DROP TEMPORARY TABLE tidak memicu implicit commit
+}
+
+// scanSQLStatements memecah isi file menjadi statement (mengabaikan komentar, string, dan identifier ber-backtick,
+// serta mengikuti perintah DELIMITER client) lalu mengembalikan kata kunci awal tiap statement.
+// unterminated bernilai true jika masih ada teks statement setelah delimiter terakhir.
+func scanSQLStatements(data []byte) (keywords []sqlKeyword, unterminated bool) {
+	delim := ";"
+	// Hanya awal statement yang disimpan (cukup untuk kata kunci) agar INSERT besar tidak disalin utuh.
+	var head strings.Builder
+	pending := false
+	write := func(b string) {
+		if strings.TrimSpace(b) != "" {
+			pending = true
+		}
+		if head.Len() < 128 {
+			head.WriteString(b)
+		}
+	}
+	flush := func() {
+		if kw, ok := statementKeyword(head.String()); ok {
+			keywords = append(keywords, kw)
+		}
+		head.Reset()
+		pending = false
+	}
+
+	s := string(data)
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case (c == 'D' || c == 'd') && !pending && startsWithFold(s[i:], "DELIMITER") && atLineStart(s, i):
+			// Perintah client DELIMITER berlaku sampai akhir baris dan tidak memakai delimiter.
+			end := strings.IndexByte(s[i:], '\n')
+			if end < 0 {
+				end = len(s) - i
+			}
+			fields := strings.Fields(s[i : i+end])
+			if len(fields) >= 2 {
+				delim = fields[1]
+			}
+			keywords = append(keywords, sqlKeyword{first: "DELIMITER"})
+			head.Reset()
+			i += end
+		case c == '#', c == '-' && isDashComment(s[i:]):
+			end := strings.IndexByte(s[i:], '\n')
+			if end < 0 {
+				end = len(s) - i
+			}
+			i += end
+		case c == '/' && strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				return keywords, true
+			}
+			// Komentar versi (/*!50003 ... */, MariaDB /*M!100100 ... */) dieksekusi server: isinya bagian statement.
+			if body, ok := versionedCommentBody(s[i+2 : i+2+end]); ok {
+				write(" " + body + " ")
+			} else {
+				write(" ")
+			}
+			i += end + 4
+		case c == '\'' || c == '"' || c == '`':
+			j := i + 1
+			for j < len(s) && s[j] != c {
+				if s[j] == '\\' && c != '`' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				return keywords, true
+			}
+			write(s[i : j+1])
+			i = j + 1
+		case strings.HasPrefix(s[i:], delim):
+			flush()
+			i += len(delim)
+		default:
+			write(s[i : i+1])
+			i++
+		}
+	}
+	return keywords, pending
+}
+
+func statementKeyword(stmt string) (sqlKeyword, bool) {
+	fields := strings.Fields(stmt)
+	if len(fields) == 0 {
+		return sqlKeyword{}, false
+	}
+	kw := sqlKeyword{first: strings.ToUpper(fields[0])}
+	if len(fields) > 1 {
+		kw.second = strings.ToUpper(strings.TrimLeft(fields[1], "@"))
+		if i := strings.IndexByte(kw.second, '='); i >= 0 {
+			kw.second = kw.second[:i]
+		}
+	}
+	kw.temporary = (kw.first == "CREATE" || kw.first == "DROP") && kw.second == "TEMPORARY"
+	return kw, true
+}
+
+// versionedCommentBody mengembalikan isi komentar versi MySQL/MariaDB (tanpa penanda dan nomor versi).
+func versionedCommentBody(comment string) (string, bool) {
+	switch {
+	case strings.HasPrefix(comment, "!"):
+		comment = comment[1:]
+	case strings.HasPrefix(comment, "M!"):
+		comment = comment[2:]
+	default:
+		return "", false
+	}
+	return strings.TrimLeft(comment, "0123456789"), true
+}
+
+// isDashComment: komentar "--" MySQL wajib diikuti spasi/kontrol (atau akhir input).
+func isDashComment(s string) bool {
+	if !strings.HasPrefix(s, "--") {
+		return false
+	}
+	return len(s) == 2 || s[2] == ' ' || s[2] == '\t' || s[2] == '\n' || s[2] == '\r'
+}
+
+func startsWithFold(s, prefix string) bool {
+	return len(s) > len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) && (s[len(prefix)] == ' ' || s[len(prefix)] == '\t')
+}
+
+func atLineStart(s string, i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		switch s[j] {
+		case ' ', '\t', '\r':
+			continue
+		case '\n':
+			return true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// runSQLScripts menjalankan semua file dalam satu sesi client (berhenti pada error pertama, tanpa -f).
+// Dengan transaction, isi file diapit START TRANSACTION/COMMIT; jika client berhenti karena error,
+// koneksi tertutup sebelum COMMIT sehingga server me-rollback transaksi.
+// Kredensial dibaca client dari defaults-file sesi (bukan argv) agar password tidak terlihat di ps.
+func runSQLScripts(ctx context.Context, defaultsFile, database string, scripts []sqlScript, transaction bool) error {
+	var (
+		readers []io.Reader
+		files   []*os.File
+		starts  = make([]int, len(scripts)) // baris pertama tiap file di stream gabungan
+		line    = 1
+	)
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+
+	if transaction {
+		readers = append(readers, strings.NewReader("START TRANSACTION;\n"))
+		line++
+	}
+	for i, sc := range scripts {
+		f, err := os.Open(sc.Path)
+		if err != nil {
+			return fmt.Errorf("gagal membuka %s: %w", sc.Name, err)
+		}
+		files = append(files, f)
+		starts[i] = line
+		readers = append(readers, f, strings.NewReader("\n"))
+		line += sc.Lines + 1
+	}
+	if transaction {
+		readers = append(readers, strings.NewReader("COMMIT;\n"))
+	}
+
+	// --defaults-extra-file wajib argumen pertama; nilainya meng-override ~/.my.cnf dan [client] global.
+	args := []string{"--defaults-extra-file=" + defaultsFile, database}
+	err := restorehelpers.ExecuteMySQLCommand(ctx, args, io.MultiReader(readers...))
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("script SQL dibatalkan: %w", ctx.Err())
+	}
+	if m := clientErrorLineRe.FindStringSubmatch(err.Error()); m != nil {
+		if n, convErr := strconv.Atoi(m[1]); convErr == nil {
+			for i := len(scripts) - 1; i >= 0; i-- {
+				if n >= starts[i] {
+					return &sqlScriptError{Index: i, Name: scripts[i].Name, Line: n - starts[i] + 1, Err: err}
+				}
+			}
+		}
+	}
+	return fmt.Errorf("script SQL gagal: %w", err)
+}
+
+// planSQLScripts memilih file yang belum diterapkan (urutan bundle dipertahankan) berdasarkan riwayat
+// tracking runs (urut id naik). File dengan hash yang pernah tercatat success dilewati dan dikembalikan
+// di applied. File yang run terakhirnya (nama atau hash sama) masih running, atau failed tanpa transaksi,
+// bisa sudah diterapkan sebagian sehingga run ditolak kecuali force.
+func planSQLScripts(scripts []sqlScript, runs []sqlScriptRun, force bool) (pending []sqlScript, applied []sqlScriptRun, err error) {
+	var blocked []string
+	for _, sc := range scripts {
+		var (
+			success *sqlScriptRun
+			last    *sqlScriptRun
+		)
+		for i := range runs {
+			r := &runs[i]
+			if r.Hash == sc.Hash && r.Status == sqlRunStatusSuccess {
+				success = r
+			}
+			if r.Hash == sc.Hash || r.Name == sc.Name {
+				last = r
+			}
+		}
+		if success != nil {
+			applied = append(applied, *success)
+			continue
+		}
+		if last != nil && !force {
+			switch {
+			case last.Status == sqlRunStatusRunning:
+				blocked = append(blocked, fmt.Sprintf("%s (running sejak %s, ticket %s)", sc.Name, last.StartedAt.Format("2006-01-02 15:04:05"), last.Ticket))
+			case last.Status == sqlRunStatusFailed && !last.Transaction:
+				blocked = append(blocked, fmt.Sprintf("%s (gagal tanpa transaksi, ticket %s)", sc.Name, last.Ticket))
+			}
+		}
+		pending = append(pending, sc)
+	}
+	if len(blocked) > 0 {
+		return nil, nil, fmt.Errorf("file berikut mungkin sudah diterapkan sebagian: %s; periksa database lalu ulangi dengan --force-rerun",
+			strings.Join(blocked, ", "))
+	}
+	return pending, applied, nil
+}
+
+// sqlRunResults memetakan hasil runSQLScripts ke tiap file: ran adalah jumlah file yang dicatat
+// (sisanya tidak sempat dijalankan), results[i] nil berarti file i sukses.
+// Dalam transaksi semua file ikut gagal (di-rollback); tanpa transaksi, error yang tidak bisa dipetakan
+// ke file membuat semua file tercatat gagal karena tidak diketahui sampai mana client berjalan.
+func sqlRunResults(n int, runErr error, transaction bool) (results []error, ran int) {
+	results = make([]error, n)
+	if runErr == nil {
+		return results, n
+	}
+	var scriptErr *sqlScriptError
+	if !transaction && errors.As(runErr, &scriptErr) && scriptErr.Index < n {
+		results[scriptErr.Index] = runErr
+		return results, scriptErr.Index + 1
+	}
+	for i := range results {
+		results[i] = runErr
+	}
+	return results, n
+}
+
+// runSQLBundle menjalankan bundle SQL ke opts.Database: validasi file, buka koneksi profile (tunnel),
+// pilih file yang belum tercatat sukses di tabel tracking, lalu jalankan dan catat hasil per file.
+func runSQLBundle(ctx context.Context, opts RunOptions, rootDir, entrypoint string) error {
+	scripts, err := collectSQLScripts(rootDir, entrypoint)
+	if err != nil {
+		return err
+	}
+	if err := inspectSQLScripts(scripts); err != nil {
+		return err
+	}
+
+	session, err := openProfileSession(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	tracker, err := openSQLTracker(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer tracker.Close()
+
+	runs, err := tracker.Runs(ctx, scripts)
+	if err != nil {
+		return err
+	}
+	pending, applied, err := planSQLScripts(scripts, runs, opts.ForceRerun)
+	if err != nil {
+		return err
+	}
+	for _, r := range applied {
+		fmt.Printf("File SQL %s sudah diterapkan ke database %s pada %s (ticket %s, oleh %s); dilewati.\n",
+			r.Name, opts.Database, r.FinishedAt.Format("2006-01-02 15:04:05"), r.Ticket, r.ExecutedBy)
+	}
+	if len(pending) == 0 {
+		fmt.Printf("Semua file SQL sudah diterapkan ke database %s\n", opts.Database)
+		return nil
+	}
+	// Hanya file yang akan dijalankan yang harus bebas implicit commit.
+	if blocker := transactionBlocker(pending); opts.Transaction && blocker != "" {
+		return fmt.Errorf("--transaction tidak bisa dipakai: %s memicu (atau bisa memicu) implicit commit", blocker)
+	}
+
+	ids, err := tracker.Start(ctx, opts, pending, opts.Transaction)
+	if err != nil {
+		return err
+	}
+	mode := "tanpa transaksi"
+	if opts.Transaction {
+		mode = "dalam satu transaksi"
+	}
+	fmt.Printf("Menjalankan %d file SQL ke database %s %s\n", len(pending), opts.Database, mode)
+	runErr := runSQLScripts(ctx, session.defaultsFile, opts.Database, pending, opts.Transaction)
+	results, ran := sqlRunResults(len(pending), runErr, opts.Transaction)
+	var trackErr error
+	for i, id := range ids {
+		var err error
+		if i < ran {
+			err = tracker.Finish(id, results[i])
+		} else {
+			err = tracker.Discard(id)
+		}
+		if err == nil {
+			continue
+		}
+		// Row lain tetap diperbarui; error pertama dikembalikan jika script sendiri sukses.
+		if runErr == nil && trackErr == nil {
+			trackErr = err
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	if runErr != nil {
+		return runErr
+	}
+	fmt.Printf("✓ Script SQL selesai diterapkan ke database %s\n", opts.Database)
+	return trackErr
+}
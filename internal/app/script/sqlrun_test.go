@@ -0,0 +1,308 @@
+package script
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestScanSQLStatements(t *testing.T) {
+	tests := []struct {
+		name         string
+		sql          string
+		want         []string // first keyword tiap statement
+		unterminated bool
+	}{
+		{
+			name: "simple statements",
+			sql:  "INSERT INTO t VALUES (1);\nupdate t set a = 2;\n",
+			want: []string{"INSERT", "UPDATE"},
+		},
+		{
+			name: "comments are ignored",
+			sql:  "-- DROP TABLE x;\n# ALTER TABLE y;\n/* CREATE TABLE z; */ DELETE FROM t;\n",
+			want: []string{"DELETE"},
+		},
+		{
+			name: "delimiter inside strings and identifiers",
+			sql:  "INSERT INTO `a;b` VALUES ('x;y', \"p;q\", 'it\\'s;');\n",
+			want: []string{"INSERT"},
+		},
+		{
+			name: "versioned comment is part of statement",
+			sql:  "/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`%`*/ /*!50003 TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET @a = 1 */;\n",
+			want: []string{"CREATE"},
+		},
+		{
+			name: "mariadb versioned comment",
+			sql:  "/*M!100100 ALTER TABLE t ENGINE=InnoDB */;\n",
+			want: []string{"ALTER"},
+		},
+		{
+			name: "client delimiter command",
+			sql:  "DELIMITER //\nCREATE PROCEDURE p() BEGIN SELECT 1; END//\nDELIMITER ;\nCALL p();\n",
+			want: []string{"DELIMITER", "CREATE", "DELIMITER", "CALL"},
+		},
+		{
+			name:         "missing final delimiter",
+			sql:          "INSERT INTO t VALUES (1);\nUPDATE t SET a = 2\n",
+			want:         []string{"INSERT"},
+			unterminated: true,
+		},
+		{
+			name:         "unterminated string",
+			sql:          "INSERT INTO t VALUES ('abc);\n",
+			unterminated: true,
+		},
+		{
+			name:         "unterminated block comment",
+			sql:          "SELECT 1; /* trailing",
+			want:         []string{"SELECT"},
+			unterminated: true,
+		},
+		{
+			name: "trailing comment after last statement",
+			sql:  "SELECT 1;\n-- selesai\n",
+			want: []string{"SELECT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keywords, unterminated := scanSQLStatements([]byte(tt.sql))
+			var got []string
+			for _, kw := range keywords {
+				got = append(got, kw.first)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("keywords = %v, want %v", got, tt.want)
+			}
+			if unterminated != tt.unterminated {
+				t.Fatalf("unterminated = %v, want %v", unterminated, tt.unterminated)
+			}
+		})
+	}
+}
+
+func TestStatementKeyword(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want sqlKeyword
+		ok   bool
+	}{
+		{stmt: "  \n", ok: false},
+		{stmt: "select 1", want: sqlKeyword{first: "SELECT", second: "1"}, ok: true},
+		{stmt: "set @@autocommit=0", want: sqlKeyword{first: "SET", second: "AUTOCOMMIT"}, ok: true},
+		{stmt: "create temporary table t (a int)", want: sqlKeyword{first: "CREATE", second: "TEMPORARY", temporary: true}, ok: true},
+		{stmt: "DROP TEMPORARY TABLE t", want: sqlKeyword{first: "DROP", second: "TEMPORARY", temporary: true}, ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.stmt, func(t *testing.T) {
+			got, ok := statementKeyword(tt.stmt)
+			if ok != tt.ok || got != tt.want {
+				t.Fatalf("statementKeyword(%q) = %+v, %v; want %+v, %v", tt.stmt, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestInspectSQLScripts(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       []string
+		wantBlocker string
+		wantErr     bool
+	}{
+		{name: "dml only", files: []string{"INSERT INTO t VALUES (1);\n", "UPDATE t SET a = 1;\n"}},
+		{name: "temporary table allowed", files: []string{"CREATE TEMPORARY TABLE tmp (a INT);\nDROP TEMPORARY TABLE tmp;\n"}},
+		{name: "ddl blocks transaction", files: []string{"INSERT INTO t VALUES (1);\n", "ALTER TABLE t ADD b INT;\n"}, wantBlocker: "002.sql (ALTER)"},
+		{name: "versioned ddl blocks transaction", files: []string{"/*!40000 ALTER TABLE t DISABLE KEYS */;\n"}, wantBlocker: "001.sql (ALTER)"},
+		{name: "call blocks transaction", files: []string{"CALL cleanup();\n"}, wantBlocker: "001.sql (CALL)"},
+		{name: "execute blocks transaction", files: []string{"PREPARE s FROM 'DROP TABLE t';\nEXECUTE s;\n"}, wantBlocker: "001.sql (EXECUTE)"},
+		{name: "set autocommit blocks transaction", files: []string{"SET autocommit=1;\n"}, wantBlocker: "001.sql (SET autocommit)"},
+		{name: "unterminated file", files: []string{"INSERT INTO t VALUES (1)\n"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			var scripts []sqlScript
+			for i, content := range tt.files {
+				name := fmt.Sprintf("%03d.sql", i+1)
+				p := filepath.Join(dir, name)
+				if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				scripts = append(scripts, sqlScript{Name: name, Path: p})
+			}
+			err := inspectSQLScripts(scripts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("inspectSQLScripts err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			for _, sc := range scripts {
+				if len(sc.Hash) != 64 {
+					t.Fatalf("%s: hash = %q, want sha256 hex", sc.Name, sc.Hash)
+				}
+			}
+			if blocker := transactionBlocker(scripts); blocker != tt.wantBlocker {
+				t.Fatalf("blocker = %q, want %q", blocker, tt.wantBlocker)
+			}
+		})
+	}
+}
+
+func TestPlanSQLScripts(t *testing.T) {
+	scripts := []sqlScript{{Name: "001.sql", Hash: "h1"}, {Name: "002.sql", Hash: "h2"}, {Name: "003.sql", Hash: "h3"}}
+	tests := []struct {
+		name        string
+		runs        []sqlScriptRun
+		force       bool
+		wantPending []string
+		wantApplied []string
+		wantErr     string
+	}{
+		{name: "fresh database", wantPending: []string{"001.sql", "002.sql", "003.sql"}},
+		{
+			name:        "new file appended to applied bundle",
+			runs:        []sqlScriptRun{{Name: "001.sql", Hash: "h1", Status: sqlRunStatusSuccess}, {Name: "002.sql", Hash: "h2", Status: sqlRunStatusSuccess}},
+			wantPending: []string{"003.sql"},
+			wantApplied: []string{"001.sql", "002.sql"},
+		},
+		{
+			name:        "failed inside transaction can be retried",
+			runs:        []sqlScriptRun{{Name: "001.sql", Hash: "h1", Status: sqlRunStatusFailed, Transaction: true}},
+			wantPending: []string{"001.sql", "002.sql", "003.sql"},
+		},
+		{
+			name:    "failed without transaction is refused",
+			runs:    []sqlScriptRun{{Name: "001.sql", Hash: "h1", Status: sqlRunStatusSuccess}, {Name: "002.sql", Hash: "h2", Status: sqlRunStatusFailed}},
+			wantErr: "002.sql (gagal tanpa transaksi",
+		},
+		{
+			name:    "edited file still refused after non-transactional failure",
+			runs:    []sqlScriptRun{{Name: "002.sql", Hash: "old", Status: sqlRunStatusFailed}},
+			wantErr: "002.sql",
+		},
+		{
+			name:    "running row is refused",
+			runs:    []sqlScriptRun{{Name: "001.sql", Hash: "h1", Status: sqlRunStatusRunning, Transaction: true}},
+			wantErr: "001.sql (running",
+		},
+		{
+			name:        "force reruns blocked files",
+			runs:        []sqlScriptRun{{Name: "001.sql", Hash: "h1", Status: sqlRunStatusSuccess}, {Name: "002.sql", Hash: "h2", Status: sqlRunStatusRunning}},
+			force:       true,
+			wantPending: []string{"002.sql", "003.sql"},
+			wantApplied: []string{"001.sql"},
+		},
+		{
+			name:        "later success clears earlier failure",
+			runs:        []sqlScriptRun{{Name: "001.sql", Hash: "h1", Status: sqlRunStatusFailed}, {Name: "001.sql", Hash: "h1", Status: sqlRunStatusSuccess}},
+			wantPending: []string{"002.sql", "003.sql"},
+			wantApplied: []string{"001.sql"},
+		},
+		{
+			name:        "success after renaming keeps file applied",
+			runs:        []sqlScriptRun{{Name: "01_init.sql", Hash: "h1", Status: sqlRunStatusSuccess}},
+			wantPending: []string{"002.sql", "003.sql"},
+			wantApplied: []string{"01_init.sql"},
+		},
+	}
+
+	names := func(scripts []sqlScript) []string {
+		var out []string
+		for _, sc := range scripts {
+			out = append(out, sc.Name)
+		}
+		return out
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pending, applied, err := planSQLScripts(scripts, tt.runs, tt.force)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("planSQLScripts() error = %v, want mengandung %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("planSQLScripts() error = %v", err)
+			}
+			if got := names(pending); !slices.Equal(got, tt.wantPending) {
+				t.Fatalf("pending = %v, want %v", got, tt.wantPending)
+			}
+			var gotApplied []string
+			for _, r := range applied {
+				gotApplied = append(gotApplied, r.Name)
+			}
+			if !slices.Equal(gotApplied, tt.wantApplied) {
+				t.Fatalf("applied = %v, want %v", gotApplied, tt.wantApplied)
+			}
+		})
+	}
+}
+
+func TestSQLRunResults(t *testing.T) {
+	scriptErr := &sqlScriptError{Index: 1, Name: "002.sql", Line: 3, Err: errors.New("exit status 1")}
+	otherErr := errors.New("koneksi terputus")
+	tests := []struct {
+		name        string
+		runErr      error
+		transaction bool
+		wantRan     int
+		wantFailed  []int
+	}{
+		{name: "success", wantRan: 3},
+		{name: "error mapped to file", runErr: scriptErr, wantRan: 2, wantFailed: []int{1}},
+		{name: "transaction fails every file", runErr: scriptErr, transaction: true, wantRan: 3, wantFailed: []int{0, 1, 2}},
+		{name: "unmapped error fails every file", runErr: otherErr, wantRan: 3, wantFailed: []int{0, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, ran := sqlRunResults(3, tt.runErr, tt.transaction)
+			if ran != tt.wantRan {
+				t.Fatalf("ran = %d, want %d", ran, tt.wantRan)
+			}
+			var failed []int
+			for i, err := range results[:ran] {
+				if err != nil {
+					failed = append(failed, i)
+				}
+			}
+			if !slices.Equal(failed, tt.wantFailed) {
+				t.Fatalf("failed = %v, want %v", failed, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestVersionedCommentBody(t *testing.T) {
+	tests := []struct {
+		comment string
+		want    string
+		ok      bool
+	}{
+		{comment: "!50003 CREATE", want: " CREATE", ok: true},
+		{comment: "!SET NAMES utf8", want: "SET NAMES utf8", ok: true},
+		{comment: "M!100100 ALTER", want: " ALTER", ok: true},
+		{comment: " plain comment ", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.comment, func(t *testing.T) {
+			got, ok := versionedCommentBody(tt.comment)
+			if ok != tt.ok || got != tt.want {
+				t.Fatalf("versionedCommentBody(%q) = %q, %v; want %q, %v", tt.comment, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
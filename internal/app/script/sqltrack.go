@@ -0,0 +1,239 @@
+// File : internal/app/script/sqltrack.go
+// Deskripsi : Tabel tracking bundle SQL di database target (hash per file, ticket, hasil) agar file tidak diterapkan dua kali
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package script
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	profileconn "sfdbtools/internal/app/profile/connection"
+	"sfdbtools/internal/shared/database"
+)
+
+// sqlTrackingTable adalah tabel tracking di database target.
+const sqlTrackingTable = "sfdbtools_script_runs"
+
+// Status run di tabel tracking.
+const (
+	sqlRunStatusRunning = "running"
+	sqlRunStatusSuccess = "success"
+	sqlRunStatusFailed  = "failed"
+)
+
+// sqlTracker mencatat eksekusi bundle SQL, satu row per file. Koneksinya memegang GET_LOCK per database
+// selama script berjalan agar dua proses (mis. fleet yang dijalankan ganda) tidak menerapkan bundle SQL
+// ke database yang sama bersamaan.
+type sqlTracker struct {
+	client   *database.Client
+	conn     *sql.Conn
+	database string
+	lockName string
+}
+
+// sqlScriptRun adalah satu row tabel tracking (satu file dalam satu run).
+type sqlScriptRun struct {
+	ID          int64
+	Name        string
+	Hash        string
+	Status      string
+	Transaction bool
+	Ticket      string
+	ExecutedBy  string
+	StartedAt   time.Time
+	FinishedAt  time.Time
+}
+
+// openSQLTracker membuka koneksi ke database target (lewat tunnel yang sudah aktif), memastikan tabel
+// tracking ada, lalu mengambil lock bundle SQL untuk database tersebut.
+func openSQLTracker(ctx context.Context, opts RunOptions) (*sqlTracker, error) {
+	info := profileconn.EffectiveDBInfo(opts.Profile)
+	timeout := opts.ConnectTimeout
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	client, err := database.NewClient(ctx, database.Config{
+		Host:                 info.Host,
+		Port:                 info.Port,
+		User:                 info.User,
+		Password:             info.Password,
+		AllowNativePasswords: true,
+		ParseTime:            true,
+		Database:             opts.Database,
+		TLS:                  info.TLS,
+		TLSServerName:        opts.Profile.DBInfo.Host,
+		Socket:               info.Socket,
+	}, timeout, 2, 1, 0)
+	if err != nil {
+		return nil, fmt.Errorf("gagal koneksi ke database %s untuk tracking: %w", opts.Database, err)
+	}
+
+	t := &sqlTracker{client: client, database: opts.Database}
+	create := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		id BIGINT NOT NULL AUTO_INCREMENT,
+		script_name VARCHAR(255) NOT NULL,
+		script_hash VARCHAR(80) NOT NULL,
+		bundle VARCHAR(255) NOT NULL DEFAULT '',
+		ticket VARCHAR(128) NOT NULL DEFAULT '',
+		profile_name VARCHAR(255) NOT NULL DEFAULT '',
+		executed_by VARCHAR(255) NOT NULL DEFAULT '',
+		use_transaction TINYINT(1) NOT NULL DEFAULT 0,
+		status VARCHAR(16) NOT NULL,
+		error_message TEXT NULL,
+		started_at DATETIME NOT NULL,
+		finished_at DATETIME NULL,
+		PRIMARY KEY (id),
+		KEY idx_script_runs_name (script_name),
+		KEY idx_script_runs_hash (script_hash, status)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`, t.table())
+	if _, err := client.ExecContextWithRetry(ctx, create); err != nil {
+		t.Close()
+		return nil, fmt.Errorf("gagal membuat tabel tracking %s: %w", sqlTrackingTable, err)
+	}
+
+	conn, err := client.DB().Conn(ctx)
+	if err != nil {
+		t.Close()
+		return nil, fmt.Errorf("gagal membuka koneksi tracking: %w", err)
+	}
+	t.conn = conn
+	// Lock berlaku global di server dan namanya dibatasi 64 karakter, jadi nama database di-hash.
+	sum := sha256.Sum256([]byte(opts.Database))
+	t.lockName = "sfdbtools_script:" + hex.EncodeToString(sum[:])[:40]
+	var got sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", t.lockName).Scan(&got); err != nil {
+		t.Close()
+		return nil, fmt.Errorf("gagal mengambil lock script: %w", err)
+	}
+	if got.Int64 != 1 {
+		t.lockName = ""
+		t.Close()
+		return nil, fmt.Errorf("bundle SQL lain sedang dijalankan proses lain pada database %s", opts.Database)
+	}
+	return t, nil
+}
+
+func (t *sqlTracker) table() string {
+	return fmt.Sprintf("`%s`.`%s`", strings.ReplaceAll(t.database, "`", "``"), sqlTrackingTable)
+}
+
+// Runs mengembalikan riwayat row (urut id naik) untuk file yang nama atau hash-nya sama dengan scripts.
+func (t *sqlTracker) Runs(ctx context.Context, scripts []sqlScript) ([]sqlScriptRun, error) {
+	marks := strings.TrimSuffix(strings.Repeat("?,", len(scripts)), ",")
+	args := make([]interface{}, 0, 2*len(scripts))
+	for _, sc := range scripts {
+		args = append(args, sc.Name)
+	}
+	for _, sc := range scripts {
+		args = append(args, sc.Hash)
+	}
+	query := fmt.Sprintf(`SELECT id, script_name, script_hash, status, use_transaction, ticket, executed_by, started_at, finished_at
+		FROM %s WHERE script_name IN (%s) OR script_hash IN (%s) ORDER BY id`, t.table(), marks, marks)
+	rows, err := t.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca tabel tracking: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []sqlScriptRun
+	for rows.Next() {
+		var (
+			run      sqlScriptRun
+			finished sql.NullTime
+		)
+		if err := rows.Scan(&run.ID, &run.Name, &run.Hash, &run.Status, &run.Transaction, &run.Ticket, &run.ExecutedBy, &run.StartedAt, &finished); err != nil {
+			return nil, fmt.Errorf("gagal membaca tabel tracking: %w", err)
+		}
+		run.FinishedAt = finished.Time
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("gagal membaca tabel tracking: %w", err)
+	}
+	return runs, nil
+}
+
+// Start mencatat satu row berstatus running per file (dalam satu transaksi) dan mengembalikan id-nya
+// sesuai urutan scripts. Row running yang tertinggal (proses mati) menahan run berikutnya.
+func (t *sqlTracker) Start(ctx context.Context, opts RunOptions, scripts []sqlScript, transaction bool) ([]int64, error) {
+	tx, err := t.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("gagal mencatat run di tabel tracking: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := fmt.Sprintf(`INSERT INTO %s
+		(script_name, script_hash, bundle, ticket, profile_name, executed_by, use_transaction, status, started_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, t.table())
+	now := time.Now()
+	ids := make([]int64, 0, len(scripts))
+	for _, sc := range scripts {
+		res, err := tx.ExecContext(ctx, query, sc.Name, sc.Hash, filepath.Base(opts.FilePath),
+			opts.Ticket, opts.Profile.Name, executedBy(), transaction, sqlRunStatusRunning, now)
+		if err != nil {
+			return nil, fmt.Errorf("gagal mencatat run %s di tabel tracking: %w", sc.Name, err)
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("gagal mencatat run %s di tabel tracking: %w", sc.Name, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("gagal mencatat run di tabel tracking: %w", err)
+	}
+	return ids, nil
+}
+
+// Finish memperbarui status run. Memakai context baru agar hasil tetap tercatat saat run dibatalkan.
+func (t *sqlTracker) Finish(id int64, runErr error) error {
+	status := sqlRunStatusSuccess
+	var msg sql.NullString
+	if runErr != nil {
+		status = sqlRunStatusFailed
+		msg = sql.NullString{String: runErr.Error(), Valid: true}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	query := fmt.Sprintf("UPDATE %s SET status = ?, error_message = ?, finished_at = ? WHERE id = ?", t.table())
+	if _, err := t.conn.ExecContext(ctx, query, status, msg, time.Now(), id); err != nil {
+		return fmt.Errorf("gagal memperbarui tabel tracking (id %d): %w", id, err)
+	}
+	return nil
+}
+
+// Discard menghapus row file yang tidak sempat dijalankan karena file sebelumnya gagal.
+func (t *sqlTracker) Discard(id int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = ?", t.table())
+	if _, err := t.conn.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("gagal menghapus row tracking (id %d): %w", id, err)
+	}
+	return nil
+}
+
+// Close melepas lock dan menutup koneksi tracking.
+func (t *sqlTracker) Close() {
+	if t == nil {
+		return
+	}
+	if t.conn != nil {
+		if t.lockName != "" {
+			_, _ = t.conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", t.lockName)
+		}
+		_ = t.conn.Close()
+	}
+	if t.client != nil {
+		_ = t.client.Close()
+	}
+}
@@ -2,10 +2,17 @@
 // Deskripsi : Type definitions untuk script operations
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 21 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package script
 
+import (
+	"time"
+
+	"sfdbtools/internal/domain"
+	appconfig "sfdbtools/internal/services/config"
+)
+
 // ========================
 // CLI Options Structs
 // ========================
@@ -19,6 +26,8 @@ type EncryptOptions struct {
 	Mode          string
 	OutputPath    string
 	DeleteSource  bool
+	SignKey       string // path private key ed25519 untuk menandatangani bundle (opsional)
+	SpecPath      string // file spec parameter/requirement (default: sftools.yaml di folder entrypoint)
 }
 
 // RunOptions menyimpan opsi untuk run bundle.
@@ -26,19 +35,47 @@ type RunOptions struct {
 	FilePath      string
 	EncryptionKey string
 	Args          []string
+
+	// Interactive mengizinkan prompt parameter yang belum diisi; PromptArgs meminta args bebas
+	// (hanya untuk bundle tanpa deklarasi parameter) jika Args kosong.
+	Interactive bool
+	PromptArgs  bool
+
+	// TrustedSigners diisi dari config script.trusted_signers; AllowUntrusted melewati penolakan
+	// bundle tanpa tanda tangan/signer tak dikenal.
+	TrustedSigners []appconfig.ScriptTrustedSigner
+	AllowUntrusted bool
+
+	// ProfilePath/ProfileKey dari flag --profile/--profile-key; command layer me-load-nya menjadi Profile.
+	// Jika Profile terisi, script mendapat koneksinya lewat env SFDB_DB_* dan defaults-file sementara.
+	ProfilePath    string
+	ProfileKey     string
+	Profile        *domain.ProfileInfo
+	Database       string
+	Ticket         string
+	ConnectTimeout time.Duration
+
+	// Transaction membungkus bundle SQL dalam satu transaksi (ditolak jika ada statement implicit commit).
+	// ForceRerun menjalankan ulang file SQL yang tercatat running atau gagal tanpa transaksi.
+	Transaction bool
+	ForceRerun  bool
 }
 
 // ExtractOptions menyimpan opsi untuk extract bundle.
 type ExtractOptions struct {
-	FilePath      string
-	EncryptionKey string
-	OutDir        string
+	FilePath       string
+	EncryptionKey  string
+	OutDir         string
+	TrustedSigners []appconfig.ScriptTrustedSigner
+	AllowUntrusted bool
 }
 
 // InfoOptions menyimpan opsi untuk info bundle.
 type InfoOptions struct {
-	FilePath      string
-	EncryptionKey string
+	FilePath       string
+	EncryptionKey  string
+	TrustedSigners []appconfig.ScriptTrustedSigner
+	AllowUntrusted bool
 }
 
 // ========================
@@ -46,12 +83,24 @@ type InfoOptions struct {
 // ========================
 
 // manifest represents bundle metadata stored in .sftools-manifest.json
+// Entrypoint "." (versi 3) berarti folder SQL: semua .sql di root bundle dijalankan berurutan.
 type manifest struct {
 	Version    int    `json:"version"`
 	Entrypoint string `json:"entrypoint"`
 	CreatedAt  string `json:"created_at"`
 	Mode       string `json:"mode,omitempty"`
 	RootDir    string `json:"root_dir,omitempty"`
+
+	// Digest adalah sha256 isi bundle (lihat bundleDigest); SignerID dan Signature terisi jika
+	// bundle ditandatangani (script encrypt --sign-key).
+	Digest    string `json:"digest,omitempty"`
+	SignerID  string `json:"signer_id,omitempty"`
+	Signature string `json:"signature,omitempty"`
+
+	// Versi 2: dokumentasi, deklarasi parameter, dan requirement host (dari file spec saat encrypt).
+	Description string              `json:"description,omitempty"`
+	Params      []BundleParam       `json:"params,omitempty"`
+	Requires    *BundleRequirements `json:"requires,omitempty"`
 }
 
 // BundleInfo represents metadata information about a script bundle.
@@ -63,6 +112,12 @@ type BundleInfo struct {
 	RootDir    string   `json:"root_dir"`
 	Scripts    []string `json:"scripts"`
 	FileCount  int      `json:"file_count"`
+	Trust      BundleTrust
+	SQL        bool // bundle SQL (entrypoint .sql atau folder .sql)
+
+	Description string
+	Params      []BundleParam
+	Requires    *BundleRequirements
 }
 
 // ========================
@@ -73,8 +128,14 @@ const (
 	// manifestFilename adalah nama file manifest dalam bundle
 	manifestFilename = ".sftools-manifest.json"
 
-	// bundleVersion adalah versi format bundle
-	bundleVersion = 1
+	// bundleVersion adalah versi format bundle terbaru yang bisa dibaca (2 = parameter + requirement,
+	// 3 = entrypoint SQL). Bundle ditulis dengan versi terendah yang dibutuhkan (lihat manifestVersion).
+	// minBundleVersion adalah versi terlama yang masih bisa dibaca.
+	bundleVersion    = 3
+	minBundleVersion = 1
+
+	// bundleSpecFilename adalah file spec default di folder entrypoint (tidak ikut dibundle).
+	bundleSpecFilename = "sftools.yaml"
 
 	// BundleExtension adalah ekstensi file bundle
 	BundleExtension = ".sftools"
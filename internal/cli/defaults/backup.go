@@ -4,6 +4,7 @@ import (
 	backuppath "sfdbtools/internal/app/backup/helpers/path"
 	"sfdbtools/internal/app/backup/model/types_backup"
 	appconfig "sfdbtools/internal/services/config"
+	"time"
 )
 
 // DefaultBackupOptions mengembalikan default options untuk database backup
@@ -80,3 +81,17 @@ func DefaultBackupOptions(mode string) types_backup.BackupDBOptions {
 
 	return opts
 }
+
+// DefaultBackupCoverageOptions mengembalikan default options untuk db-backup coverage.
+// Direktori backup default adalah base directory backup (tanpa pattern tanggal) agar pemindaian rekursif.
+func DefaultBackupCoverageOptions() types_backup.CoverageOptions {
+	opts := types_backup.CoverageOptions{MaxAge: 26 * time.Hour}
+
+	cfg, err := appconfig.LoadConfigFromEnv()
+	if err != nil || cfg == nil {
+		return opts
+	}
+	opts.BackupDir = cfg.Backup.Output.BaseDirectory
+	opts.ExcludeDatabases = append(opts.ExcludeDatabases, cfg.Backup.Exclude.Databases...)
+	return opts
+}
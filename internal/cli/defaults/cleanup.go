@@ -1,13 +1,26 @@
 package defaultVal
 
 import (
+	"fmt"
+	"strings"
+
 	cleanupmodel "sfdbtools/internal/app/cleanup/model"
 	appconfig "sfdbtools/internal/services/config"
+
+	"github.com/dustin/go-humanize"
 )
 
 // DefaultCleanupOptions mengembalikan opsi default untuk pembersihan backup.
 // Aman dipanggil saat inisialisasi (init) karena tidak akan panic jika konfigurasi belum tersedia.
+// Error konfigurasi (mis. max_total tidak valid) diabaikan di sini dan dilaporkan oleh CleanupConfigOptions.
 func DefaultCleanupOptions() cleanupmodel.CleanupOptions {
+	opts, _ := CleanupConfigOptions("")
+	return opts
+}
+
+// CleanupConfigOptions membaca opsi cleanup dari config: backup.cleanup, atau backup.scheduler.jobs[]
+// jika job diisi (direktori output dan strategi cleanup job tersebut).
+func CleanupConfigOptions(job string) (cleanupmodel.CleanupOptions, error) {
 	// Inisialisasi dengan nilai paling aman (disabled) agar tidak ada operasi berbahaya terjadi tanpa konfigurasi.
 	opts := cleanupmodel.CleanupOptions{
 		Enabled:         false,
@@ -16,17 +29,57 @@ func DefaultCleanupOptions() cleanupmodel.CleanupOptions {
 		Pattern:         "",
 	}
 
-	// Muat konfigurasi aplikasi (abaikan error; jika gagal kita pakai nilai aman di atas).
+	// Muat konfigurasi aplikasi (jika gagal kita pakai nilai aman di atas).
 	cfg, err := appconfig.LoadConfigFromEnv()
 	if err != nil || cfg == nil {
-		return opts
+		if job != "" {
+			return opts, fmt.Errorf("gagal memuat konfigurasi untuk job %s: %v", job, err)
+		}
+		return opts, nil
+	}
+
+	if job == "" {
+		opts.Enabled = cfg.Backup.Cleanup.Enabled
+		opts.CleanupSchedule = cfg.Backup.Cleanup.Schedule
+		// Pattern dibiarkan kosong (bisa diubah via flag saat runtime)
+		return opts, applyCleanupConfig(&opts, cfg.Backup.Cleanup, "backup.cleanup")
 	}
 
-	// Set nilai dari konfigurasi jika tersedia.
-	opts.Enabled = cfg.Backup.Cleanup.Enabled
-	opts.Days = cfg.Backup.Cleanup.Days
-	opts.CleanupSchedule = cfg.Backup.Cleanup.Schedule
-	// Pattern dibiarkan kosong (bisa diubah via flag saat runtime)
+	for _, j := range cfg.Backup.Scheduler.Jobs {
+		if j.Name != job {
+			continue
+		}
+		if !j.Cleanup.Enabled {
+			return opts, fmt.Errorf("cleanup tidak diaktifkan untuk job %s (backup.scheduler.jobs[].cleanup.enabled)", job)
+		}
+		opts.Enabled = true
+		opts.CleanupSchedule = j.Schedule
+		opts.BaseDirectory = strings.TrimSpace(j.Output.BaseDirectory)
+		c := j.Cleanup.CleanupConfig
+		if j.Cleanup.RetentionDays > 0 {
+			c.Days = j.Cleanup.RetentionDays
+		}
+		return opts, applyCleanupConfig(&opts, c, fmt.Sprintf("backup.scheduler.jobs[%s].cleanup", job))
+	}
+	return opts, fmt.Errorf("job %s tidak ditemukan di backup.scheduler.jobs", job)
+}
 
-	return opts
+// applyCleanupConfig menyalin strategi retensi dari section config ke opts.
+func applyCleanupConfig(opts *cleanupmodel.CleanupOptions, c appconfig.CleanupConfig, section string) error {
+	opts.Days = c.Days
+	opts.Retention = cleanupmodel.RetentionPolicy{
+		KeepDaily:   c.KeepDaily,
+		KeepWeekly:  c.KeepWeekly,
+		KeepMonthly: c.KeepMonthly,
+		KeepYearly:  c.KeepYearly,
+	}
+	opts.KeepLast = c.KeepLast
+	if v := strings.TrimSpace(c.MaxTotal); v != "" {
+		size, err := humanize.ParseBytes(v)
+		if err != nil || size == 0 {
+			return fmt.Errorf("%s.max_total tidak valid: %q (contoh: 500GB, 2TB)", section, v)
+		}
+		opts.MaxTotalBytes = int64(size)
+	}
+	return nil
 }
@@ -39,6 +39,7 @@ func GetDefaultScanOptions(mode string) dbscanmodel.ScanOptions {
 	// Output Options
 	opts.DisplayResults = true
 	opts.ShowOptions = true
+	opts.TopTables = 20
 
 	// Mode
 	opts.Mode = mode
@@ -110,3 +110,31 @@ func PrimaryBackupFlags(cmd *cobra.Command, defaultOpts *types_backup.BackupDBOp
 	addBackupCompanionFlags(cmd, defaultOpts)
 	cmd.Flags().StringVar(&defaultOpts.ClientCode, "client-code", defaultOpts.ClientCode, "Filter database primary berdasarkan client code (contoh: adaro)")
 }
+
+// AddBackupCoverageFlags menambahkan flags untuk laporan db-backup coverage.
+func AddBackupCoverageFlags(cmd *cobra.Command, opts *types_backup.CoverageOptions) {
+	AddProfileFlags(cmd, &opts.Profile)
+	cmd.Flags().StringVarP(&opts.BackupDir, "backup-dir", "o", opts.BackupDir, "Direktori backup yang diperiksa (rekursif) (default: dari config)")
+	cmd.Flags().String("max-age", opts.MaxAge.String(), "Batas umur backup sukses terakhir sebelum dianggap stale (contoh: 26h, 90m)")
+	cmd.Flags().StringArray("exclude-db", nil, "Database yang tidak diperiksa (mendukung wildcard, dapat diulang). Ditambahkan ke backup.exclude.databases")
+	cmd.Flags().BoolVar(&opts.AllowMissingMeta, "allow-missing-meta", opts.AllowMissingMeta, "Terima file backup tanpa .meta.json sebagai backup sukses")
+}
+
+// AddBackupHoldAddFlags menambahkan flags untuk db-backup hold add.
+func AddBackupHoldAddFlags(cmd *cobra.Command) {
+	cmd.Flags().String("ticket", "", "Tahan semua backup dengan ticket ini di metadata (pengganti argumen file)")
+	cmd.Flags().String("reason", "", "Alasan hold (wajib), mis. 'audit sebelum data fix'")
+	cmd.Flags().String("owner", "", "Pemilik hold (default: user OS saat ini)")
+	cmd.Flags().String("expires", "", "Kedaluwarsa hold: tanggal (2027-01-31) atau durasi (90d, 72h). Kosong = tanpa batas")
+}
+
+// AddBackupHoldRemoveFlags menambahkan flags untuk db-backup hold remove.
+func AddBackupHoldRemoveFlags(cmd *cobra.Command) {
+	cmd.Flags().String("id", "", "ID hold yang dihapus")
+	cmd.Flags().String("ticket", "", "Hapus hold berbasis ticket ini")
+}
+
+// AddBackupHoldListFlags menambahkan flags untuk db-backup hold list.
+func AddBackupHoldListFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("all", false, "Tampilkan juga hold yang sudah kedaluwarsa")
+}
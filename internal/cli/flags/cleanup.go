@@ -3,6 +3,7 @@ package flags
 import (
 	cleanupmodel "sfdbtools/internal/app/cleanup/model"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 )
 
@@ -12,4 +13,36 @@ func AddCleanupFlags(cmd *cobra.Command, opts *cleanupmodel.CleanupOptions) {
 		"Jumlah hari untuk menyimpan backup. Backup yang lebih tua dari ini akan dihapus.")
 	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", opts.DryRun,
 		"Tampilkan pratinjau tanpa menghapus file")
+
+	cmd.Flags().StringVar(&opts.BaseDirectory, "backup-dir", opts.BaseDirectory,
+		"Direktori yang dibersihkan (default: backup.output.base_directory)")
+
+	cmd.Flags().String("job", "",
+		"Pakai direktori output dan strategi cleanup job backup.scheduler.jobs[] dengan nama ini")
+
+	// Retensi GFS per database (default dari backup.cleanup.keep_*)
+	cmd.Flags().IntVar(&opts.Retention.KeepDaily, "keep-daily", opts.Retention.KeepDaily,
+		"Jumlah hari terakhir yang dipertahankan (GFS, per database)")
+	cmd.Flags().IntVar(&opts.Retention.KeepWeekly, "keep-weekly", opts.Retention.KeepWeekly,
+		"Jumlah minggu terakhir yang dipertahankan (GFS, per database)")
+	cmd.Flags().IntVar(&opts.Retention.KeepMonthly, "keep-monthly", opts.Retention.KeepMonthly,
+		"Jumlah bulan terakhir yang dipertahankan (GFS, per database)")
+	cmd.Flags().IntVar(&opts.Retention.KeepYearly, "keep-yearly", opts.Retention.KeepYearly,
+		"Jumlah tahun terakhir yang dipertahankan (GFS, per database)")
+
+	// Strategi keep-last / kuota ukuran (default dari backup.cleanup.keep_last / max_total)
+	cmd.Flags().IntVar(&opts.KeepLast, "keep-last", opts.KeepLast,
+		"Simpan N backup sukses terakhir per database, sisanya dihapus")
+	maxTotal := ""
+	if opts.MaxTotalBytes > 0 {
+		maxTotal = humanize.Bytes(uint64(opts.MaxTotalBytes))
+	}
+	cmd.Flags().String("max-total", maxTotal,
+		"Kuota total ukuran backup (contoh: 2TB); file terlama dihapus lebih dulu, backup terakhir database tidak dihapus")
+}
+
+// AddCleanupOrphansFlags mendefinisikan flag untuk perintah cleanup orphans
+func AddCleanupOrphansFlags(cmd *cobra.Command, opts *cleanupmodel.CleanupOptions) {
+	cmd.Flags().StringVar(&opts.BaseDirectory, "backup-dir", opts.BaseDirectory,
+		"Direktori yang diperiksa (default: backup.output.base_directory)")
 }
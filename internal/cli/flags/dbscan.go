@@ -13,6 +13,11 @@ func AddDbScanFilterFlags(cmd *cobra.Command) {
 
 	// Filters (Simple version without struct binding)
 	AddFilterFlagsSimple(cmd)
+
+	// Inventory, export & deep scan (opsional)
+	AddDbScanSaveFlags(cmd)
+	AddDbScanExportFlags(cmd)
+	AddDbScanDeepFlags(cmd)
 }
 
 // AddDbScanAllFlags mendaftarkan flags minimal untuk command `dbscan all`.
@@ -26,4 +31,76 @@ func AddDbScanAllFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("exclude-system", true, "Kecualikan system databases")
 
 	cmd.Flags().Bool("show-options", true, "Tampilkan opsi scanning yang digunakan sebelum eksekusi")
+
+	// Inventory, export & deep scan (opsional)
+	AddDbScanSaveFlags(cmd)
+	AddDbScanExportFlags(cmd)
+	AddDbScanDeepFlags(cmd)
+}
+
+// AddDbScanTablesFlags mendaftarkan flags untuk perintah `dbscan tables` (deep scan per tabel).
+func AddDbScanTablesFlags(cmd *cobra.Command) {
+	cmd.Flags().String("profile", "", "Path ke file profil database terenkripsi")
+	cmd.Flags().String("profile-key", "", "Encryption key untuk decrypt file profil database")
+	AddFilterFlagsSimple(cmd)
+	cmd.Flags().Bool("show-options", true, "Tampilkan opsi scanning yang digunakan sebelum eksekusi")
+	cmd.Flags().Int("top-tables", 20, "Jumlah tabel terbesar lintas database yang ditampilkan (0 = semua)")
+
+	AddDbScanSaveFlags(cmd)
+	AddDbScanExportFlags(cmd)
+}
+
+// AddDbScanLintFlags mendaftarkan flags untuk perintah `dbscan lint`.
+func AddDbScanLintFlags(cmd *cobra.Command) {
+	cmd.Flags().String("profile", "", "Path ke file profil database terenkripsi")
+	cmd.Flags().String("profile-key", "", "Encryption key untuk decrypt file profil database")
+	AddFilterFlagsSimple(cmd)
+	cmd.Flags().Bool("show-options", false, "Tampilkan opsi scanning dan minta konfirmasi sebelum eksekusi")
+
+	cmd.Flags().String("rules", "", "Hanya jalankan rule tertentu (comma-separated, override config dbscan.lint.rules)")
+	cmd.Flags().String("fail-on", "", "Exit non-zero jika ada temuan >= severity ini: info, warning, error, none (default: dbscan.lint.fail_on)")
+	cmd.Flags().Bool("show-ddl", false, "Tampilkan suggested DDL sebagai script SQL")
+	cmd.Flags().Bool("list-rules", false, "Tampilkan daftar rule lint yang tersedia lalu keluar")
+	AddDbScanExportFlags(cmd)
+}
+
+// AddDbScanDeepFlags mendaftarkan flag deep scan (metrik per tabel).
+// Flags: --deep, --top-tables
+func AddDbScanDeepFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("deep", false, "Kumpulkan metrik per tabel (engine, rows, data/index, fragmentasi, auto-increment)")
+	cmd.Flags().Int("top-tables", 20, "Jumlah tabel terbesar lintas database yang ditampilkan pada --deep (0 = semua)")
+}
+
+// AddDbScanSaveFlags mendaftarkan flags untuk menyimpan hasil scan ke database inventory pusat.
+// Flags: --save-to, --save-to-key, --save-db
+func AddDbScanSaveFlags(cmd *cobra.Command) {
+	cmd.Flags().String("save-to", "", "Profile server inventory untuk menyimpan hasil scan (kosong = tidak disimpan)")
+	cmd.Flags().String("save-to-key", "", "Encryption key untuk decrypt profile inventory (ENV: SFDB_TARGET_PROFILE_KEY)")
+	cmd.Flags().String("save-db", "", "Nama database inventory (default: sfdbtools_inventory)")
+}
+
+// AddDbScanExportFlags mendaftarkan flag export hasil scan ke file.
+// Flag: --export (format ditentukan dari ekstensi: .xlsx, .csv, .json)
+func AddDbScanExportFlags(cmd *cobra.Command) {
+	cmd.Flags().String("export", "", "Export hasil scan ke file (.xlsx, .csv, atau .json)")
+}
+
+// AddDbScanTrendFlags mendaftarkan flags untuk perintah `dbscan trend`.
+// Flags: --profile, --database, --days, --top, --export
+func AddDbScanTrendFlags(cmd *cobra.Command) {
+	cmd.Flags().String("profile", "", "Nama profile di histori scan (kosong = semua profile)")
+	cmd.Flags().String("database", "", "Tampilkan trend per snapshot untuk database tertentu")
+	cmd.Flags().Int("days", 90, "Jumlah hari histori yang dianalisis")
+	cmd.Flags().Int("top", 20, "Jumlah database dengan pertumbuhan tertinggi yang ditampilkan")
+	AddDbScanExportFlags(cmd)
+}
+
+// AddDbScanForecastFlags mendaftarkan flags untuk perintah `dbscan forecast`.
+// Flags: --profile, --days, --threshold, --volume-size, --export
+func AddDbScanForecastFlags(cmd *cobra.Command) {
+	cmd.Flags().String("profile", "", "Nama profile di histori scan (kosong = semua profile)")
+	cmd.Flags().Int("days", 90, "Jumlah hari histori yang dipakai untuk menghitung growth rate")
+	cmd.Flags().Float64("threshold", 0, "Batas pemakaian volume dalam persen (default: dbscan.forecast.threshold_percent)")
+	cmd.Flags().String("volume-size", "", "Kapasitas volume datadir untuk server remote (contoh: 2TB, 500GiB)")
+	AddDbScanExportFlags(cmd)
 }
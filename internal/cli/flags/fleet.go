@@ -0,0 +1,15 @@
+package flags
+
+import "github.com/spf13/cobra"
+
+// AddFleetFlags mendaftarkan flag untuk menjalankan command ke banyak profile sekaligus.
+// Flag: --profiles, --concurrency, --fleet-log-dir, --fleet-dry-run (dan --profile-key jika belum ada).
+func AddFleetFlags(cmd *cobra.Command) {
+	cmd.Flags().String("profiles", "", "Jalankan ke banyak profile via selector, mis. 'tag:env=prod', 'group:jkt', 'prod-*', 'all' (term dipisah koma = AND, prefix ! = negasi)")
+	cmd.Flags().Int("concurrency", 0, "Jumlah profile yang diproses paralel saat --profiles (default: profile.fleet.concurrency)")
+	cmd.Flags().String("fleet-log-dir", "", "Folder output per profile saat --profiles (default: profile.fleet.log_dir)")
+	cmd.Flags().Bool("fleet-dry-run", false, "Tampilkan profile yang terpilih oleh --profiles tanpa menjalankan command")
+	if cmd.Flags().Lookup("profile-key") == nil {
+		cmd.Flags().String("profile-key", "", "Kunci profile untuk membaca tag saat --profiles (ENV: SFDB_SOURCE_PROFILE_KEY)")
+	}
+}
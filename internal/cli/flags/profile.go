@@ -2,6 +2,7 @@ package flags
 
 import (
 	defaultVal "sfdbtools/internal/cli/defaults"
+	"sfdbtools/internal/shared/consts"
 
 	"github.com/spf13/cobra"
 )
@@ -24,7 +25,19 @@ func ProfileCreate(cmd *cobra.Command) {
 	cmd.Flags().String("ssh-user", "", "SSH username")
 	cmd.Flags().String("ssh-password", "", "SSH password (opsional)")
 	cmd.Flags().String("ssh-identity-file", "", "Path ke SSH private key (opsional)")
+	cmd.Flags().StringSlice("ssh-jump", []string{}, "Jump host (ProxyJump) berurutan, format [user@]host[:port] (bisa multiple/koma)")
+	cmd.Flags().Bool("ssh-agent", false, "Autentikasi SSH via ssh-agent (SSH_AUTH_SOCK), tanpa private key di disk")
 	cmd.Flags().Int("ssh-local-port", 0, "Local port untuk SSH tunnel (0 = otomatis)")
+
+	// Unix socket lokal (opsional; password boleh kosong untuk auth unix_socket)
+	cmd.Flags().String("socket", "", "Path unix socket database lokal, atau 'auto' untuk deteksi dari my.cnf/--print-defaults")
+
+	// TLS/SSL (opsional)
+	AddTLSFlags(cmd)
+
+	// Tag dan grup untuk seleksi fleet (--profiles 'tag:env=prod')
+	cmd.Flags().StringSlice("tag", []string{}, "Tag profil format key=value, mis. env=prod (bisa multiple)")
+	cmd.Flags().StringSlice("group", []string{}, "Grup profil, mis. jkt-primary (bisa multiple)")
 }
 
 // ProfileEdit - Flag untuk mengedit profil yang ada
@@ -47,7 +60,21 @@ func ProfileEdit(cmd *cobra.Command) {
 	cmd.Flags().String("ssh-user", "", "SSH username")
 	cmd.Flags().String("ssh-password", "", "SSH password (opsional)")
 	cmd.Flags().String("ssh-identity-file", "", "Path ke SSH private key (opsional)")
+	cmd.Flags().StringSlice("ssh-jump", []string{}, "Ganti rantai jump host (ProxyJump), format [user@]host[:port]; --ssh-jump \"\" untuk menghapus")
+	cmd.Flags().Bool("ssh-agent", false, "Autentikasi SSH via ssh-agent (SSH_AUTH_SOCK), tanpa private key di disk")
 	cmd.Flags().Int("ssh-local-port", 0, "Local port untuk SSH tunnel (0 = otomatis)")
+
+	// Unix socket lokal (opsional)
+	cmd.Flags().String("socket", "", "Path unix socket database lokal, 'auto' untuk deteksi, atau 'none' untuk kembali ke host/port")
+
+	// TLS/SSL (opsional; --ssl-mode disabled menghapus CA/cert/key)
+	AddTLSFlags(cmd)
+
+	// Tag dan grup
+	cmd.Flags().StringSlice("tag", []string{}, "Tambah/ubah tag format key=value (bisa multiple)")
+	cmd.Flags().StringSlice("untag", []string{}, "Hapus tag berdasarkan key (bisa multiple)")
+	cmd.Flags().StringSlice("group", []string{}, "Tambahkan profil ke grup (bisa multiple)")
+	cmd.Flags().StringSlice("ungroup", []string{}, "Keluarkan profil dari grup (bisa multiple)")
 }
 
 // ProfileShow - Flag untuk menampilkan profil yang ada
@@ -85,15 +112,83 @@ func ProfileClone(cmd *cobra.Command) {
 
 // ProfileImport - Flag untuk import bulk profiles dari XLSX lokal atau Google Spreadsheet.
 func ProfileImport(cmd *cobra.Command) {
-	cmd.Flags().String("input", "", "Path file XLSX lokal untuk import (mutually exclusive dengan --gsheet)")
+	cmd.Flags().String("input", "", "Path file XLSX/CSV atau manifest YAML/JSON lokal untuk import (mutually exclusive dengan --gsheet)")
+	cmd.Flags().String("input-password", "", "Password workbook XLSX terenkripsi (hasil profile export --encrypt-key)")
 	cmd.Flags().String("sheet", "Profiles", "Nama sheet di XLSX (default: Profiles; kosong = sheet pertama)")
 
 	cmd.Flags().String("gsheet", "", "Google Spreadsheet URL (format edit/share). Akan diambil via export CSV")
 	cmd.Flags().Int("gid", 0, "Google sheet tab gid untuk export CSV (default: 0)")
 
+	cmd.Flags().String("from-mycnf", "", "Import section client dari option file MySQL/MariaDB (mis. /root/.my.cnf, /etc/mysql/debian.cnf)")
+	cmd.Flags().String("from-login-path", "", "Import login path mysql_config_editor (default file: $MYSQL_TEST_LOGIN_FILE atau ~/.mylogin.cnf; file lain: --from-login-path=<file>)")
+	cmd.Flags().Lookup("from-login-path").NoOptDefVal = consts.ProfileImportLoginPathDefault
+	cmd.Flags().StringP("profile-key", "k", "", "Kunci enkripsi profile untuk manifest (default profile_key), --from-mycnf, dan --from-login-path (ENV: SFDB_TARGET_PROFILE_KEY)")
+
 	cmd.Flags().String("on-conflict", "fail", "Aksi saat file profile sudah ada: fail|skip|overwrite|rename (default: fail)")
 	cmd.Flags().Bool("skip-confirm", false, "Skip semua prompt/konfirmasi (wajib untuk automation)")
 	cmd.Flags().Bool("skip-invalid-rows", false, "Skip baris yang invalid (default: false, fail-fast)")
 	cmd.Flags().Bool("continue-on-error", false, "Lanjut proses meski ada error per-row saat conn-test/save")
 	cmd.Flags().Bool("skip-conn-test", false, "Skip tes koneksi database (default: false; conn-test ON)")
 }
+
+// ProfileSync - Flag untuk sinkronisasi direktori profile dengan manifest.
+func ProfileSync(cmd *cobra.Command) {
+	cmd.Flags().String("input", "", "Path manifest YAML/JSON (atau XLSX/CSV) yang menjadi sumber kebenaran profile")
+	cmd.Flags().StringP("profile-key", "k", "", "Kunci enkripsi default untuk profile tanpa profile_key di manifest (ENV: SFDB_TARGET_PROFILE_KEY)")
+	cmd.Flags().Bool("prune", false, "Hapus profile yang tidak ada di manifest (mode non-interaktif)")
+	cmd.Flags().Bool("dry-run", false, "Tampilkan rencana sync tanpa menulis/menghapus apa pun")
+	cmd.Flags().Bool("skip-confirm", false, "Skip semua prompt/konfirmasi (wajib untuk automation)")
+	cmd.Flags().Bool("continue-on-error", false, "Lanjut proses meski ada error per-profile saat conn-test/save")
+	cmd.Flags().Bool("skip-conn-test", false, "Skip tes koneksi database (default: false; conn-test ON)")
+}
+
+// ProfileRekey - Flag untuk rotasi kunci enkripsi profile.
+func ProfileRekey(cmd *cobra.Command) {
+	cmd.Flags().String("old-key", "", "Kunci lama profile (ENV: SFDB_SOURCE_PROFILE_KEY)")
+	cmd.Flags().String("new-key", "", "Kunci baru profile (ENV: SFDB_TARGET_PROFILE_KEY)")
+	cmd.Flags().String("key-map", "", "File map kunci lama per profile (format per baris: nama_profile = kunci)")
+	cmd.Flags().Bool("all", false, "Rekey semua profile di config_dir.database_profile")
+	cmd.Flags().StringSliceP("profile", "f", []string{}, "Nama file profil yang akan di-rekey (bisa multiple)")
+	cmd.Flags().Bool("keep-backup", false, "Pertahankan backup file lama setelah rekey sukses")
+	cmd.Flags().Bool("skip-confirm", false, "Skip konfirmasi (wajib untuk automation)")
+}
+
+// ProfileExport - Flag untuk export profile ke XLSX/CSV.
+func ProfileExport(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", "", "File output (.xlsx atau .csv)")
+	cmd.Flags().StringP("profile-key", "k", "", "Kunci untuk mendekripsi profile (ENV: SFDB_SOURCE_PROFILE_KEY)")
+	cmd.Flags().String("key-map", "", "File map kunci per profile (format per baris: nama_profile = kunci)")
+	cmd.Flags().StringSlice("name", []string{}, "Filter nama profile dengan glob, mis. 'prod-*' (bisa multiple)")
+	cmd.Flags().Bool("redact", false, "Kosongkan password, ssh_password, dan profile_key di output")
+	cmd.Flags().String("encrypt-key", "", "Enkripsi seluruh workbook XLSX dengan password ini")
+	cmd.Flags().Bool("force", false, "Timpa file output jika sudah ada")
+}
+
+// ProfileTest - Flag untuk test koneksi profil.
+func ProfileTest(cmd *cobra.Command) {
+	cmd.Flags().StringP("profile", "f", "", "Nama file profil yang akan dites")
+	cmd.Flags().StringP("profile-key", "k", "", "Kunci enkripsi untuk mendekripsi file profil (ENV: SFDB_TARGET_PROFILE_KEY atau SFDB_SOURCE_PROFILE_KEY)")
+	cmd.Flags().Bool("all", false, "Tes semua profil di folder config")
+	cmd.Flags().String("profiles", "", "Tes banyak profil via selector, mis. 'tag:env=prod', 'group:jkt', 'prod-*' (term dipisah koma = AND, prefix ! = negasi)")
+	cmd.Flags().Int("concurrency", 0, "Jumlah profil yang dites paralel saat --all/--profiles (default: profile.fleet.concurrency)")
+	cmd.Flags().String("format", "table", "Format laporan: table atau json (json ditulis ke stdout)")
+}
+
+// ProfileDiff - Flag untuk membandingkan dua versi profil.
+func ProfileDiff(cmd *cobra.Command) {
+	cmd.Flags().StringP("profile-key", "k", "", "Kunci enkripsi untuk mendekripsi versi profil (ENV: SFDB_TARGET_PROFILE_KEY atau SFDB_SOURCE_PROFILE_KEY)")
+}
+
+// ProfileRollback - Flag untuk mengembalikan profil ke versi lama.
+func ProfileRollback(cmd *cobra.Command) {
+	cmd.Flags().StringP("profile-key", "k", "", "Kunci enkripsi untuk mendekripsi versi profil (ENV: SFDB_TARGET_PROFILE_KEY atau SFDB_SOURCE_PROFILE_KEY)")
+	cmd.Flags().Bool("skip-confirm", false, "Rollback tanpa konfirmasi")
+}
+
+// ProfileSSHTrust - Flag untuk memindai dan mem-pin host key SSH profil
+func ProfileSSHTrust(cmd *cobra.Command) {
+	cmd.Flags().StringP("profile", "f", "", "Nama file profil yang host key SSH-nya akan dipercaya")
+	cmd.Flags().StringP("profile-key", "k", "", "Kunci enkripsi untuk mendekripsi file profil (ENV: SFDB_TARGET_PROFILE_KEY atau SFDB_SOURCE_PROFILE_KEY)")
+	cmd.Flags().StringSlice("fingerprint", []string{}, "Fingerprint SHA256 host key yang diharapkan, mis. SHA256:abc... (bisa multiple)")
+	cmd.Flags().Bool("skip-confirm", false, "Pin host key yang belum dikenal tanpa konfirmasi (host key yang berubah wajib --fingerprint)")
+}
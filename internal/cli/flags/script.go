@@ -3,13 +3,15 @@ package flags
 import "github.com/spf13/cobra"
 
 func AddScriptEncryptFlags(cmd *cobra.Command) {
-	cmd.Flags().StringP("file", "f", "", "Path file entrypoint (.sh) (wajib)")
+	cmd.Flags().StringP("file", "f", "", "Path file entrypoint (.sh/.sql) atau folder berisi file .sql berurutan (wajib)")
 	cmd.Flags().StringP("key", "k", "", "Encryption key (opsional, jika kosong pakai env SFDB_SCRIPT_KEY atau prompt)")
 	cmd.Flags().String("encryption-key", "", "(deprecated) Gunakan --key atau -k")
 	_ = cmd.Flags().MarkHidden("encryption-key")
 	cmd.Flags().StringP("mode", "m", "bundle", "Mode encrypt: bundle|single")
 	cmd.Flags().String("output", "", "Path output file .sftools (opsional, jika kosong pakai config YAML/otomatis)")
 	cmd.Flags().Bool("delete-source", false, "Hapus sumber setelah encrypt berhasil (single=file, bundle=folder) (opsional)")
+	cmd.Flags().String("spec", "", "File spec parameter/requirement bundle (default: sftools.yaml di folder entrypoint jika ada)")
+	cmd.Flags().String("sign-key", "", "Path private key ed25519 untuk menandatangani bundle (ENV: SFDB_SCRIPT_SIGN_KEY)")
 }
 
 func AddScriptRunFlags(cmd *cobra.Command) {
@@ -17,6 +19,13 @@ func AddScriptRunFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("key", "k", "", "Encryption key (opsional, jika kosong pakai env SFDB_SCRIPT_KEY atau prompt)")
 	cmd.Flags().String("encryption-key", "", "(deprecated) Gunakan --key atau -k")
 	_ = cmd.Flags().MarkHidden("encryption-key")
+	addScriptAllowUntrustedFlag(cmd)
+	cmd.Flags().StringP("profile", "p", "", "Profile database yang koneksinya diberikan ke script (env SFDB_DB_* + SFDB_DB_DEFAULTS_FILE)")
+	cmd.Flags().String("profile-key", "", "Kunci enkripsi profile (ENV: SFDB_SOURCE_PROFILE_KEY)")
+	cmd.Flags().StringP("database", "d", "", "Database default untuk script (env SFDB_DB_NAME) saat --profile")
+	cmd.Flags().StringP("ticket", "t", "", "Ticket number untuk audit (wajib saat --profile)")
+	cmd.Flags().Bool("transaction", false, "Bundle SQL: jalankan semua file dalam satu transaksi (ditolak jika ada DDL/implicit commit)")
+	cmd.Flags().Bool("force-rerun", false, "Bundle SQL: jalankan ulang file yang tercatat running/gagal tanpa transaksi (periksa database dulu)")
 }
 
 func AddScriptExtractFlags(cmd *cobra.Command) {
@@ -25,6 +34,7 @@ func AddScriptExtractFlags(cmd *cobra.Command) {
 	cmd.Flags().String("encryption-key", "", "(deprecated) Gunakan --key atau -k")
 	_ = cmd.Flags().MarkHidden("encryption-key")
 	cmd.Flags().StringP("out-dir", "o", "", "Directory output untuk hasil extract (wajib)")
+	addScriptAllowUntrustedFlag(cmd)
 }
 
 func AddScriptInfoFlags(cmd *cobra.Command) {
@@ -32,4 +42,9 @@ func AddScriptInfoFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("key", "k", "", "Encryption key (opsional, jika kosong pakai env SFDB_SCRIPT_KEY atau prompt)")
 	cmd.Flags().String("encryption-key", "", "(deprecated) Gunakan --key atau -k")
 	_ = cmd.Flags().MarkHidden("encryption-key")
+	addScriptAllowUntrustedFlag(cmd)
+}
+
+func addScriptAllowUntrustedFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("allow-untrusted", false, "Izinkan bundle tanpa tanda tangan atau dari signer di luar script.trusted_signers (tidak disarankan)")
 }
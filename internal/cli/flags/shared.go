@@ -54,3 +54,11 @@ func AddDBInfoFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("user", "U", "", "Database username")
 	cmd.Flags().StringP("password", "p", "", "Database password")
 }
+
+// AddTLSFlags mendaftarkan flag TLS/SSL koneksi database untuk profile.
+func AddTLSFlags(cmd *cobra.Command) {
+	cmd.Flags().String("ssl-mode", "", "Mode TLS: disabled|preferred|required|verify-ca|verify-identity (kosong = default client/driver)")
+	cmd.Flags().String("ssl-ca", "", "Path CA certificate (PEM) untuk verifikasi server")
+	cmd.Flags().String("ssl-cert", "", "Path client certificate (PEM)")
+	cmd.Flags().String("ssl-key", "", "Path client private key (PEM)")
+}
@@ -0,0 +1,51 @@
+package parsing
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"sfdbtools/internal/app/backup/model/types_backup"
+	defaultVal "sfdbtools/internal/cli/defaults"
+	"sfdbtools/internal/cli/resolver"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingBackupCoverageOptions membaca flag untuk perintah `db-backup coverage`.
+func ParsingBackupCoverageOptions(cmd *cobra.Command) (types_backup.CoverageOptions, error) {
+	opts := defaultVal.DefaultBackupCoverageOptions()
+
+	if err := PopulateProfileFlags(cmd, &opts.Profile); err != nil {
+		return opts, err
+	}
+
+	if v := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "backup-dir", "")); v != "" {
+		opts.BackupDir = v
+	}
+	if opts.BackupDir == "" {
+		return opts, fmt.Errorf("direktori backup tidak diketahui, gunakan --backup-dir atau set backup.output.base_directory")
+	}
+
+	if v := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "max-age", "")); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return opts, fmt.Errorf("--max-age tidak valid: %w", err)
+		}
+		if d <= 0 {
+			return opts, fmt.Errorf("--max-age harus lebih dari 0")
+		}
+		opts.MaxAge = d
+	}
+
+	for _, v := range resolver.GetStringArrayFlagOrEnv(cmd, "exclude-db", "") {
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				opts.ExcludeDatabases = append(opts.ExcludeDatabases, name)
+			}
+		}
+	}
+
+	opts.AllowMissingMeta = resolver.GetBoolFlagOrEnv(cmd, "allow-missing-meta", "")
+	return opts, nil
+}
@@ -0,0 +1,76 @@
+package parsing
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"time"
+
+	"sfdbtools/internal/app/backup/model/types_backup"
+	"sfdbtools/internal/cli/resolver"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingBackupHoldOptions membaca argumen dan flag untuk `db-backup hold add|remove|list`.
+// Argumen pertama (opsional) adalah path file backup. Flag yang tidak terdaftar pada command diabaikan.
+func ParsingBackupHoldOptions(cmd *cobra.Command, args []string, registry string) (types_backup.HoldOptions, error) {
+	opts := types_backup.HoldOptions{Registry: registry}
+	if len(args) > 0 {
+		opts.File = strings.TrimSpace(args[0])
+	}
+
+	if cmd.Flags().Lookup("ticket") != nil {
+		opts.Ticket = strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "ticket", ""))
+	}
+	if cmd.Flags().Lookup("id") != nil {
+		opts.ID = strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "id", ""))
+	}
+	if cmd.Flags().Lookup("all") != nil {
+		opts.ShowAll = resolver.GetBoolFlagOrEnv(cmd, "all", "")
+	}
+
+	if cmd.Flags().Lookup("reason") != nil {
+		opts.Reason = strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "reason", ""))
+		opts.Owner = strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "owner", ""))
+		if opts.Owner == "" {
+			opts.Owner = currentUsername()
+		}
+		if v := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "expires", "")); v != "" {
+			t, err := parseHoldExpiry(v, time.Now())
+			if err != nil {
+				return opts, err
+			}
+			opts.ExpiresAt = &t
+		}
+		if (opts.File == "") == (opts.Ticket == "") {
+			return opts, fmt.Errorf("tentukan tepat satu target: <file> atau --ticket")
+		}
+	}
+	return opts, nil
+}
+
+// parseHoldExpiry menerima tanggal (2006-01-02), durasi hari (90d), atau durasi Go (72h).
+func parseHoldExpiry(v string, now time.Time) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", v, time.Local); err == nil {
+		return t, nil
+	}
+	if strings.HasSuffix(v, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(v, "d")); err == nil && days > 0 {
+			return now.AddDate(0, 0, days), nil
+		}
+	}
+	if d, err := time.ParseDuration(v); err == nil && d > 0 {
+		return now.Add(d), nil
+	}
+	return time.Time{}, fmt.Errorf("--expires tidak valid: %q (contoh: 2027-01-31, 90d, 72h)", v)
+}
+
+func currentUsername() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
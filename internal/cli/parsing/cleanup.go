@@ -1,22 +1,33 @@
 package parsing
 
 import (
+	"fmt"
+	"strings"
+
 	cleanupmodel "sfdbtools/internal/app/cleanup/model"
 	defaultVal "sfdbtools/internal/cli/defaults"
 	resolver "sfdbtools/internal/cli/resolver"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 )
 
 // ParsingCleanupOptions membaca flag untuk perintah cleanup
 // Mengembalikan CleanupOptions yang siap dipakai service.
 func ParsingCleanupOptions(cmd *cobra.Command) (cleanupmodel.CleanupOptions, error) {
-	// Mulai dari default
-	opts := defaultVal.DefaultCleanupOptions()
+	// Mulai dari config: backup.cleanup, atau job scheduler jika --job diisi
+	job := ""
+	if cmd.Flags().Lookup("job") != nil {
+		job = strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "job", ""))
+	}
+	opts, err := defaultVal.CleanupConfigOptions(job)
+	if err != nil {
+		return opts, err
+	}
 
-	// Days - retention days untuk cleanup
-	if v := resolver.GetIntFlagOrEnv(cmd, "days", ""); v != 0 {
-		opts.Days = v
+	// Days - retention days untuk cleanup (default flag sudah berisi backup.cleanup.days)
+	if cmd.Flags().Lookup("days") != nil && cmd.Flags().Changed("days") {
+		opts.Days = resolver.GetIntFlagOrEnv(cmd, "days", "")
 	}
 
 	// Pattern - glob pattern untuk filter files
@@ -24,8 +35,88 @@ func ParsingCleanupOptions(cmd *cobra.Command) (cleanupmodel.CleanupOptions, err
 		opts.Pattern = v
 	}
 
+	// Backup directory - override backup.output.base_directory (mis. per share NAS)
+	if cmd.Flags().Lookup("backup-dir") != nil {
+		if v := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "backup-dir", "")); v != "" {
+			opts.BaseDirectory = v
+		}
+	}
+
+	if err := applyCleanupStrategyFlags(cmd, &opts); err != nil {
+		return opts, err
+	}
+
 	// Dry-run mode
 	opts.DryRun = resolver.GetBoolFlagOrEnv(cmd, "dry-run", "")
 
 	return opts, nil
 }
+
+// applyCleanupStrategyFlags meng-override strategi retensi dari config dengan flag.
+// Jika flag satu strategi diberikan (--days, --keep-*, --keep-last, --max-total), strategi lain
+// dari config dinonaktifkan agar pilihan di CLI tidak bentrok dengan config.
+func applyCleanupStrategyFlags(cmd *cobra.Command, opts *cleanupmodel.CleanupOptions) error {
+	changed := func(name string) bool {
+		return cmd.Flags().Lookup(name) != nil && cmd.Flags().Changed(name)
+	}
+
+	var selected []string
+	if changed("days") {
+		selected = append(selected, "--days")
+	}
+	if changed("keep-daily") || changed("keep-weekly") || changed("keep-monthly") || changed("keep-yearly") {
+		selected = append(selected, "--keep-daily/weekly/monthly/yearly")
+	}
+	if changed("keep-last") {
+		selected = append(selected, "--keep-last")
+	}
+	if changed("max-total") {
+		selected = append(selected, "--max-total")
+	}
+	if len(selected) > 1 {
+		return fmt.Errorf("pilih salah satu strategi retensi: %s", strings.Join(selected, ", "))
+	}
+	if len(selected) == 1 {
+		opts.Retention = cleanupmodel.RetentionPolicy{}
+		opts.KeepLast = 0
+		opts.MaxTotalBytes = 0
+	}
+
+	// Retensi GFS
+	for _, f := range []struct {
+		name   string
+		target *int
+	}{
+		{"keep-daily", &opts.Retention.KeepDaily},
+		{"keep-weekly", &opts.Retention.KeepWeekly},
+		{"keep-monthly", &opts.Retention.KeepMonthly},
+		{"keep-yearly", &opts.Retention.KeepYearly},
+	} {
+		if !changed(f.name) {
+			continue
+		}
+		v := resolver.GetIntFlagOrEnv(cmd, f.name, "")
+		if v < 0 {
+			return fmt.Errorf("--%s tidak boleh negatif", f.name)
+		}
+		*f.target = v
+	}
+
+	if changed("keep-last") {
+		v := resolver.GetIntFlagOrEnv(cmd, "keep-last", "")
+		if v <= 0 {
+			return fmt.Errorf("--keep-last harus lebih dari 0")
+		}
+		opts.KeepLast = v
+	}
+
+	if changed("max-total") {
+		raw := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "max-total", ""))
+		size, err := humanize.ParseBytes(raw)
+		if err != nil || size == 0 {
+			return fmt.Errorf("--max-total tidak valid: %q (contoh: 500GB, 2TB)", raw)
+		}
+		opts.MaxTotalBytes = int64(size)
+	}
+	return nil
+}
@@ -2,16 +2,18 @@
 // Deskripsi : Common helper functions untuk parsing operations (shared across all parsers)
 // Author : Hadiyatna Muflihun
 // Tanggal : 21 Januari 2026
-// Last Modified : 23 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package common
 
 import (
 	"fmt"
 	"os"
+	"sfdbtools/internal/app/profile/process"
 	resolver "sfdbtools/internal/cli/resolver"
 	"sfdbtools/internal/domain"
 	"sfdbtools/internal/shared/consts"
+	"sfdbtools/internal/shared/database"
 	"sfdbtools/internal/shared/runtimecfg"
 	"sfdbtools/internal/shared/validation"
 	"strings"
@@ -73,6 +75,30 @@ func ParseDBConfig(cmd *cobra.Command) domain.DBInfo {
 	}
 }
 
+// ParseTLSConfig parsing pengaturan TLS/SSL dari flags (ssl_mode dinormalisasi).
+func ParseTLSConfig(cmd *cobra.Command) (domain.TLSConfig, error) {
+	mode, err := database.NormalizeSSLMode(resolver.GetStringFlagOrEnv(cmd, "ssl-mode", ""))
+	if err != nil {
+		return domain.TLSConfig{}, err
+	}
+	return domain.TLSConfig{
+		Mode: mode,
+		CA:   resolver.GetStringFlagOrEnv(cmd, "ssl-ca", ""),
+		Cert: resolver.GetStringFlagOrEnv(cmd, "ssl-cert", ""),
+		Key:  resolver.GetStringFlagOrEnv(cmd, "ssl-key", ""),
+	}, nil
+}
+
+// ParseSocket membaca flag --socket. Nilai "auto" dideteksi menjadi path socket lokal;
+// nilai "none" dikembalikan apa adanya agar edit dapat menghapus socket.
+func ParseSocket(cmd *cobra.Command) (string, error) {
+	socket := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "socket", ""))
+	if !strings.EqualFold(socket, consts.DBSocketAuto) {
+		return socket, nil
+	}
+	return database.DetectSocketPath()
+}
+
 // ParseSSHConfig parsing SSH tunnel configuration dari flags/env
 func ParseSSHConfig(cmd *cobra.Command) domain.SSHTunnelConfig {
 	sshPort := resolver.GetIntFlagOrEnv(cmd, "ssh-port", "")
@@ -80,15 +106,25 @@ func ParseSSHConfig(cmd *cobra.Command) domain.SSHTunnelConfig {
 		sshPort = 22
 	}
 
-	return domain.SSHTunnelConfig{
+	cfg := domain.SSHTunnelConfig{
 		Enabled:      resolver.GetBoolFlagOrEnv(cmd, "ssh", ""),
 		Host:         resolver.GetStringFlagOrEnv(cmd, "ssh-host", ""),
 		Port:         sshPort,
 		User:         resolver.GetStringFlagOrEnv(cmd, "ssh-user", ""),
 		Password:     resolver.GetStringFlagOrEnv(cmd, "ssh-password", ""),
 		IdentityFile: resolver.GetStringFlagOrEnv(cmd, "ssh-identity-file", ""),
+		UseAgent:     resolver.GetBoolFlagOrEnv(cmd, "ssh-agent", ""),
 		LocalPort:    resolver.GetIntFlagOrEnv(cmd, "ssh-local-port", ""),
 	}
+	// --ssh-jump "" menghasilkan slice kosong (non-nil) agar edit dapat menghapus rantai jump host.
+	if cmd.Flags().Changed("ssh-jump") {
+		jumps, _ := cmd.Flags().GetStringSlice("ssh-jump")
+		cfg.JumpHosts = process.SplitJumpHosts(strings.Join(jumps, ","))
+		if cfg.JumpHosts == nil {
+			cfg.JumpHosts = []string{}
+		}
+	}
+	return cfg
 }
 
 // ValidateNonInteractive validasi parameter wajib untuk non-interactive mode
@@ -1,9 +1,13 @@
 package parsing
 
 import (
+	"fmt"
+	"strings"
+
 	dbscanmodel "sfdbtools/internal/app/dbscan/model"
 	defaultVal "sfdbtools/internal/cli/defaults"
 	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/shared/consts"
 
 	"github.com/spf13/cobra"
 )
@@ -24,5 +28,71 @@ func ParsingScanAllOptions(cmd *cobra.Command) (dbscanmodel.ScanOptions, error)
 	opts.ExcludeSystem = resolver.GetBoolFlagOrEnv(cmd, "exclude-system", "")
 	opts.ShowOptions = resolver.GetBoolFlagOrEnv(cmd, "show-options", "")
 
+	if err := PopulateScanSaveFlags(cmd, &opts); err != nil {
+		return dbscanmodel.ScanOptions{}, err
+	}
+	if err := PopulateScanExportFlags(cmd, &opts); err != nil {
+		return dbscanmodel.ScanOptions{}, err
+	}
+	if err := PopulateScanDeepFlags(cmd, &opts); err != nil {
+		return dbscanmodel.ScanOptions{}, err
+	}
+
 	return opts, nil
 }
+
+// PopulateScanDeepFlags membaca flag --deep dan --top-tables (deep scan per tabel).
+func PopulateScanDeepFlags(cmd *cobra.Command, opts *dbscanmodel.ScanOptions) error {
+	if cmd.Flags().Lookup("deep") != nil {
+		opts.Deep = resolver.GetBoolFlagOrEnv(cmd, "deep", "")
+	}
+	if cmd.Flags().Lookup("top-tables") != nil {
+		opts.TopTables = resolver.GetIntFlagOrEnv(cmd, "top-tables", "")
+		if opts.TopTables < 0 {
+			return fmt.Errorf("--top-tables tidak boleh negatif")
+		}
+	}
+	return nil
+}
+
+// PopulateScanExportFlags membaca flag --export; format file divalidasi oleh service dbscan.
+func PopulateScanExportFlags(cmd *cobra.Command, opts *dbscanmodel.ScanOptions) error {
+	if cmd.Flags().Lookup("export") == nil {
+		return nil
+	}
+	opts.ExportPath = strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "export", ""))
+	return nil
+}
+
+// PopulateScanSaveFlags membaca flag --save-to/--save-to-key/--save-db (inventory).
+// Jika --save-to kosong, hasil scan tidak disimpan dan flag lain diabaikan.
+func PopulateScanSaveFlags(cmd *cobra.Command, opts *dbscanmodel.ScanOptions) error {
+	if cmd.Flags().Lookup("save-to") == nil {
+		return nil
+	}
+
+	saveTo := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "save-to", ""))
+	saveDB := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "save-db", ""))
+	if saveTo == "" {
+		if saveDB != "" {
+			return fmt.Errorf("flag --save-db membutuhkan --save-to (profile server inventory)")
+		}
+		return nil
+	}
+
+	key, err := resolver.GetSecretStringFlagOrEnv(cmd, "save-to-key", consts.ENV_TARGET_PROFILE_KEY)
+	if err != nil {
+		return err
+	}
+	if saveDB == "" {
+		saveDB = consts.DefaultInventoryDatabase
+	}
+	if strings.ContainsAny(saveDB, "`/\\.") {
+		return fmt.Errorf("nama database inventory tidak valid: %s", saveDB)
+	}
+
+	opts.SaveTo.ProfileInfo.Path = saveTo
+	opts.SaveTo.ProfileInfo.EncryptionKey = key
+	opts.SaveTo.Database = saveDB
+	return nil
+}
@@ -1,6 +1,9 @@
 package parsing
 
 import (
+	"fmt"
+	"strings"
+
 	dbscanmodel "sfdbtools/internal/app/dbscan/model"
 	defaultVal "sfdbtools/internal/cli/defaults"
 	"sfdbtools/internal/cli/resolver"
@@ -50,5 +53,57 @@ func ParsingScanFilterOptions(cmd *cobra.Command, cfg *appconfig.Config) (dbscan
 		}
 	}
 
+	if err := PopulateScanSaveFlags(cmd, &opts); err != nil {
+		return dbscanmodel.ScanOptions{}, err
+	}
+	if err := PopulateScanExportFlags(cmd, &opts); err != nil {
+		return dbscanmodel.ScanOptions{}, err
+	}
+	if err := PopulateScanDeepFlags(cmd, &opts); err != nil {
+		return dbscanmodel.ScanOptions{}, err
+	}
+
 	return opts, nil
 }
+
+// ParsingScanTablesOptions membaca flag untuk perintah `dbscan tables`.
+// Sama seperti filter, namun deep scan selalu aktif dan include file hanya dari --db-file
+// (tanpa fallback config) agar default-nya mencakup semua database.
+func ParsingScanTablesOptions(cmd *cobra.Command, cfg *appconfig.Config) (dbscanmodel.ScanOptions, error) {
+	opts, err := ParsingScanFilterOptions(cmd, cfg)
+	if err != nil {
+		return dbscanmodel.ScanOptions{}, err
+	}
+	opts.Mode = "tables"
+	opts.DatabaseList.File = resolver.GetStringFlagOrEnv(cmd, "db-file", "")
+	opts.ShowOptions = resolver.GetBoolFlagOrEnv(cmd, "show-options", "")
+	opts.Deep = true
+	return opts, nil
+}
+
+// ParsingScanLintOptions membaca flag untuk perintah `dbscan lint`.
+// Seleksi database mengikuti `dbscan tables` (tanpa filter = semua database non-system).
+func ParsingScanLintOptions(cmd *cobra.Command, cfg *appconfig.Config) (dbscanmodel.ScanOptions, dbscanmodel.LintOptions, error) {
+	lintOpts := dbscanmodel.LintOptions{
+		Rules:     listx.CSVToCleanList(resolver.GetStringFlagOrEnv(cmd, "rules", "")),
+		FailOn:    strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "fail-on", "")),
+		ShowDDL:   resolver.GetBoolFlagOrEnv(cmd, "show-ddl", ""),
+		ListRules: resolver.GetBoolFlagOrEnv(cmd, "list-rules", ""),
+	}
+	if lintOpts.FailOn != "" {
+		if _, err := dbscanmodel.ParseLintSeverity(lintOpts.FailOn); err != nil {
+			return dbscanmodel.ScanOptions{}, lintOpts, fmt.Errorf("--fail-on: %w", err)
+		}
+	}
+
+	opts, err := ParsingScanFilterOptions(cmd, cfg)
+	if err != nil {
+		return dbscanmodel.ScanOptions{}, lintOpts, err
+	}
+	opts.Mode = "lint"
+	opts.DatabaseList.File = resolver.GetStringFlagOrEnv(cmd, "db-file", "")
+	opts.ShowOptions = resolver.GetBoolFlagOrEnv(cmd, "show-options", "")
+	lintOpts.ExportPath = opts.ExportPath
+
+	return opts, lintOpts, nil
+}
@@ -0,0 +1,69 @@
+package parsing
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	dbscanmodel "sfdbtools/internal/app/dbscan/model"
+	"sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+// ParsingScanTrendOptions membaca flag untuk perintah `dbscan trend` dan `dbscan forecast`.
+// Flag yang tidak terdaftar pada command diabaikan.
+func ParsingScanTrendOptions(cmd *cobra.Command) (dbscanmodel.TrendOptions, error) {
+	opts := dbscanmodel.TrendOptions{
+		Profile: normalizeHistoryProfile(resolver.GetStringFlagOrEnv(cmd, "profile", "")),
+		Days:    resolver.GetIntFlagOrEnv(cmd, "days", ""),
+	}
+	if opts.Days < 0 {
+		return opts, fmt.Errorf("--days tidak boleh negatif")
+	}
+
+	if cmd.Flags().Lookup("database") != nil {
+		opts.Database = strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "database", ""))
+	}
+	if cmd.Flags().Lookup("top") != nil {
+		opts.Top = resolver.GetIntFlagOrEnv(cmd, "top", "")
+	}
+
+	if cmd.Flags().Lookup("threshold") != nil {
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+		if threshold < 0 || threshold > 100 {
+			return opts, fmt.Errorf("--threshold harus di antara 0 dan 100")
+		}
+		opts.ThresholdPercent = threshold
+	}
+
+	if cmd.Flags().Lookup("volume-size") != nil {
+		if raw := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "volume-size", "")); raw != "" {
+			size, err := humanize.ParseBytes(raw)
+			if err != nil {
+				return opts, fmt.Errorf("--volume-size tidak valid: %w", err)
+			}
+			opts.VolumeSizeBytes = size
+		}
+	}
+
+	var scanOpts dbscanmodel.ScanOptions
+	if err := PopulateScanExportFlags(cmd, &scanOpts); err != nil {
+		return opts, err
+	}
+	opts.ExportPath = scanOpts.ExportPath
+
+	return opts, nil
+}
+
+// normalizeHistoryProfile menyamakan input profile (nama/path) dengan nama profile di histori scan.
+func normalizeHistoryProfile(spec string) string {
+	name := filepath.Base(strings.TrimSpace(spec))
+	if name == "." || name == string(filepath.Separator) {
+		return ""
+	}
+	name = strings.TrimSuffix(name, consts.ExtEnc)
+	return strings.TrimSuffix(name, consts.ExtCnf)
+}
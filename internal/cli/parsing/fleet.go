@@ -0,0 +1,33 @@
+package parsing
+
+import (
+	"fmt"
+
+	fleetmodel "sfdbtools/internal/app/fleet/model"
+	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingFleetOptions membaca flag fleet (--profiles dan kawan-kawan).
+func ParsingFleetOptions(cmd *cobra.Command) (*fleetmodel.Options, error) {
+	concurrency := resolver.GetIntFlagOrEnv(cmd, "concurrency", "")
+	if concurrency < 0 {
+		return nil, fmt.Errorf("--concurrency tidak valid: %d", concurrency)
+	}
+	if cmd.Flags().Changed("profile") {
+		return nil, fmt.Errorf("--profiles tidak bisa digabung dengan --profile")
+	}
+	key, err := resolver.GetSecretStringFlagOrEnv(cmd, "profile-key", consts.ENV_SOURCE_PROFILE_KEY)
+	if err != nil {
+		return nil, err
+	}
+	return &fleetmodel.Options{
+		Selector:    resolver.GetStringFlagOrEnv(cmd, "profiles", ""),
+		Concurrency: concurrency,
+		ProfileKey:  key,
+		LogDir:      resolver.GetStringFlagOrEnv(cmd, "fleet-log-dir", ""),
+		DryRun:      resolver.GetBoolFlagOrEnv(cmd, "fleet-dry-run", ""),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package profile
+
+import (
+	"fmt"
+	profilemodel "sfdbtools/internal/app/profile/model"
+	parsingcommon "sfdbtools/internal/cli/parsing/common"
+	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingTestProfile parses flags untuk profile test command
+func ParsingTestProfile(cmd *cobra.Command) (*profilemodel.ProfileTestOptions, error) {
+	filePath := resolver.GetStringFlagOrEnv(cmd, "profile", "")
+	key, _, err := parsingcommon.ResolveEncryptionKey(cmd, consts.ENV_TARGET_PROFILE_KEY, consts.ENV_SOURCE_PROFILE_KEY)
+	if err != nil {
+		return nil, err
+	}
+	interactive := parsingcommon.IsInteractiveMode()
+
+	format := strings.ToLower(strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "format", "")))
+	if format == "" {
+		format = consts.ProfileTestFormatTable
+	}
+	if format != consts.ProfileTestFormatTable && format != consts.ProfileTestFormatJSON {
+		return nil, fmt.Errorf("--format tidak valid: %q (gunakan %s atau %s)", format, consts.ProfileTestFormatTable, consts.ProfileTestFormatJSON)
+	}
+
+	selector := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "profiles", ""))
+	all := resolver.GetBoolFlagOrEnv(cmd, "all", "")
+	if all && selector != "" {
+		return nil, fmt.Errorf("--all tidak bisa digabung dengan --profiles")
+	}
+	if all {
+		selector = "all"
+	}
+	if selector != "" {
+		if strings.TrimSpace(filePath) != "" {
+			return nil, fmt.Errorf("--all/--profiles tidak bisa digabung dengan --profile")
+		}
+		concurrency := resolver.GetIntFlagOrEnv(cmd, "concurrency", "")
+		if concurrency < 0 {
+			return nil, fmt.Errorf("--concurrency tidak valid: %d", concurrency)
+		}
+		if !interactive && strings.TrimSpace(key) == "" {
+			if err := parsingcommon.ValidateNonInteractive(interactive,
+				[]string{"--profile-key / ENV " + consts.ENV_TARGET_PROFILE_KEY + " atau " + consts.ENV_SOURCE_PROFILE_KEY},
+				"Contoh: sfdbtools profile test --quiet --all --profile-key <key> --format json"); err != nil {
+				return nil, err
+			}
+		}
+		return &profilemodel.ProfileTestOptions{
+			Interactive: interactive,
+			Selector:    selector,
+			Concurrency: concurrency,
+			Format:      format,
+			ProfileInfo: domain.ProfileInfo{EncryptionKey: key},
+		}, nil
+	}
+
+	if !interactive {
+		missing := make([]string, 0, 2)
+		if strings.TrimSpace(filePath) == "" {
+			missing = append(missing, "--profile")
+		}
+		if strings.TrimSpace(key) == "" {
+			missing = append(missing, "--profile-key / ENV "+consts.ENV_TARGET_PROFILE_KEY+" atau "+consts.ENV_SOURCE_PROFILE_KEY)
+		}
+		if err := parsingcommon.ValidateNonInteractive(interactive, missing,
+			"Contoh: sfdbtools profile test --quiet --profile <nama-file> --profile-key <key>"); err != nil {
+			return nil, err
+		}
+	}
+
+	return &profilemodel.ProfileTestOptions{
+		Interactive: interactive,
+		Format:      format,
+		ProfileInfo: domain.ProfileInfo{
+			Path:          filePath,
+			EncryptionKey: key,
+		},
+	}, nil
+}
@@ -3,6 +3,7 @@ package profile
 import (
 	"fmt"
 	"os"
+	"sfdbtools/internal/app/profile/helpers/tags"
 	profilemodel "sfdbtools/internal/app/profile/model"
 	parsingcommon "sfdbtools/internal/cli/parsing/common"
 	resolver "sfdbtools/internal/cli/resolver"
@@ -27,6 +28,23 @@ func ParsingCreateProfile(cmd *cobra.Command, logger applog.Logger) (*profilemod
 	interactive := parsingcommon.IsInteractiveMode()
 	dbConfig := parsingcommon.ParseDBConfig(cmd)
 	sshConfig := parsingcommon.ParseSSHConfig(cmd)
+	if dbConfig.TLS, err = parsingcommon.ParseTLSConfig(cmd); err != nil {
+		return nil, err
+	}
+	if dbConfig.Socket, err = parsingcommon.ParseSocket(cmd); err != nil {
+		return nil, err
+	}
+	if dbConfig.Socket == consts.DBSocketNone {
+		dbConfig.Socket = ""
+	}
+	// Koneksi socket selalu lokal; host hanya dipakai untuk tampilan dan metadata.
+	if dbConfig.Socket != "" && strings.TrimSpace(dbConfig.Host) == "" {
+		dbConfig.Host = "localhost"
+	}
+	tagMap, groups, err := parseTagFlags(cmd, "tag", "group")
+	if err != nil {
+		return nil, err
+	}
 
 	// Port default hanya untuk mode non-interaktif (create). Untuk mode interaktif,
 	// biarkan port=0 agar wizard akan prompt dengan default 3306.
@@ -54,7 +72,8 @@ func ParsingCreateProfile(cmd *cobra.Command, logger applog.Logger) (*profilemod
 		if strings.TrimSpace(dbConfig.User) == "" {
 			missing = append(missing, "--user / ENV "+consts.ENV_TARGET_DB_USER)
 		}
-		if strings.TrimSpace(dbConfig.Password) == "" {
+		// Password opsional untuk socket (auth unix_socket tidak memakai password).
+		if strings.TrimSpace(dbConfig.Password) == "" && dbConfig.Socket == "" {
 			missing = append(missing, "--password / ENV "+consts.ENV_TARGET_DB_PASSWORD)
 		}
 		if strings.TrimSpace(key) == "" {
@@ -73,8 +92,26 @@ func ParsingCreateProfile(cmd *cobra.Command, logger applog.Logger) (*profilemod
 			EncryptionKey: key,
 			DBInfo:        dbConfig,
 			SSHTunnel:     sshConfig,
+			Tags:          tagMap,
+			Groups:        groups,
 		},
 		OutputDir:   outputDir,
 		Interactive: interactive,
 	}, nil
 }
+
+// parseTagFlags membaca flag tag (key=value) dan grup.
+func parseTagFlags(cmd *cobra.Command, tagFlag, groupFlag string) (map[string]string, []string, error) {
+	tagMap, err := tags.ParseTags(resolver.GetStringSliceFlagOrEnv(cmd, tagFlag, ""))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tagMap) == 0 {
+		tagMap = nil
+	}
+	groups, err := tags.ParseGroups(resolver.GetStringSliceFlagOrEnv(cmd, groupFlag, ""))
+	if err != nil {
+		return nil, nil, err
+	}
+	return tagMap, groups, nil
+}
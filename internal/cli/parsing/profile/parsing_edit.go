@@ -3,6 +3,7 @@ package profile
 import (
 	"fmt"
 	"os"
+	"sfdbtools/internal/app/profile/helpers/tags"
 	profilemodel "sfdbtools/internal/app/profile/model"
 	parsingcommon "sfdbtools/internal/cli/parsing/common"
 	resolver "sfdbtools/internal/cli/resolver"
@@ -36,6 +37,20 @@ func ParsingEditProfile(cmd *cobra.Command) (*profilemodel.ProfileEditOptions, e
 	interactive := parsingcommon.IsInteractiveMode()
 	dbConfig := parsingcommon.ParseDBConfig(cmd)
 	sshConfig := parsingcommon.ParseSSHConfig(cmd)
+	if dbConfig.TLS, err = parsingcommon.ParseTLSConfig(cmd); err != nil {
+		return nil, err
+	}
+	if dbConfig.Socket, err = parsingcommon.ParseSocket(cmd); err != nil {
+		return nil, err
+	}
+	setTags, addGroups, err := parseTagFlags(cmd, "tag", "group")
+	if err != nil {
+		return nil, err
+	}
+	removeGroups, err := tags.ParseGroups(resolver.GetStringSliceFlagOrEnv(cmd, "ungroup", ""))
+	if err != nil {
+		return nil, err
+	}
 
 	// Jangan default port saat edit: port=0 berarti tidak override snapshot.
 	// Namun jika user/env secara eksplisit memberi nilai di luar range, fail-fast.
@@ -74,5 +89,9 @@ func ParsingEditProfile(cmd *cobra.Command) (*profilemodel.ProfileEditOptions, e
 		Interactive:         interactive,
 		NewProfileKey:       newKey,
 		NewProfileKeySource: newKeySource,
+		SetTags:             setTags,
+		UnsetTags:           resolver.GetStringSliceFlagOrEnv(cmd, "untag", ""),
+		AddGroups:           addGroups,
+		RemoveGroups:        removeGroups,
 	}, nil
 }
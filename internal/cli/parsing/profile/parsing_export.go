@@ -0,0 +1,49 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	profilemodel "sfdbtools/internal/app/profile/model"
+	parsingcommon "sfdbtools/internal/cli/parsing/common"
+	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingExportProfile parses flags untuk profile export command.
+func ParsingExportProfile(cmd *cobra.Command) (*profilemodel.ProfileExportOptions, error) {
+	output := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "output", ""))
+	if output == "" {
+		return nil, fmt.Errorf("--output wajib diisi (contoh: --output profiles.xlsx)")
+	}
+	profileKey, err := resolver.GetSecretStringFlagOrEnv(cmd, "profile-key", consts.ENV_SOURCE_PROFILE_KEY)
+	if err != nil {
+		return nil, err
+	}
+	encryptKey, err := resolver.GetSecretStringFlagOrEnv(cmd, "encrypt-key", "")
+	if err != nil {
+		return nil, err
+	}
+	keyMap := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "key-map", ""))
+	interactive := parsingcommon.IsInteractiveMode()
+
+	if !interactive && strings.TrimSpace(profileKey) == "" && keyMap == "" {
+		if err := parsingcommon.ValidateNonInteractive(interactive, []string{"--profile-key/--key-map"},
+			"Contoh: sfdbtools profile export --quiet --output profiles.xlsx --profile-key <kunci>"); err != nil {
+			return nil, err
+		}
+	}
+
+	return &profilemodel.ProfileExportOptions{
+		Output:      output,
+		ProfileKey:  strings.TrimSpace(profileKey),
+		KeyMapFile:  keyMap,
+		Names:       resolver.GetStringSliceFlagOrEnv(cmd, "name", ""),
+		Redact:      resolver.GetBoolFlagOrEnv(cmd, "redact", ""),
+		EncryptKey:  encryptKey,
+		Force:       resolver.GetBoolFlagOrEnv(cmd, "force", ""),
+		Interactive: interactive,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	"sfdbtools/internal/app/profile/helpers/history"
+	profilemodel "sfdbtools/internal/app/profile/model"
+	parsingcommon "sfdbtools/internal/cli/parsing/common"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingHistoryProfile parses argumen untuk profile history <nama>
+func ParsingHistoryProfile(cmd *cobra.Command) (*profilemodel.ProfileHistoryOptions, error) {
+	args := cmd.Flags().Args()
+	if len(args) != 1 || strings.TrimSpace(args[0]) == "" {
+		return nil, fmt.Errorf("gunakan: sfdbtools profile history <nama>")
+	}
+	return &profilemodel.ProfileHistoryOptions{
+		ProfileName: strings.TrimSpace(args[0]),
+		Interactive: parsingcommon.IsInteractiveMode(),
+	}, nil
+}
+
+// ParsingDiffProfile parses argumen untuk profile diff <nama> <v1> <v2>
+func ParsingDiffProfile(cmd *cobra.Command) (*profilemodel.ProfileDiffOptions, error) {
+	args := cmd.Flags().Args()
+	if len(args) != 3 {
+		return nil, fmt.Errorf("gunakan: sfdbtools profile diff <nama> <v1> <v2> (versi: angka atau %q)", history.VersionCurrent)
+	}
+	from, err := history.ParseVersion(args[1])
+	if err != nil {
+		return nil, err
+	}
+	to, err := history.ParseVersion(args[2])
+	if err != nil {
+		return nil, err
+	}
+	key, err := resolveHistoryProfileKey(cmd, "Contoh: sfdbtools profile diff --quiet <nama> 3 current --profile-key <key>")
+	if err != nil {
+		return nil, err
+	}
+	return &profilemodel.ProfileDiffOptions{
+		ProfileName: strings.TrimSpace(args[0]),
+		From:        from,
+		To:          to,
+		ProfileKey:  key,
+		Interactive: parsingcommon.IsInteractiveMode(),
+	}, nil
+}
+
+// ParsingRollbackProfile parses argumen untuk profile rollback <nama> <versi>
+func ParsingRollbackProfile(cmd *cobra.Command) (*profilemodel.ProfileRollbackOptions, error) {
+	args := cmd.Flags().Args()
+	if len(args) != 2 {
+		return nil, fmt.Errorf("gunakan: sfdbtools profile rollback <nama> <versi>")
+	}
+	version, err := history.ParseVersion(args[1])
+	if err != nil {
+		return nil, err
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("versi rollback harus nomor versi dari 'profile history', bukan %q", history.VersionCurrent)
+	}
+	key, err := resolveHistoryProfileKey(cmd, "Contoh: sfdbtools profile rollback --quiet <nama> 3 --profile-key <key>")
+	if err != nil {
+		return nil, err
+	}
+	skipConfirm, _ := cmd.Flags().GetBool("skip-confirm")
+	return &profilemodel.ProfileRollbackOptions{
+		ProfileName: strings.TrimSpace(args[0]),
+		Version:     version,
+		ProfileKey:  key,
+		SkipConfirm: skipConfirm,
+		Interactive: parsingcommon.IsInteractiveMode(),
+	}, nil
+}
+
+// resolveHistoryProfileKey membaca --profile-key/ENV; wajib pada mode non-interaktif.
+func resolveHistoryProfileKey(cmd *cobra.Command, example string) (string, error) {
+	key, _, err := parsingcommon.ResolveEncryptionKey(cmd, consts.ENV_TARGET_PROFILE_KEY, consts.ENV_SOURCE_PROFILE_KEY)
+	if err != nil {
+		return "", err
+	}
+	interactive := parsingcommon.IsInteractiveMode()
+	if !interactive && strings.TrimSpace(key) == "" {
+		missing := []string{"--profile-key / ENV " + consts.ENV_TARGET_PROFILE_KEY + " atau " + consts.ENV_SOURCE_PROFILE_KEY}
+		if err := parsingcommon.ValidateNonInteractive(interactive, missing, example); err != nil {
+			return "", err
+		}
+	}
+	return key, nil
+}
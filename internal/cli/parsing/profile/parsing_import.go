@@ -2,9 +2,11 @@ package profile
 
 import (
 	"fmt"
+	"sfdbtools/internal/app/profile/helpers/reader"
 	profilemodel "sfdbtools/internal/app/profile/model"
 	parsingcommon "sfdbtools/internal/cli/parsing/common"
 	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/shared/consts"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -21,6 +23,16 @@ func ParsingImportProfile(cmd *cobra.Command) (*profilemodel.ProfileImportOption
 	skipInvalidRows := resolver.GetBoolFlagOrEnv(cmd, "skip-invalid-rows", "")
 	continueOnError := resolver.GetBoolFlagOrEnv(cmd, "continue-on-error", "")
 	skipConnTest := resolver.GetBoolFlagOrEnv(cmd, "skip-conn-test", "")
+	inputPassword, err := resolver.GetSecretStringFlagOrEnv(cmd, "input-password", "")
+	if err != nil {
+		return nil, err
+	}
+	fromMyCnf := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "from-mycnf", ""))
+	fromLoginPath := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "from-login-path", ""))
+	profileKey, err := resolver.GetSecretStringFlagOrEnv(cmd, "profile-key", consts.ENV_TARGET_PROFILE_KEY)
+	if err != nil {
+		return nil, err
+	}
 
 	interactive := parsingcommon.IsInteractiveMode()
 	// Jika mode non-interaktif, wajib skip-confirm agar tidak hang.
@@ -28,14 +40,28 @@ func ParsingImportProfile(cmd *cobra.Command) (*profilemodel.ProfileImportOption
 		return nil, fmt.Errorf("mode non-interaktif: flag --skip-confirm wajib disertakan untuk automation")
 	}
 
-	if strings.TrimSpace(input) != "" && strings.TrimSpace(gsheet) != "" {
-		return nil, fmt.Errorf("gunakan salah satu: --input (XLSX lokal) atau --gsheet (Google Spreadsheet), bukan keduanya")
+	sources := 0
+	for _, v := range []string{input, gsheet, fromMyCnf, fromLoginPath} {
+		if strings.TrimSpace(v) != "" {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return nil, fmt.Errorf("gunakan salah satu sumber: --input, --gsheet, --from-mycnf, atau --from-login-path")
+	}
+	if fromMyCnf != "" || fromLoginPath != "" {
+		// Option file tidak punya kolom profile_key; semua profile hasil import memakai satu kunci.
+		if strings.TrimSpace(profileKey) == "" && (!interactive || skipConfirm) {
+			return nil, fmt.Errorf("--from-mycnf/--from-login-path membutuhkan --profile-key atau ENV %s pada mode non-interaktif", consts.ENV_TARGET_PROFILE_KEY)
+		}
+	} else if cmd.Flags().Changed("profile-key") && !reader.IsManifestPath(input) {
+		return nil, fmt.Errorf("--profile-key hanya dipakai bersama manifest YAML/JSON, --from-mycnf, atau --from-login-path (XLSX/CSV memakai kolom profile_key)")
 	}
 	// Full-interactive mode: bila belum ada sumber, akan dipilih via prompt di executor.
 	// Non-interaktif/automation tetap wajib menentukan sumber via flag.
-	if strings.TrimSpace(input) == "" && strings.TrimSpace(gsheet) == "" {
+	if sources == 0 {
 		if !interactive || skipConfirm {
-			return nil, fmt.Errorf("sumber import tidak tersedia: gunakan --input <file.xlsx> atau --gsheet <url>")
+			return nil, fmt.Errorf("sumber import tidak tersedia: gunakan --input <file.xlsx>, --gsheet <url>, --from-mycnf <file>, atau --from-login-path")
 		}
 	}
 
@@ -53,9 +79,13 @@ func ParsingImportProfile(cmd *cobra.Command) (*profilemodel.ProfileImportOption
 
 	return &profilemodel.ProfileImportOptions{
 		Input:           strings.TrimSpace(input),
+		InputPassword:   inputPassword,
 		Sheet:           strings.TrimSpace(sheet),
 		GSheetURL:       strings.TrimSpace(gsheet),
 		GID:             gid,
+		FromMyCnf:       fromMyCnf,
+		FromLoginPath:   fromLoginPath,
+		ProfileKey:      profileKey,
 		OnConflict:      strings.TrimSpace(onConflict),
 		SkipConfirm:     skipConfirm,
 		SkipInvalidRows: skipInvalidRows,
@@ -0,0 +1,63 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	profilemodel "sfdbtools/internal/app/profile/model"
+	parsingcommon "sfdbtools/internal/cli/parsing/common"
+	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingRekeyProfile parses flags untuk profile rekey command.
+func ParsingRekeyProfile(cmd *cobra.Command) (*profilemodel.ProfileRekeyOptions, error) {
+	oldKey, err := resolver.GetSecretStringFlagOrEnv(cmd, "old-key", consts.ENV_SOURCE_PROFILE_KEY)
+	if err != nil {
+		return nil, err
+	}
+	newKey, err := resolver.GetSecretStringFlagOrEnv(cmd, "new-key", consts.ENV_TARGET_PROFILE_KEY)
+	if err != nil {
+		return nil, err
+	}
+	keyMap := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "key-map", ""))
+	all := resolver.GetBoolFlagOrEnv(cmd, "all", "")
+	profiles := resolver.GetStringSliceFlagOrEnv(cmd, "profile", "")
+	keepBackup := resolver.GetBoolFlagOrEnv(cmd, "keep-backup", "")
+	skipConfirm := resolver.GetBoolFlagOrEnv(cmd, "skip-confirm", "")
+
+	if all == (len(profiles) > 0) {
+		return nil, fmt.Errorf("tentukan target: --all atau --profile <nama> (salah satu)")
+	}
+
+	interactive := parsingcommon.IsInteractiveMode()
+	if !interactive {
+		missing := make([]string, 0, 3)
+		if strings.TrimSpace(newKey) == "" {
+			missing = append(missing, "--new-key")
+		}
+		if strings.TrimSpace(oldKey) == "" && keyMap == "" {
+			missing = append(missing, "--old-key/--key-map")
+		}
+		if !skipConfirm {
+			missing = append(missing, "--skip-confirm")
+		}
+		if err := parsingcommon.ValidateNonInteractive(interactive, missing,
+			"Contoh: sfdbtools profile rekey --quiet --skip-confirm --all --old-key <lama> --new-key <baru>"); err != nil {
+			return nil, err
+		}
+	}
+
+	return &profilemodel.ProfileRekeyOptions{
+		OldKey:      strings.TrimSpace(oldKey),
+		NewKey:      strings.TrimSpace(newKey),
+		KeyMapFile:  keyMap,
+		All:         all,
+		Profiles:    profiles,
+		KeepBackup:  keepBackup,
+		SkipConfirm: skipConfirm,
+		Interactive: interactive && !skipConfirm,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package profile
+
+import (
+	profilemodel "sfdbtools/internal/app/profile/model"
+	parsingcommon "sfdbtools/internal/cli/parsing/common"
+	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingSSHTrustProfile parses flags untuk profile ssh trust command
+func ParsingSSHTrustProfile(cmd *cobra.Command) (*profilemodel.ProfileSSHTrustOptions, error) {
+	filePath := resolver.GetStringFlagOrEnv(cmd, "profile", "")
+	key, _, err := parsingcommon.ResolveEncryptionKey(cmd, consts.ENV_TARGET_PROFILE_KEY, consts.ENV_SOURCE_PROFILE_KEY)
+	if err != nil {
+		return nil, err
+	}
+	fingerprints, _ := cmd.Flags().GetStringSlice("fingerprint")
+	skipConfirm, _ := cmd.Flags().GetBool("skip-confirm")
+	interactive := parsingcommon.IsInteractiveMode()
+
+	if !interactive {
+		missing := make([]string, 0, 3)
+		if strings.TrimSpace(filePath) == "" {
+			missing = append(missing, "--profile")
+		}
+		if strings.TrimSpace(key) == "" {
+			missing = append(missing, "--profile-key / ENV "+consts.ENV_TARGET_PROFILE_KEY+" atau "+consts.ENV_SOURCE_PROFILE_KEY)
+		}
+		if len(fingerprints) == 0 && !skipConfirm {
+			missing = append(missing, "--fingerprint atau --skip-confirm")
+		}
+		if err := parsingcommon.ValidateNonInteractive(interactive, missing,
+			"Contoh: sfdbtools profile ssh trust --quiet --profile <nama-file> --profile-key <key> --fingerprint SHA256:..."); err != nil {
+			return nil, err
+		}
+	}
+
+	cleaned := make([]string, 0, len(fingerprints))
+	for _, fp := range fingerprints {
+		if fp = strings.TrimSpace(fp); fp != "" {
+			cleaned = append(cleaned, fp)
+		}
+	}
+
+	return &profilemodel.ProfileSSHTrustOptions{
+		Interactive:  interactive,
+		Fingerprints: cleaned,
+		SkipConfirm:  skipConfirm,
+		ProfileInfo: domain.ProfileInfo{
+			Path:          filePath,
+			EncryptionKey: key,
+		},
+	}, nil
+}
@@ -0,0 +1,51 @@
+package profile
+
+import (
+	"fmt"
+	"sfdbtools/internal/app/profile/helpers/reader"
+	profilemodel "sfdbtools/internal/app/profile/model"
+	parsingcommon "sfdbtools/internal/cli/parsing/common"
+	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/shared/consts"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// ParsingSyncProfile parses flags untuk profile sync command
+func ParsingSyncProfile(cmd *cobra.Command) (*profilemodel.ProfileSyncOptions, error) {
+	input := strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "input", ""))
+	prune := resolver.GetBoolFlagOrEnv(cmd, "prune", "")
+	dryRun := resolver.GetBoolFlagOrEnv(cmd, "dry-run", "")
+	skipConfirm := resolver.GetBoolFlagOrEnv(cmd, "skip-confirm", "")
+	continueOnError := resolver.GetBoolFlagOrEnv(cmd, "continue-on-error", "")
+	skipConnTest := resolver.GetBoolFlagOrEnv(cmd, "skip-conn-test", "")
+	profileKey, err := resolver.GetSecretStringFlagOrEnv(cmd, "profile-key", consts.ENV_TARGET_PROFILE_KEY)
+	if err != nil {
+		return nil, err
+	}
+
+	if input == "" {
+		return nil, fmt.Errorf("sumber sync tidak tersedia: gunakan --input <manifest.yaml>")
+	}
+	if cmd.Flags().Changed("profile-key") && !reader.IsManifestPath(input) {
+		return nil, fmt.Errorf("--profile-key hanya dipakai bersama manifest YAML/JSON (XLSX/CSV memakai kolom profile_key)")
+	}
+
+	interactive := parsingcommon.IsInteractiveMode()
+	// Dry-run tidak menulis apa pun sehingga aman tanpa --skip-confirm.
+	if !interactive && !skipConfirm && !dryRun {
+		return nil, fmt.Errorf("mode non-interaktif: flag --skip-confirm wajib disertakan untuk automation")
+	}
+
+	return &profilemodel.ProfileSyncOptions{
+		Input:           input,
+		ProfileKey:      profileKey,
+		Prune:           prune,
+		DryRun:          dryRun,
+		SkipConfirm:     skipConfirm,
+		SkipConnTest:    skipConnTest,
+		ContinueOnError: continueOnError,
+		Interactive:     interactive && !skipConfirm,
+	}, nil
+}
@@ -1,8 +1,11 @@
 package parsing
 
 import (
+	"fmt"
 	"sfdbtools/internal/app/script"
+	parsingcommon "sfdbtools/internal/cli/parsing/common"
 	resolver "sfdbtools/internal/cli/resolver"
+	"sfdbtools/internal/shared/consts"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -20,18 +23,42 @@ func ParsingScriptEncryptOptions(cmd *cobra.Command) script.EncryptOptions {
 		Mode:          resolver.GetStringFlagOrEnv(cmd, "mode", ""),
 		OutputPath:    resolver.GetStringFlagOrEnv(cmd, "output", ""),
 		DeleteSource:  deleteSource,
+		SignKey:       resolver.GetStringFlagOrEnv(cmd, "sign-key", consts.ENV_SCRIPT_SIGN_KEY),
+		SpecPath:      resolver.GetStringFlagOrEnv(cmd, "spec", ""),
 	}
 }
 
-func ParsingScriptRunOptions(cmd *cobra.Command) script.RunOptions {
+func ParsingScriptRunOptions(cmd *cobra.Command) (script.RunOptions, error) {
 	key := resolver.GetStringFlagOrEnv(cmd, "key", "")
 	if strings.TrimSpace(key) == "" {
 		key = resolver.GetStringFlagOrEnv(cmd, "encryption-key", "")
 	}
-	return script.RunOptions{
-		FilePath:      resolver.GetStringFlagOrEnv(cmd, "file", ""),
-		EncryptionKey: key,
+	allowUntrusted, _ := cmd.Flags().GetBool("allow-untrusted")
+	transaction, _ := cmd.Flags().GetBool("transaction")
+	forceRerun, _ := cmd.Flags().GetBool("force-rerun")
+	opts := script.RunOptions{
+		FilePath:       resolver.GetStringFlagOrEnv(cmd, "file", ""),
+		EncryptionKey:  key,
+		AllowUntrusted: allowUntrusted,
+		Interactive:    parsingcommon.IsInteractiveMode(),
+		ProfilePath:    strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "profile", "")),
+		Database:       strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "database", "")),
+		Ticket:         strings.TrimSpace(resolver.GetStringFlagOrEnv(cmd, "ticket", "")),
+		Transaction:    transaction,
+		ForceRerun:     forceRerun,
+	}
+	if opts.ProfilePath == "" {
+		if opts.Database != "" {
+			return opts, fmt.Errorf("--database hanya berlaku bersama --profile")
+		}
+		return opts, nil
+	}
+	profileKey, err := resolver.GetSecretStringFlagOrEnv(cmd, "profile-key", consts.ENV_SOURCE_PROFILE_KEY)
+	if err != nil {
+		return opts, err
 	}
+	opts.ProfileKey = profileKey
+	return opts, nil
 }
 
 func ParsingScriptExtractOptions(cmd *cobra.Command) script.ExtractOptions {
@@ -39,10 +66,12 @@ func ParsingScriptExtractOptions(cmd *cobra.Command) script.ExtractOptions {
 	if strings.TrimSpace(key) == "" {
 		key = resolver.GetStringFlagOrEnv(cmd, "encryption-key", "")
 	}
+	allowUntrusted, _ := cmd.Flags().GetBool("allow-untrusted")
 	return script.ExtractOptions{
-		FilePath:      resolver.GetStringFlagOrEnv(cmd, "file", ""),
-		EncryptionKey: key,
-		OutDir:        resolver.GetStringFlagOrEnv(cmd, "out-dir", ""),
+		FilePath:       resolver.GetStringFlagOrEnv(cmd, "file", ""),
+		EncryptionKey:  key,
+		OutDir:         resolver.GetStringFlagOrEnv(cmd, "out-dir", ""),
+		AllowUntrusted: allowUntrusted,
 	}
 }
 
@@ -51,8 +80,10 @@ func ParsingScriptInfoOptions(cmd *cobra.Command) script.InfoOptions {
 	if strings.TrimSpace(key) == "" {
 		key = resolver.GetStringFlagOrEnv(cmd, "encryption-key", "")
 	}
+	allowUntrusted, _ := cmd.Flags().GetBool("allow-untrusted")
 	return script.InfoOptions{
-		FilePath:      resolver.GetStringFlagOrEnv(cmd, "file", ""),
-		EncryptionKey: key,
+		FilePath:       resolver.GetStringFlagOrEnv(cmd, "file", ""),
+		EncryptionKey:  key,
+		AllowUntrusted: allowUntrusted,
 	}
 }
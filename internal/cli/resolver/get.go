@@ -24,13 +24,18 @@ func GetStringFlagOrEnv(cmd *cobra.Command, flagName, envName string) string {
 }
 
 // GetSecretStringFlagOrEnv mengambil nilai secret dari flag atau env.
-// - Flag selalu dianggap plaintext (tidak didekripsi meskipun bernilai "prefix:...")
+// - Flag dan env boleh berupa referensi secret (vault://, file://, cmd://) yang langsung di-resolve.
+// - Flag non-referensi dianggap plaintext (tidak didekripsi meskipun bernilai "prefix:...").
 // - Env mendukung plaintext maupun format terenkripsi "prefix:<payload>".
 // Jika env memakai prefix namun payload invalid, akan mengembalikan error (fail-fast).
 func GetSecretStringFlagOrEnv(cmd *cobra.Command, flagName, envName string) (string, error) {
 	val, _ := cmd.Flags().GetString(flagName)
 	if val != "" {
-		return val, nil
+		v, err := crypto.ResolveSecret(val)
+		if err != nil {
+			return "", fmt.Errorf("gagal membaca --%s: %w", flagName, err)
+		}
+		return v, nil
 	}
 	if strings.TrimSpace(envName) == "" {
 		return "", nil
@@ -0,0 +1,137 @@
+// File : internal/cli/runner/fleet.go
+// Deskripsi : Wrapper command untuk eksekusi ke banyak profile (--profiles selector)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package runner
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"sfdbtools/internal/app/fleet"
+	appdeps "sfdbtools/internal/cli/deps"
+	"sfdbtools/internal/cli/flags"
+	"sfdbtools/internal/cli/parsing"
+	parsingcommon "sfdbtools/internal/cli/parsing/common"
+
+	"github.com/spf13/cobra"
+)
+
+// fleetFlags adalah flag milik parent fleet yang tidak diteruskan ke proses child.
+// Kunci profile diteruskan lewat env agar tidak muncul di argv child maupun log fleet.
+var fleetFlags = []string{"profiles", "concurrency", "fleet-log-dir", "fleet-dry-run", "profile-key"}
+
+// WithFleet menambahkan flag fleet ke cmd dan membungkus Run/RunE-nya.
+// Tanpa --profiles, command berjalan seperti biasa. Dengan --profiles, command dijalankan ulang
+// sebagai proses terpisah untuk setiap profile terpilih (lihat internal/app/fleet).
+// Panggil setelah flag command didaftarkan.
+func WithFleet(cmd *cobra.Command) {
+	passProfile := cmd.Flags().Lookup("profile") != nil
+	flags.AddFleetFlags(cmd)
+
+	if run := cmd.Run; run != nil {
+		cmd.Run = func(c *cobra.Command, args []string) {
+			if !c.Flags().Changed("profiles") {
+				run(c, args)
+				return
+			}
+			Run(c, func() error { return runFleet(c, passProfile) })
+		}
+	}
+	if runE := cmd.RunE; runE != nil {
+		cmd.RunE = func(c *cobra.Command, args []string) error {
+			if !c.Flags().Changed("profiles") {
+				return runE(c, args)
+			}
+			return runFleet(c, passProfile)
+		}
+	}
+}
+
+func runFleet(cmd *cobra.Command, passProfile bool) error {
+	if appdeps.Deps == nil {
+		return fmt.Errorf("%s", depsMissingMsg)
+	}
+	if fleet.IsChild() {
+		return fmt.Errorf("--profiles tidak boleh dipakai di dalam proses fleet")
+	}
+	opts, err := parsing.ParsingFleetOptions(cmd)
+	if err != nil {
+		return err
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("gagal menentukan path binary sfdbtools: %w", err)
+	}
+
+	inv := fleet.ChildInvocation{
+		Executable:  exe,
+		Args:        stripFleetArgs(cmd, os.Args[1:]),
+		PassProfile: passProfile,
+	}
+	return fleet.Execute(appdeps.Deps.Config, appdeps.Deps.Logger, opts, inv, cmd.CommandPath(), parsingcommon.IsInteractiveMode())
+}
+
+// fleetArg adalah bentuk argv sebuah flag fleet.
+type fleetArg struct {
+	long, short string
+	hasValue    bool
+}
+
+// matchesShortValue cocok untuk shorthand dengan value menempel ("-k=value" atau "-kvalue").
+// Shorthand bool hanya cocok dengan "-d=value" agar gabungan shorthand lain tidak ikut terbuang.
+func (f fleetArg) matchesShortValue(a string) bool {
+	if f.short == "" || strings.HasPrefix(a, "--") {
+		return false
+	}
+	if f.hasValue {
+		return strings.HasPrefix(a, f.short)
+	}
+	return strings.HasPrefix(a, f.short+"=")
+}
+
+// stripFleetArgs membuang flag fleet dari argv, baik bentuk panjang ("--flag value", "--flag=value")
+// maupun shorthand ("-k value", "-k=value", "-kvalue"). Shorthand diambil dari definisi flag cmd.
+func stripFleetArgs(cmd *cobra.Command, args []string) []string {
+	var known []fleetArg
+	for _, name := range fleetFlags {
+		f := cmd.Flags().Lookup(name)
+		if f == nil {
+			continue
+		}
+		fa := fleetArg{long: "--" + f.Name, hasValue: f.NoOptDefVal == ""}
+		if f.Shorthand != "" {
+			fa.short = "-" + f.Shorthand
+		}
+		known = append(known, fa)
+	}
+
+	out := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--" {
+			return append(out, args[i:]...)
+		}
+		skipped := false
+		for _, f := range known {
+			if a == f.long || (f.short != "" && a == f.short) {
+				if f.hasValue {
+					i++ // buang juga value-nya
+				}
+				skipped = true
+				break
+			}
+			if strings.HasPrefix(a, f.long+"=") || f.matchesShortValue(a) {
+				skipped = true
+				break
+			}
+		}
+		if !skipped {
+			out = append(out, a)
+		}
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+package runner
+
+import (
+	"reflect"
+	"testing"
+
+	"sfdbtools/internal/cli/flags"
+
+	"github.com/spf13/cobra"
+)
+
+func TestStripFleetArgs(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringP("profile-key", "k", "", "")
+	cmd.Flags().BoolP("dry-run", "d", false, "")
+	flags.AddFleetFlags(cmd)
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "long forms",
+			args: []string{"backup", "--profiles", "tag:env=prod", "--concurrency=4", "--fleet-dry-run", "--profile-key", "rahasia", "-d"},
+			want: []string{"backup", "-d"},
+		},
+		{
+			name: "long forms with equals",
+			args: []string{"backup", "--profiles=all", "--fleet-log-dir=/tmp/x", "--profile-key=rahasia"},
+			want: []string{"backup"},
+		},
+		{
+			name: "shorthand separate value",
+			args: []string{"backup", "-k", "rahasia", "--profiles", "all"},
+			want: []string{"backup"},
+		},
+		{
+			name: "shorthand with equals",
+			args: []string{"backup", "-k=rahasia", "--profiles=all"},
+			want: []string{"backup"},
+		},
+		{
+			name: "shorthand attached value",
+			args: []string{"backup", "-krahasia", "--profiles=all"},
+			want: []string{"backup"},
+		},
+		{
+			name: "args after double dash are kept",
+			args: []string{"backup", "--profiles=all", "--", "-k", "x"},
+			want: []string{"backup", "--", "-k", "x"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripFleetArgs(cmd, tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("stripFleetArgs = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
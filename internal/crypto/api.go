@@ -8,6 +8,7 @@
 //   - core/: AES-256-GCM primitives
 //   - stream/: Streaming encryption/decryption for large files
 //   - key/: Key resolution, derivation, and ENV secret handling
+//   - secret/: External secret references (vault://, file://, cmd://)
 //   - auth/: Password prompting and validation
 //   - file/: File encryption convenience functions
 //
@@ -62,6 +63,7 @@ import (
 	"sfdbtools/internal/crypto/core"
 	"sfdbtools/internal/crypto/file"
 	"sfdbtools/internal/crypto/key"
+	"sfdbtools/internal/crypto/secret"
 	"sfdbtools/internal/crypto/stream"
 )
 
@@ -208,6 +210,25 @@ func ResolveEnvSecret(envName string) (string, error) {
 	return key.ResolveEnvSecret(envName)
 }
 
+// ResolveSecret resolves a secret reference (vault://, file://, cmd://).
+//
+// Non-reference values are returned as-is. Results are cached per process.
+func ResolveSecret(value string) (string, error) {
+	return secret.Resolve(value)
+}
+
+// IsSecretReference reports whether value is a secret reference.
+func IsSecretReference(value string) bool {
+	return secret.IsReference(value)
+}
+
+// SecretProviderEnvKeys returns ENV names read by secret providers (Vault address, token, ...).
+//
+// Child processes that must not read profile secrets should run without them.
+func SecretProviderEnvKeys() []string {
+	return secret.VaultEnvKeys()
+}
+
 // EncryptedPrefixForDisplay returns the prefix for encrypted ENV values.
 // Used in CLI help text and documentation.
 func EncryptedPrefixForDisplay() string {
@@ -2,7 +2,7 @@
 // Deskripsi : ENV value encryption/decryption dengan master key derivation
 // Author : Hadiyatna Muflihun
 // Tanggal : 8 Januari 2026
-// Last Modified : 8 Januari 2026
+// Last Modified : 18 Oktober 2026
 package key
 
 import (
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"sfdbtools/internal/crypto/secret"
 	"sfdbtools/internal/shared/consts"
 )
 
@@ -198,6 +199,7 @@ func DecodeEnvValue(value string) (decoded string, wasEncrypted bool, err error)
 }
 
 // ResolveEnvSecret gets ENV var value and auto-decrypts if encrypted.
+// Values holding a secret reference (vault://, file://, cmd://) are fetched from the provider.
 //
 // Returns empty string if ENV var not set.
 // Returns error if value is encrypted but decryption fails.
@@ -218,7 +220,11 @@ func ResolveEnvSecret(envVar string) (string, error) {
 	if wasEncrypted {
 		return decoded, nil
 	}
-	return raw, nil
+	resolved, err := secret.Resolve(raw)
+	if err != nil {
+		return "", fmt.Errorf("env %s: %w", envVar, err)
+	}
+	return resolved, nil
 }
 
 // Helper functions
@@ -2,13 +2,14 @@
 // Deskripsi : Key resolution dari multiple sources (flag, env, prompt)
 // Author : Hadiyatna Muflihun
 // Tanggal : 8 Januari 2026
-// Last Modified : 9 Januari 2026
+// Last Modified : 18 Oktober 2026
 package key
 
 import (
 	"fmt"
 	"strings"
 
+	"sfdbtools/internal/crypto/secret"
 	"sfdbtools/internal/ui/prompt"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -19,6 +20,8 @@ import (
 //  2. Environment variable
 //  3. Interactive prompt (if allowPrompt = true)
 //
+// Nilai flag/env boleh berupa referensi secret (vault://, file://, cmd://).
+//
 // Parameters:
 //   - explicit: key from command-line flag or parameter
 //   - envName: environment variable name to check
@@ -35,7 +38,11 @@ import (
 func Resolve(explicit, envName string, allowPrompt bool) (key, source string, err error) {
 	// Priority 1: Explicit key from flag/parameter
 	if k := strings.TrimSpace(explicit); k != "" {
-		return k, "flag", nil
+		resolved, err := secret.Resolve(k)
+		if err != nil {
+			return "", "flag", err
+		}
+		return strings.TrimSpace(resolved), "flag", nil
 	}
 
 	// Priority 2: Environment variable (with auto-decrypt if encrypted)
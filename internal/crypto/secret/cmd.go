@@ -0,0 +1,42 @@
+// File : internal/crypto/secret/cmd.go
+// Deskripsi : Provider secret berbasis command eksternal (cmd://)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package secret
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// cmdProvider menjalankan command (mis. `pass show db/prod`) dan memakai stdout sebagai secret.
+// Stdin dan stderr diteruskan ke terminal agar command yang meminta passphrase (gpg) tetap bisa dipakai.
+type cmdProvider struct{}
+
+func (cmdProvider) Fetch(ctx context.Context, ref Reference) (string, error) {
+	command := strings.TrimSpace(ref.Target)
+	if command == "" {
+		return "", fmt.Errorf("command kosong")
+	}
+
+	var stdout bytes.Buffer
+	c := exec.CommandContext(ctx, "sh", "-c", command)
+	c.Stdin = os.Stdin
+	c.Stdout = &stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return "", fmt.Errorf("command gagal: %w", err)
+	}
+	// Seperti `pass`, baris pertama adalah secret.
+	out := strings.TrimRight(stdout.String(), "\r\n")
+	if first, _, found := strings.Cut(out, "\n"); found {
+		out = strings.TrimRight(first, "\r")
+	}
+	return out, nil
+}
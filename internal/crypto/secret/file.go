@@ -0,0 +1,30 @@
+// File : internal/crypto/secret/file.go
+// Deskripsi : Provider secret berbasis file (file://)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fileProvider membaca secret dari file, mis. Docker/Kubernetes secret di /run/secrets.
+type fileProvider struct{}
+
+func (fileProvider) Fetch(_ context.Context, ref Reference) (string, error) {
+	path := ref.Target
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path file harus absolut (file:///path/ke/secret)")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
@@ -0,0 +1,126 @@
+// File : internal/crypto/secret/secret.go
+// Deskripsi : Referensi secret eksternal (vault://, file://, cmd://) dengan cache per proses
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+// Package secret me-resolve referensi secret eksternal yang dapat dipakai di profile,
+// config.yaml, dan flag/env key. Nilai yang bukan referensi dikembalikan apa adanya.
+//
+// Format referensi:
+//
+//	vault://<mount>/<path>#<field>   HashiCorp Vault KV v2 (VAULT_ADDR, VAULT_TOKEN)
+//	file:///run/secrets/db_password  Isi file (newline di akhir dibuang)
+//	cmd://pass show db/prod          Stdout command (dijalankan via sh -c)
+package secret
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Reference adalah referensi secret yang sudah di-parse.
+type Reference struct {
+	Raw    string // Referensi lengkap, mis. vault://kv/db/prod#password
+	Scheme string // vault, file, cmd
+	Target string // Bagian setelah "<scheme>://" tanpa fragment
+	Field  string // Fragment setelah '#' (hanya untuk vault)
+}
+
+// Provider mengambil nilai secret untuk satu scheme.
+type Provider interface {
+	Fetch(ctx context.Context, ref Reference) (string, error)
+}
+
+// defaultTimeout adalah batas waktu satu kali pengambilan secret.
+const defaultTimeout = 30 * time.Second
+
+var (
+	providers = map[string]Provider{
+		"vault": vaultProvider{},
+		"file":  fileProvider{},
+		"cmd":   cmdProvider{},
+	}
+
+	cacheMu sync.Mutex
+	cache   = make(map[string]string)
+)
+
+// Parse mem-parse referensi. ok=false jika value bukan referensi secret yang dikenal.
+func Parse(value string) (ref Reference, ok bool) {
+	v := strings.TrimSpace(value)
+	scheme, rest, found := strings.Cut(v, "://")
+	if !found {
+		return Reference{}, false
+	}
+	scheme = strings.ToLower(scheme)
+	if _, known := providers[scheme]; !known {
+		return Reference{}, false
+	}
+
+	ref = Reference{Raw: v, Scheme: scheme, Target: rest}
+	// Fragment hanya bermakna untuk vault; command boleh mengandung '#'.
+	if scheme == "vault" {
+		if target, field, hasField := strings.Cut(rest, "#"); hasField {
+			ref.Target = target
+			ref.Field = field
+		}
+	}
+	return ref, true
+}
+
+// IsReference mengecek apakah value adalah referensi secret.
+func IsReference(value string) bool {
+	_, ok := Parse(value)
+	return ok
+}
+
+// Resolve mengembalikan nilai secret jika value adalah referensi, atau value apa adanya.
+// Hasil di-cache per proses sehingga Vault/command hanya dipanggil sekali per run.
+func Resolve(value string) (string, error) {
+	ref, ok := Parse(value)
+	if !ok {
+		return value, nil
+	}
+
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if v, hit := cache[ref.Raw]; hit {
+		return v, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+	v, err := providers[ref.Scheme].Fetch(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("gagal mengambil secret %s: %w", Redact(ref.Raw), err)
+	}
+	if v == "" {
+		return "", fmt.Errorf("secret %s kosong", Redact(ref.Raw))
+	}
+	cache[ref.Raw] = v
+	return v, nil
+}
+
+// Redact menyamarkan argumen command pada referensi cmd:// agar aman ditampilkan di log.
+func Redact(value string) string {
+	ref, ok := Parse(value)
+	if !ok || ref.Scheme != "cmd" {
+		return value
+	}
+	fields := strings.Fields(ref.Target)
+	if len(fields) == 0 {
+		return value
+	}
+	return "cmd://" + fields[0] + " ..."
+}
+
+// ResetCache mengosongkan cache secret (mis. setelah rotasi).
+func ResetCache() {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	cache = make(map[string]string)
+}
@@ -0,0 +1,117 @@
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		wantOK bool
+		want   Reference
+	}{
+		{
+			name:   "vault with field",
+			value:  "vault://kv/db/prod#password",
+			wantOK: true,
+			want:   Reference{Raw: "vault://kv/db/prod#password", Scheme: "vault", Target: "kv/db/prod", Field: "password"},
+		},
+		{
+			name:   "vault without field",
+			value:  "vault://kv/db/prod",
+			wantOK: true,
+			want:   Reference{Raw: "vault://kv/db/prod", Scheme: "vault", Target: "kv/db/prod"},
+		},
+		{
+			name:   "scheme is case-insensitive and value trimmed",
+			value:  "  FILE:///run/secrets/db  ",
+			wantOK: true,
+			want:   Reference{Raw: "FILE:///run/secrets/db", Scheme: "file", Target: "/run/secrets/db"},
+		},
+		{
+			name:   "cmd keeps hash in target",
+			value:  "cmd://pass show db#prod",
+			wantOK: true,
+			want:   Reference{Raw: "cmd://pass show db#prod", Scheme: "cmd", Target: "pass show db#prod"},
+		},
+		{name: "plain password", value: "s3cret", wantOK: false},
+		{name: "unknown scheme", value: "https://example.com/secret", wantOK: false},
+		{name: "empty", value: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Parse(tt.value)
+			if ok != tt.wantOK {
+				t.Fatalf("Parse(%q) ok = %v, want %v", tt.value, ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Fatalf("Parse(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+			if IsReference(tt.value) != tt.wantOK {
+				t.Fatalf("IsReference(%q) != %v", tt.value, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "cmd://pass show db/prod", want: "cmd://pass ..."},
+		{value: "vault://kv/db/prod#password", want: "vault://kv/db/prod#password"},
+		{value: "file:///run/secrets/db", want: "file:///run/secrets/db"},
+		{value: "plain", want: "plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := Redact(tt.value); got != tt.want {
+				t.Fatalf("Redact(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveFile(t *testing.T) {
+	dir := t.TempDir()
+	secretPath := filepath.Join(dir, "db_password")
+	if err := os.WriteFile(secretPath, []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	emptyPath := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyPath, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "non reference returned as-is", value: "plain", want: "plain"},
+		{name: "file trims trailing newline", value: "file://" + secretPath, want: "s3cret"},
+		{name: "relative path rejected", value: "file://relative/secret", wantErr: true},
+		{name: "missing file", value: "file://" + filepath.Join(dir, "missing"), wantErr: true},
+		{name: "empty secret rejected", value: "file://" + emptyPath, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ResetCache()
+			got, err := Resolve(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resolve(%q) err = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Resolve(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
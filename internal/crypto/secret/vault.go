@@ -0,0 +1,183 @@
+// File : internal/crypto/secret/vault.go
+// Deskripsi : Provider secret HashiCorp Vault KV v2 (vault://)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package secret
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Environment variable standar Vault CLI.
+const (
+	envVaultAddr       = "VAULT_ADDR"
+	envVaultToken      = "VAULT_TOKEN"
+	envVaultNamespace  = "VAULT_NAMESPACE"
+	envVaultCACert     = "VAULT_CACERT"
+	envVaultSkipVerify = "VAULT_SKIP_VERIFY"
+
+	defaultVaultAddr = "http://127.0.0.1:8200"
+)
+
+// VaultEnvKeys mengembalikan env Vault yang dibaca provider vault://. Proses anak yang tidak boleh
+// melihat secret (mis. script bundle) harus dijalankan tanpa env ini.
+func VaultEnvKeys() []string {
+	return []string{envVaultAddr, envVaultToken, envVaultNamespace, envVaultCACert, envVaultSkipVerify}
+}
+
+// vaultProvider membaca secret dari Vault KV v2.
+// vault://kv/db/prod#password -> GET $VAULT_ADDR/v1/kv/data/db/prod, field "password".
+type vaultProvider struct{}
+
+func (vaultProvider) Fetch(ctx context.Context, ref Reference) (string, error) {
+	mount, path, ok := strings.Cut(strings.Trim(ref.Target, "/"), "/")
+	if !ok || mount == "" || path == "" {
+		return "", fmt.Errorf("format vault tidak valid, gunakan vault://<mount>/<path>#<field>")
+	}
+
+	token, err := vaultToken()
+	if err != nil {
+		return "", err
+	}
+	client, err := vaultHTTPClient()
+	if err != nil {
+		return "", err
+	}
+
+	addr := strings.TrimRight(os.Getenv(envVaultAddr), "/")
+	if addr == "" {
+		addr = defaultVaultAddr
+	}
+	endpoint := fmt.Sprintf("%s/v1/%s/data/%s", addr, url.PathEscape(mount), escapeVaultPath(path))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if ns := strings.TrimSpace(os.Getenv(envVaultNamespace)); ns != "" {
+		req.Header.Set("X-Vault-Namespace", ns)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request ke Vault gagal: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("server Vault mengembalikan HTTP %d%s", resp.StatusCode, vaultErrors(body))
+	}
+
+	var payload struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", fmt.Errorf("response Vault tidak valid: %w", err)
+	}
+	return pickVaultField(payload.Data.Data, ref.Field)
+}
+
+// pickVaultField mengambil field dari data secret. Field boleh kosong jika secret hanya punya satu key.
+func pickVaultField(data map[string]interface{}, field string) (string, error) {
+	if len(data) == 0 {
+		return "", fmt.Errorf("secret tidak memiliki data (bukan KV v2 atau sudah dihapus)")
+	}
+	if field == "" {
+		if len(data) != 1 {
+			keys := make([]string, 0, len(data))
+			for k := range data {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			return "", fmt.Errorf("secret memiliki beberapa field (%s), tentukan dengan #<field>", strings.Join(keys, ", "))
+		}
+		for k := range data {
+			field = k
+		}
+	}
+	v, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("field %q tidak ditemukan", field)
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// vaultToken membaca token dari VAULT_TOKEN atau ~/.vault-token (hasil `vault login`).
+func vaultToken() (string, error) {
+	if t := strings.TrimSpace(os.Getenv(envVaultToken)); t != "" {
+		return t, nil
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		if data, err := os.ReadFile(filepath.Join(home, ".vault-token")); err == nil {
+			if t := strings.TrimSpace(string(data)); t != "" {
+				return t, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("token Vault tidak tersedia: set %s atau jalankan `vault login`", envVaultToken)
+}
+
+func vaultHTTPClient() (*http.Client, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caPath := strings.TrimSpace(os.Getenv(envVaultCACert)); caPath != "" {
+		pem, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membaca %s: %w", envVaultCACert, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s tidak berisi sertifikat PEM yang valid", envVaultCACert)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(envVaultSkipVerify))) {
+	case "1", "true", "yes":
+		tlsCfg.InsecureSkipVerify = true
+	}
+	return &http.Client{
+		Timeout:   defaultTimeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsCfg},
+	}, nil
+}
+
+func escapeVaultPath(path string) string {
+	parts := strings.Split(path, "/")
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+	return strings.Join(parts, "/")
+}
+
+// vaultErrors mengambil pesan error dari body response Vault ({"errors": [...]}).
+func vaultErrors(body []byte) string {
+	var e struct {
+		Errors []string `json:"errors"`
+	}
+	if json.Unmarshal(body, &e) != nil || len(e.Errors) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(e.Errors, "; ")
+}
@@ -9,7 +9,18 @@ type DBInfo struct {
 	Port     int
 	User     string
 	Password string
-	Version  string // mariadb or mysql
+	Version  string    // mariadb or mysql
+	TLS      TLSConfig // Pengaturan TLS/SSL koneksi (kosong = perilaku default client/driver)
+	Socket   string    // Path unix socket; jika diisi koneksi lokal lewat socket (Host/Port diabaikan)
+}
+
+// TLSConfig menyimpan pengaturan TLS/SSL koneksi database.
+// Mode mengikuti penamaan --ssl-mode MySQL: disabled, preferred, required, verify-ca, verify-identity.
+type TLSConfig struct {
+	Mode string
+	CA   string // Path CA certificate (PEM)
+	Cert string // Path client certificate (PEM)
+	Key  string // Path client private key (PEM)
 }
 
 type SourceDBConnection struct {
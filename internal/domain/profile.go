@@ -11,6 +11,8 @@ type SSHTunnelConfig struct {
 	User              string
 	Password          string
 	IdentityFile      string
+	JumpHosts         []string // Rantai ProxyJump berurutan sebelum bastion, format [user@]host[:port]
+	UseAgent          bool     // Autentikasi via ssh-agent (SSH_AUTH_SOCK) tanpa private key di disk
 	LocalPort         int
 	ResolvedLocalPort int
 }
@@ -22,6 +24,8 @@ type ProfileInfo struct {
 	SSHTunnel        SSHTunnelConfig
 	EncryptionKey    string
 	EncryptionSource string
+	Tags             map[string]string // Label key=value untuk seleksi fleet (mis. env=prod)
+	Groups           []string          // Nama grup untuk seleksi fleet (mis. jkt-primary)
 	Size             string
 	LastModified     time.Time
 	Path             string
@@ -2,7 +2,7 @@
 // Deskripsi : Default config + auto-init config file (zero-config first run)
 // Author : Hadiyatna Muflihun
 // Tanggal : 2 Januari 2026
-// Last Modified : 20 Januari 2026
+// Last Modified : 18 Oktober 2026
 package appconfig
 
 import (
@@ -56,10 +56,22 @@ func defaultConfigForPath(configPath string) *Config {
 	cfg.Log.Timezone = "Asia/Jakarta"
 
 	cfg.Profile.Connection.Timeout = "15s"
+	cfg.Profile.Fleet.Concurrency = 4
+	cfg.Profile.Fleet.LogDir = filepath.Join(baseDir, "fleet_logs")
+	cfg.Profile.History.Dir = filepath.Join(baseDir, "profile_history")
+	cfg.Profile.History.Keep = 20
 
 	cfg.ConfigDir.DatabaseProfile = filepath.Join(baseDir, "config", "db_profile")
 	cfg.Script.BundleOutputDir = filepath.Join(baseDir, "scripts")
 
+	cfg.DBScan.History.Dir = filepath.Join(baseDir, "dbscan_history")
+	cfg.Backup.Hold.Registry = filepath.Join(baseDir, "backup_holds.json")
+	cfg.DBScan.Forecast.ThresholdPercent = 90
+	cfg.DBScan.Forecast.AnomalyFactor = 5
+	cfg.DBScan.Lint.FailOn = "error"
+	cfg.DBScan.Lint.AutoIncrementWarnPercent = 75
+	cfg.DBScan.Lint.AutoIncrementErrorPercent = 90
+
 	return cfg
 }
 
@@ -2,7 +2,7 @@
 // Deskripsi : Fungsi untuk memuat konfigurasi dari file YAML dan variabel lingkungan
 // Author : Hadiyatna Muflihun
 // Tanggal : 3 Oktober 2024
-// Last Modified : 26 Januari 2026
+// Last Modified : 18 Oktober 2026
 package appconfig
 
 import (
@@ -105,4 +105,37 @@ func applyRuntimeDefaults(cfg *Config, configPath string) {
 	if cfg.ConfigDir.DatabaseProfile == "" {
 		cfg.ConfigDir.DatabaseProfile = defaultConfigForPath(configPath).ConfigDir.DatabaseProfile
 	}
+	if cfg.Profile.Fleet.Concurrency <= 0 {
+		cfg.Profile.Fleet.Concurrency = defaultConfigForPath(configPath).Profile.Fleet.Concurrency
+	}
+	if cfg.Profile.Fleet.LogDir == "" {
+		cfg.Profile.Fleet.LogDir = defaultConfigForPath(configPath).Profile.Fleet.LogDir
+	}
+	if cfg.Profile.History.Dir == "" {
+		cfg.Profile.History.Dir = defaultConfigForPath(configPath).Profile.History.Dir
+	}
+	if cfg.Profile.History.Keep <= 0 {
+		cfg.Profile.History.Keep = defaultConfigForPath(configPath).Profile.History.Keep
+	}
+	if cfg.Backup.Hold.Registry == "" {
+		cfg.Backup.Hold.Registry = defaultConfigForPath(configPath).Backup.Hold.Registry
+	}
+	if cfg.DBScan.History.Dir == "" {
+		cfg.DBScan.History.Dir = defaultConfigForPath(configPath).DBScan.History.Dir
+	}
+	if cfg.DBScan.Forecast.ThresholdPercent <= 0 || cfg.DBScan.Forecast.ThresholdPercent > 100 {
+		cfg.DBScan.Forecast.ThresholdPercent = defaultConfigForPath(configPath).DBScan.Forecast.ThresholdPercent
+	}
+	if cfg.DBScan.Forecast.AnomalyFactor <= 1 {
+		cfg.DBScan.Forecast.AnomalyFactor = defaultConfigForPath(configPath).DBScan.Forecast.AnomalyFactor
+	}
+	if cfg.DBScan.Lint.FailOn == "" {
+		cfg.DBScan.Lint.FailOn = defaultConfigForPath(configPath).DBScan.Lint.FailOn
+	}
+	if cfg.DBScan.Lint.AutoIncrementWarnPercent <= 0 || cfg.DBScan.Lint.AutoIncrementWarnPercent > 100 {
+		cfg.DBScan.Lint.AutoIncrementWarnPercent = defaultConfigForPath(configPath).DBScan.Lint.AutoIncrementWarnPercent
+	}
+	if cfg.DBScan.Lint.AutoIncrementErrorPercent <= 0 || cfg.DBScan.Lint.AutoIncrementErrorPercent > 100 {
+		cfg.DBScan.Lint.AutoIncrementErrorPercent = defaultConfigForPath(configPath).DBScan.Lint.AutoIncrementErrorPercent
+	}
 }
@@ -2,7 +2,7 @@
 // Deskripsi : Definisi struktur config.yaml
 // Author : Hadiyatna Muflihun
 // Tanggal : 2 Januari 2026
-// Last Modified : 20 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package appconfig
 
@@ -17,12 +17,70 @@ type Config struct {
 	Profile     ProfileConfig     `yaml:"profile"`
 	SystemUsers SystemUsersConfig `yaml:"system_users"`
 	Script      ScriptConfig      `yaml:"script"`
+	DBScan      DBScanConfig      `yaml:"dbscan"`
+}
+
+// Struct untuk bagian 'dbscan'
+type DBScanConfig struct {
+	History  DBScanHistoryConfig  `yaml:"history"`
+	Forecast DBScanForecastConfig `yaml:"forecast"`
+	Lint     DBScanLintConfig     `yaml:"lint"`
+}
+
+// DBScanHistoryConfig mengatur histori snapshot hasil scan lokal (dipakai db-scan trend/forecast).
+type DBScanHistoryConfig struct {
+	// Disabled menonaktifkan pencatatan snapshot setelah scan (default: aktif).
+	Disabled bool `yaml:"disabled"`
+	// Dir lokasi file histori (satu file .jsonl per profile).
+	// Jika kosong, default: <folder config>/dbscan_history.
+	Dir string `yaml:"dir"`
+	// RetentionDays menghapus snapshot yang lebih tua dari N hari saat menulis snapshot baru (0 = simpan selamanya).
+	RetentionDays int `yaml:"retention_days"`
+}
+
+// DBScanForecastConfig mengatur perhitungan forecast kapasitas.
+type DBScanForecastConfig struct {
+	// ThresholdPercent batas pemakaian volume datadir (default: 90).
+	ThresholdPercent float64 `yaml:"threshold_percent"`
+	// AnomalyFactor kelipatan lonjakan ukuran antar snapshot yang dianggap anomali (default: 5).
+	AnomalyFactor float64 `yaml:"anomaly_factor"`
+}
+
+// DBScanLintConfig mengatur rule schema lint (db-scan lint).
+type DBScanLintConfig struct {
+	// Rules mengaktifkan/menonaktifkan rule berdasarkan nama (rule yang tidak disebut = aktif).
+	Rules map[string]bool `yaml:"rules"`
+	// FailOn severity minimum yang membuat command exit non-zero: info, warning, error, none (default: error).
+	FailOn string `yaml:"fail_on"`
+	// AutoIncrementWarnPercent / AutoIncrementErrorPercent batas pemakaian auto-increment (default: 75 / 90).
+	AutoIncrementWarnPercent  float64 `yaml:"auto_increment_warn_percent"`
+	AutoIncrementErrorPercent float64 `yaml:"auto_increment_error_percent"`
 }
 
 // Struct untuk bagian 'profile'
 // Konfigurasi untuk operasi profile (create/edit/show/delete)
 type ProfileConfig struct {
 	Connection ProfileConnectionConfig `yaml:"connection"`
+	Fleet      ProfileFleetConfig      `yaml:"fleet"`
+	History    ProfileHistoryConfig    `yaml:"history"`
+}
+
+// ProfileHistoryConfig mengatur penyimpanan versi lama profile (edit/delete/import overwrite/rollback).
+type ProfileHistoryConfig struct {
+	// Dir lokasi history; satu folder per profile berisi versi terenkripsi + index.json.
+	// Jika kosong, default: <folder config>/profile_history.
+	Dir string `yaml:"dir"`
+	// Keep jumlah versi terbaru yang disimpan per profile (default: 20).
+	Keep int `yaml:"keep"`
+}
+
+// ProfileFleetConfig mengatur eksekusi command ke banyak profile sekaligus (--profiles selector).
+type ProfileFleetConfig struct {
+	// Concurrency jumlah profile yang diproses paralel jika --concurrency tidak diisi (default: 4).
+	Concurrency int `yaml:"concurrency"`
+	// LogDir lokasi output per profile (satu folder per run).
+	// Jika kosong, default: <folder config>/fleet_logs.
+	LogDir string `yaml:"log_dir"`
 }
 
 // ProfileConnectionConfig mengatur timeout untuk connection test saat create/edit profile
@@ -38,6 +96,19 @@ type ScriptConfig struct {
 	// BundleOutputDir jika diisi, output .sftools akan ditaruh di folder ini.
 	// Jika kosong, default: satu folder dengan entrypoint.
 	BundleOutputDir string `yaml:"bundle_output_dir"`
+
+	// TrustedSigners adalah daftar public key ed25519 yang boleh menandatangani bundle.
+	// script run/extract/info menolak bundle yang tidak ditandatangani salah satu signer ini.
+	TrustedSigners []ScriptTrustedSigner `yaml:"trusted_signers"`
+}
+
+// ScriptTrustedSigner adalah satu signer bundle .sftools yang dipercaya.
+type ScriptTrustedSigner struct {
+	// Name adalah label signer yang ditampilkan (mis. "tim-dba").
+	Name string `yaml:"name"`
+	// PublicKey berisi public key ed25519: format authorized_keys ("ssh-ed25519 AAAA..."),
+	// PEM "PUBLIC KEY", base64 32 byte, atau path file yang berisi salah satunya.
+	PublicKey string `yaml:"public_key"`
 }
 
 // Struct untuk bagian 'backup'
@@ -47,10 +118,12 @@ type BackupConfig struct {
 	Exclude       ExcludeConfig      `yaml:"exclude"`
 	Include       IncludeConfig      `yaml:"include"`
 	Cleanup       CleanupConfig      `yaml:"cleanup"`
+	Hold          HoldConfig         `yaml:"hold"`
 	Encryption    EncryptionConfig   `yaml:"encryption"`
 	Output        OutputConfig       `yaml:"output"`
 	Verification  VerificationConfig `yaml:"verification"`
 	Replication   ReplicationConfig  `yaml:"replication"`
+	Scheduler     SchedulerConfig    `yaml:"scheduler"`
 }
 
 type IncludeConfig struct {
@@ -78,6 +151,46 @@ type CleanupConfig struct {
 	Enabled  bool   `yaml:"enabled"`
 	Schedule string `yaml:"schedule"`
 	Days     int    `yaml:"days"`
+	// Retensi GFS (grandfather-father-son) per database. Jika salah satu > 0,
+	// policy GFS dipakai menggantikan retensi berbasis days.
+	KeepDaily   int `yaml:"keep_daily"`
+	KeepWeekly  int `yaml:"keep_weekly"`
+	KeepMonthly int `yaml:"keep_monthly"`
+	KeepYearly  int `yaml:"keep_yearly"`
+	// KeepLast menyimpan N backup sukses terakhir per database.
+	KeepLast int `yaml:"keep_last"`
+	// MaxTotal adalah kuota total ukuran backup (mis. "2TB"); file terlama dihapus lebih dulu.
+	MaxTotal string `yaml:"max_total"`
+}
+
+// SchedulerConfig berisi daftar job terjadwal (backup.scheduler.jobs).
+type SchedulerConfig struct {
+	Jobs []SchedulerJobConfig `yaml:"jobs"`
+}
+
+// SchedulerJobConfig adalah satu job terjadwal. Saat ini hanya bagian yang dipakai
+// `cleanup run --job` yang dibaca (nama, direktori output, dan strategi cleanup).
+type SchedulerJobConfig struct {
+	Name     string `yaml:"name"`
+	Enabled  bool   `yaml:"enabled"`
+	Schedule string `yaml:"schedule"`
+	Output   struct {
+		BaseDirectory string `yaml:"base_directory"`
+	} `yaml:"output"`
+	Cleanup SchedulerJobCleanupConfig `yaml:"cleanup"`
+}
+
+// SchedulerJobCleanupConfig adalah strategi cleanup per job. retention_days setara backup.cleanup.days;
+// keep_* / keep_last / max_total sama dengan backup.cleanup.
+type SchedulerJobCleanupConfig struct {
+	CleanupConfig `yaml:",inline"`
+	RetentionDays int `yaml:"retention_days"`
+}
+
+// HoldConfig mengatur registry legal hold (backup yang tidak boleh dihapus cleanup).
+type HoldConfig struct {
+	// Registry adalah path file JSON registry hold.
+	Registry string `yaml:"registry"`
 }
 
 type EncryptionConfig struct {
@@ -12,4 +12,7 @@ const (
 
 	// DefaultInitialDatabase is the initial DB to connect to (system DB).
 	DefaultInitialDatabase = "mysql"
+
+	// DefaultInventoryDatabase adalah nama database inventory hasil db-scan (--save-db).
+	DefaultInventoryDatabase = "sfdbtools_inventory"
 )
@@ -14,6 +14,12 @@ const (
 	ENV_DEST_DB_NAME   = "SFDB_DEST_DB_NAME"
 	ENV_APP_DB_NAME    = "SFDB_APP_DB_NAME"
 
+	// Koneksi profile untuk script run --profile (diset sfdbtools untuk proses script, bukan input)
+	ENV_DB_SOCKET        = "SFDB_DB_SOCKET"
+	ENV_DB_DEFAULTS_FILE = "SFDB_DB_DEFAULTS_FILE"
+	ENV_SCRIPT_PROFILE   = "SFDB_SCRIPT_PROFILE"
+	ENV_SCRIPT_TICKET    = "SFDB_SCRIPT_TICKET"
+
 	// Encryption Key
 	ENV_SOURCE_PROFILE_KEY = "SFDB_SOURCE_PROFILE_KEY"
 	ENV_TARGET_PROFILE_KEY = "SFDB_TARGET_PROFILE_KEY"
@@ -23,6 +29,10 @@ const (
 	ENV_ENCRYPTION_KEY = "SFDB_ENCRYPTION_KEY"
 	// Script bundle encryption key
 	ENV_SCRIPT_KEY = "SFDB_SCRIPT_KEY"
+	// Private key ed25519 untuk menandatangani bundle script (path file)
+	ENV_SCRIPT_SIGN_KEY = "SFDB_SCRIPT_SIGN_KEY"
+	// Passphrase private key penandatangan bundle yang terenkripsi (mode non-interaktif)
+	ENV_SCRIPT_SIGN_KEY_PASSPHRASE = "SFDB_SCRIPT_SIGN_KEY_PASSPHRASE"
 	// Backup encryption key
 	ENV_BACKUP_ENCRYPTION_KEY = "SFDB_BACKUP_ENCRYPTION_KEY"
 
@@ -46,11 +56,19 @@ const (
 	// Default: verifikasi host key wajib (secure-by-default).
 	ENV_SSH_INSECURE_IGNORE_HOSTKEY = "SFDB_SSH_INSECURE_IGNORE_HOSTKEY"
 
+	// Passphrase untuk SSH identity file terenkripsi (mode non-interaktif).
+	ENV_SSH_KEY_PASSPHRASE = "SFDB_SSH_KEY_PASSPHRASE"
+
 	// Profile Connection Timeout
 	// Override timeout untuk koneksi database saat create/edit profile (format: "15s", "1m", etc.)
 	// Default: 15s
 	ENV_PROFILE_CONNECT_TIMEOUT = "SFDB_PROFILE_CONNECT_TIMEOUT"
 
+	// Fleet (--profiles selector): diset oleh parent untuk setiap proses child per profile.
+	ENV_FLEET_CHILD        = "SFDB_FLEET_CHILD"        // "1" jika proses adalah child fleet
+	ENV_FLEET_PROFILE      = "SFDB_FLEET_PROFILE"      // nama profile yang sedang diproses
+	ENV_FLEET_PROFILE_PATH = "SFDB_FLEET_PROFILE_PATH" // path file profile yang sedang diproses
+
 	// Auto Update (GitHub Releases)
 	ENV_AUTO_UPDATE       = "SFDB_AUTO_UPDATE"       // set 1 untuk enable auto-update saat start
 	ENV_NO_AUTO_UPDATE    = "SFDB_NO_AUTO_UPDATE"    // set 1 untuk disable paksa (override)
@@ -22,3 +22,28 @@ const (
 	ModeSelection = "selection"
 	ModeCustom    = "custom"
 )
+
+// TLS/SSL mode koneksi database (field ssl_mode pada profile).
+// Penamaan mengikuti --ssl-mode MySQL agar mudah dipetakan ke client/driver.
+const (
+	SSLModeDisabled       = "disabled"
+	SSLModePreferred      = "preferred"
+	SSLModeRequired       = "required"
+	SSLModeVerifyCA       = "verify-ca"
+	SSLModeVerifyIdentity = "verify-identity"
+)
+
+// Nilai khusus --socket pada profile.
+const (
+	DBSocketAuto = "auto" // deteksi path socket lokal otomatis
+	DBSocketNone = "none" // hapus socket (kembali ke koneksi TCP host/port)
+)
+
+// Format laporan profile test (--format).
+const (
+	ProfileTestFormatTable = "table"
+	ProfileTestFormatJSON  = "json"
+)
+
+// ProfileImportLoginPathDefault adalah nilai --from-login-path tanpa argumen (pakai file login path default).
+const ProfileImportLoginPathDefault = "default"
@@ -4,7 +4,7 @@ package consts
 // Deskripsi : Konstanta yang digunakan oleh fitur profile (prompt, header, dan pesan umum)
 // Author : Hadiyatna Muflihun
 // Tanggal : 4 Januari 2026
-// Last Modified : 25 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 // =============================================================================
 // Header + Success message
@@ -16,6 +16,12 @@ const (
 	ProfileSuccessDeleted  = "✓ Profile berhasil dihapus"
 	ProfileSuccessCloned   = "✓ Profile berhasil di-clone"
 	ProfileSuccessImported = "✓ Profile berhasil di-import"
+	ProfileSuccessRekeyed  = "✓ Kunci enkripsi profile berhasil dirotasi"
+	ProfileSuccessExported = "✓ Profile berhasil di-export"
+	ProfileSuccessTested   = "✓ Koneksi profile sehat"
+	ProfileSuccessSSHTrust = "✓ Host key SSH profile sudah dipercaya"
+	ProfileSuccessRollback = "✓ Profile berhasil di-rollback"
+	ProfileSuccessSynced   = "✓ Sync profile selesai"
 )
 
 // =============================================================================
@@ -27,12 +33,20 @@ const (
 	ProfileMsgNonInteractivePrefix = "mode " + profileNonInteractiveQuiet + " "
 
 	// Mode strings
-	ProfileModeCreate = "create"
-	ProfileModeShow   = "show"
-	ProfileModeEdit   = "edit"
-	ProfileModeDelete = "delete"
-	ProfileModeClone  = "clone"
-	ProfileModeImport = "import"
+	ProfileModeCreate   = "create"
+	ProfileModeShow     = "show"
+	ProfileModeEdit     = "edit"
+	ProfileModeDelete   = "delete"
+	ProfileModeClone    = "clone"
+	ProfileModeImport   = "import"
+	ProfileModeRekey    = "rekey"
+	ProfileModeExport   = "export"
+	ProfileModeTest     = "test"
+	ProfileModeSSHTrust = "ssh-trust"
+	ProfileModeHistory  = "history"
+	ProfileModeDiff     = "diff"
+	ProfileModeRollback = "rollback"
+	ProfileModeSync     = "sync"
 
 	// UI text / action labels
 	ProfileUIHeaderCreate         = "Pembuatan Profil Baru"
@@ -41,6 +55,14 @@ const (
 	ProfileUIHeaderDelete         = "Penghapusan Profil Database"
 	ProfileUIHeaderClone          = "Clone Profil Database"
 	ProfileUIHeaderImport         = "Import Profil Database"
+	ProfileUIHeaderRekey          = "Rotasi Kunci Profil Database"
+	ProfileUIHeaderExport         = "Export Profil Database"
+	ProfileUIHeaderTest           = "Test Koneksi Profil Database"
+	ProfileUIHeaderSSHTrust       = "Trust Host Key SSH Profil"
+	ProfileUIHeaderHistory        = "History Versi Profil Database"
+	ProfileUIHeaderDiff           = "Perbandingan Versi Profil Database"
+	ProfileUIHeaderRollback       = "Rollback Profil Database"
+	ProfileUIHeaderSync           = "Sinkronisasi Profil dari Manifest"
 	ProfilePromptAction           = "Aksi:"
 	ProfileActionEditData         = "Ubah data"
 	ProfileActionSaveClone        = "Simpan Clone"
@@ -72,28 +94,46 @@ const (
 	ProfileLabelDBPort          = "Database Port"
 	ProfileLabelDBUser          = "Database User"
 	ProfileLabelDBPassword      = "Database Password"
+	ProfileLabelDBSocket        = "Database Socket"
 	ProfileLabelSSHHost         = "SSH Host"
 	ProfileLabelSSHPort         = "SSH Port"
 	ProfileLabelSSHUser         = "SSH User"
 	ProfileLabelSSHPassword     = "SSH Password"
 	ProfileLabelSSHIdentityFile = "SSH Identity File"
+	ProfileLabelSSHJumpHosts    = "SSH Jump Hosts"
+	ProfileLabelSSHAgent        = "SSH Agent"
 	profileLabelLocalPort       = "Local Port"
 	ProfileLabelSSHLocalPort    = "SSH Local Port"
+	ProfileLabelSSLMode         = "SSL Mode"
+	ProfileLabelSSLCA           = "SSL CA"
+	ProfileLabelSSLCert         = "SSL Client Cert"
+	ProfileLabelSSLKey          = "SSL Client Key"
 
 	profileSuffixOptional     = " (opsional)"
 	profileSuffixEmptyDefault = " (kosong = default)"
 	profileSuffixZeroAuto     = " (0 = otomatis)"
 
+	ProfilePromptUseDBSocket             = "Gunakan unix socket lokal untuk koneksi database?"
+	ProfilePromptDBSocket                = ProfileLabelDBSocket + " (path)"
 	ProfilePromptUseSSHTunnel            = "Gunakan SSH tunnel untuk koneksi database?"
 	ProfilePromptSSHHost                 = "SSH Host (bastion)"
 	ProfilePromptSSHUser                 = ProfileLabelSSHUser + profileSuffixEmptyDefault
 	ProfilePromptSSHPasswordOptional     = ProfileLabelSSHPassword + profileSuffixOptional
 	ProfilePromptSSHIdentityFileOptional = ProfileLabelSSHIdentityFile + profileSuffixOptional
 	ProfilePromptSSHLocalPort            = profileLabelLocalPort + profileSuffixZeroAuto
+	ProfilePromptSSHJumpHosts            = ProfileLabelSSHJumpHosts + " ([user@]host[:port], pisahkan koma)" + profileSuffixOptional
+	ProfilePromptSSHUseAgent             = "Autentikasi SSH via ssh-agent (SSH_AUTH_SOCK)?"
+	ProfilePromptSSLMode                 = "Mode TLS/SSL koneksi database:"
+	ProfilePromptSSLCAOptional           = ProfileLabelSSLCA + " (path PEM)" + profileSuffixOptional
+	ProfilePromptSSLCARequired           = ProfileLabelSSLCA + " (path PEM)"
+	ProfilePromptSSLCertOptional         = ProfileLabelSSLCert + " (path PEM)" + profileSuffixOptional
+	ProfilePromptSSLKey                  = ProfileLabelSSLKey + " (path PEM)"
+	ProfileSSLModeDefaultOption          = "default (ikuti client/driver)"
 
 	ProfileTipKeepCurrentDBPassword  = "💡 Tekan Enter untuk mempertahankan password saat ini."
 	ProfileTipKeepCurrentSSHPassword = "💡 Tekan Enter untuk mempertahankan SSH password saat ini."
 	ProfileTipSSHPasswordOptional    = "💡 SSH password opsional. Kosongkan jika menggunakan key/agent."
+	ProfileTipDBPasswordSocket       = "💡 Password opsional untuk koneksi socket. Kosongkan jika memakai auth unix_socket."
 )
 
 // =============================================================================
@@ -217,12 +257,20 @@ const (
 	ProfileLogDeleteFileFailedFmt         = "Gagal menghapus file %s: %v"
 	ProfileLogUnknownProfileTypeInService = "Tipe profil tidak dikenali dalam Service"
 
-	ProfileLogPrefixCreate = "profile-create"
-	ProfileLogPrefixShow   = "profile-show"
-	ProfileLogPrefixEdit   = "profile-edit"
-	ProfileLogPrefixDelete = "profile-delete"
-	ProfileLogPrefixClone  = "profile-clone"
-	ProfileLogPrefixImport = "profile-import"
+	ProfileLogPrefixCreate   = "profile-create"
+	ProfileLogPrefixShow     = "profile-show"
+	ProfileLogPrefixEdit     = "profile-edit"
+	ProfileLogPrefixDelete   = "profile-delete"
+	ProfileLogPrefixClone    = "profile-clone"
+	ProfileLogPrefixImport   = "profile-import"
+	ProfileLogPrefixRekey    = "profile-rekey"
+	ProfileLogPrefixExport   = "profile-export"
+	ProfileLogPrefixTest     = "profile-test"
+	ProfileLogPrefixSSHTrust = "profile-ssh-trust"
+	ProfileLogPrefixHistory  = "profile-history"
+	ProfileLogPrefixDiff     = "profile-diff"
+	ProfileLogPrefixRollback = "profile-rollback"
+	ProfileLogPrefixSync     = "profile-sync"
 )
 
 // Label field untuk multi-select edit (wizard)
@@ -230,6 +278,7 @@ const (
 	ProfileFieldName            = "Nama profil"
 	ProfileFieldEncryptionKey   = "Kunci enkripsi profil"
 	ProfileFieldSSHTunnelToggle = "SSH Tunnel (enable/disable)"
+	ProfileFieldTLS             = "TLS/SSL (mode, CA, cert, key)"
 )
 
 // =============================================================================
@@ -303,6 +352,8 @@ const (
 	ProfileDisplayFieldSSHTunnel    = "SSH Tunnel"
 	ProfileDisplayFieldFileSize     = "File Size"
 	ProfileDisplayFieldLastModified = "Last Modified"
+	ProfileDisplayFieldTags         = "Tags"
+	ProfileDisplayFieldGroups       = "Groups"
 
 	ProfileDisplayStateNotSet = "(not set)"
 	ProfileDisplayStateSet    = "(set)"
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"sfdbtools/internal/domain"
+
 	"github.com/go-sql-driver/mysql"
 )
 
@@ -22,6 +24,9 @@ type Config struct {
 	Database             string        // Optional, bisa kosong
 	ReadTimeout          time.Duration // Read timeout untuk long-running queries
 	WriteTimeout         time.Duration // Write timeout untuk large data transfers
+	TLS                  domain.TLSConfig
+	TLSServerName        string // Hostname untuk verify-identity (mis. host asli saat lewat SSH tunnel)
+	Socket               string // Path unix socket; jika diisi Host/Port diabaikan
 }
 
 type Client struct {
@@ -62,6 +67,14 @@ func (c *Config) DSN() string {
 		ReadTimeout:  c.ReadTimeout,  // 0 = unlimited
 		WriteTimeout: c.WriteTimeout, // 0 = unlimited
 	}
+	if c.Socket != "" {
+		cfg.Net = "unix"
+		cfg.Addr = c.Socket
+	}
+	// Error TLS sudah dilaporkan oleh NewClient sebelum DSN dipakai.
+	if tlsName, err := c.tlsParam(); err == nil {
+		cfg.TLSConfig = tlsName
+	}
 
 	return cfg.FormatDSN()
 }
@@ -69,6 +82,9 @@ func (c *Config) DSN() string {
 // NewClient membuat instance Client baru, membuka koneksi pool, dan melakukan ping.
 // Ini adalah satu-satunya tempat di mana sql.Open dipanggil.
 func NewClient(ctx context.Context, cfg Config, timeout time.Duration, maxOpenConns, maxIdleConns int, connMaxLifetime time.Duration) (*Client, error) {
+	if _, err := cfg.tlsParam(); err != nil {
+		return nil, fmt.Errorf("konfigurasi TLS tidak valid: %w", err)
+	}
 	db, err := sql.Open("mysql", cfg.DSN())
 	if err != nil {
 		return nil, fmt.Errorf("gagal membuka koneksi sql: %w", err)
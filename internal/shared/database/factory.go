@@ -13,7 +13,7 @@ import (
 // connectWithSpinner adalah helper untuk membuat koneksi dengan spinner UI.
 func connectWithSpinner(info domain.DBInfo, database, label string, timeout time.Duration) (*Client, error) {
 	spin := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
-	spin.Suffix = fmt.Sprintf(" Menghubungkan ke database %s %s:%d...", label, info.Host, info.Port)
+	spin.Suffix = fmt.Sprintf(" Menghubungkan ke database %s %s...", label, DBInfoAddr(info))
 	spin.Start()
 	defer spin.Stop()
 
@@ -27,6 +27,8 @@ func connectWithSpinner(info domain.DBInfo, database, label string, timeout time
 		Database:             database,
 		ReadTimeout:          0,
 		WriteTimeout:         0,
+		TLS:                  info.TLS,
+		Socket:               info.Socket,
 	}
 
 	client, err := NewClient(context.Background(), cfg, timeout, 10, 5, 0)
@@ -46,7 +48,7 @@ func ConnectToDestinationDatabase(creds domain.DestinationDBConnection) (*Client
 
 // ConnectionTest - Menguji koneksi database berdasarkan informasi yang diberikan
 func ConnectionTest(dbInfo *domain.DBInfo, applog applog.Logger) error {
-	applog.Info("Memeriksa koneksi database ke " + dbInfo.Host + ":" + fmt.Sprintf("%d", dbInfo.Port) + "...")
+	applog.Info("Memeriksa koneksi database ke " + DBInfoAddr(*dbInfo) + "...")
 	connectionInfo := domain.DestinationDBConnection{
 		DBInfo:   *dbInfo,
 		Database: "mysql", // Tidak perlu database spesifik untuk tes koneksi
@@ -57,6 +59,6 @@ func ConnectionTest(dbInfo *domain.DBInfo, applog applog.Logger) error {
 		return err
 	}
 	defer client.db.Close()
-	applog.Info("Koneksi database ke " + dbInfo.Host + ":" + fmt.Sprintf("%d", dbInfo.Port) + " berhasil.")
+	applog.Info("Koneksi database ke " + DBInfoAddr(*dbInfo) + " berhasil.")
 	return nil
 }
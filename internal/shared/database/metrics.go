@@ -71,3 +71,54 @@ func (s *Client) GetUserGrantCount(ctx context.Context, dbName string) (int, err
 	}
 	return count, nil
 }
+
+// TableStats adalah metrik satu tabel dari information_schema.TABLES.
+// AutoIncrementColumnType berisi COLUMN_TYPE kolom auto_increment (kosong jika tidak ada).
+// AutoIncrement disimpan sebagai string karena counter BIGINT UNSIGNED bisa melebihi batas int64.
+type TableStats struct {
+	TableName               string
+	Engine                  string
+	TableRows               int64
+	DataLength              int64
+	IndexLength             int64
+	DataFree                int64
+	AutoIncrement           sql.NullString
+	AutoIncrementColumnType string
+	Collation               string
+	Charset                 string
+	CreateTime              sql.NullTime
+	UpdateTime              sql.NullTime
+}
+
+// GetTableStats mengambil metrik per tabel (BASE TABLE) untuk sebuah database.
+func (s *Client) GetTableStats(ctx context.Context, dbName string) ([]TableStats, error) {
+	query := `SET STATEMENT max_statement_time=0 FOR
+		SELECT t.TABLE_NAME, IFNULL(t.ENGINE, ''), IFNULL(t.TABLE_ROWS, 0),
+			IFNULL(t.DATA_LENGTH, 0), IFNULL(t.INDEX_LENGTH, 0), IFNULL(t.DATA_FREE, 0),
+			t.AUTO_INCREMENT,
+			IFNULL((SELECT c.COLUMN_TYPE FROM information_schema.COLUMNS c
+				WHERE c.TABLE_SCHEMA = t.TABLE_SCHEMA AND c.TABLE_NAME = t.TABLE_NAME AND c.EXTRA LIKE '%auto_increment%'
+				LIMIT 1), ''),
+			IFNULL(t.TABLE_COLLATION, ''), IFNULL(co.CHARACTER_SET_NAME, ''),
+			t.CREATE_TIME, t.UPDATE_TIME
+		FROM information_schema.TABLES t
+		LEFT JOIN information_schema.COLLATIONS co ON co.COLLATION_NAME = t.TABLE_COLLATION
+		WHERE t.TABLE_SCHEMA = ? AND t.TABLE_TYPE = 'BASE TABLE'`
+
+	rows, err := s.DB().QueryContext(ctx, query, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []TableStats
+	for rows.Next() {
+		var t TableStats
+		if err := rows.Scan(&t.TableName, &t.Engine, &t.TableRows, &t.DataLength, &t.IndexLength, &t.DataFree,
+			&t.AutoIncrement, &t.AutoIncrementColumnType, &t.Collation, &t.Charset, &t.CreateTime, &t.UpdateTime); err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,148 @@
+// File : internal/shared/database/socket.go
+// Deskripsi : Deteksi dan validasi unix socket MySQL/MariaDB lokal
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package database
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"sfdbtools/internal/domain"
+)
+
+// mycnfPaths adalah file konfigurasi global yang dibaca saat deteksi socket (urutan prioritas).
+var mycnfPaths = []string{"/etc/my.cnf", "/etc/mysql/my.cnf"}
+
+// commonSocketPaths adalah lokasi socket default paket distro, dipakai jika konfigurasi tidak menyebut socket.
+var commonSocketPaths = []string{
+	"/var/lib/mysql/mysql.sock",
+	"/run/mysqld/mysqld.sock",
+	"/var/run/mysqld/mysqld.sock",
+	"/tmp/mysql.sock",
+}
+
+// socketSections adalah section my.cnf yang opsi socket-nya relevan untuk client.
+var socketSections = map[string]bool{
+	"client": true, "client-server": true, "client-mariadb": true,
+	"mysql": true, "mariadb": true, "mysqld": true, "server": true,
+}
+
+// DetectSocketPath mencari path unix socket server lokal.
+// Urutan: `mariadb --print-defaults` / `mysql --print-defaults`, lalu /etc/my.cnf (termasuk !include/!includedir),
+// lalu lokasi default distro. Hanya path yang benar-benar socket yang dikembalikan.
+func DetectSocketPath() (string, error) {
+	var candidates []string
+	for _, bin := range []string{"mariadb", "mysql"} {
+		candidates = append(candidates, socketFromPrintDefaults(bin)...)
+	}
+	for _, p := range mycnfPaths {
+		candidates = append(candidates, socketFromMyCnf(p, 0)...)
+	}
+	candidates = append(candidates, commonSocketPaths...)
+
+	seen := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		if c == "" || seen[c] {
+			continue
+		}
+		seen[c] = true
+		if ValidateSocketPath(c) == nil {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("unix socket MySQL/MariaDB tidak ditemukan (cek opsi socket di /etc/my.cnf atau isi --socket secara eksplisit)")
+}
+
+// ValidateSocketPath memastikan path ada dan merupakan unix socket.
+func ValidateSocketPath(path string) error {
+	p := strings.TrimSpace(path)
+	if p == "" {
+		return fmt.Errorf("path socket kosong")
+	}
+	fi, err := os.Stat(p)
+	if err != nil {
+		return fmt.Errorf("socket database tidak bisa diakses: %s (%v)", p, err)
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("path %s bukan unix socket", p)
+	}
+	return nil
+}
+
+// socketFromPrintDefaults membaca opsi --socket dari output `<bin> --print-defaults`.
+func socketFromPrintDefaults(bin string) []string {
+	path, err := exec.LookPath(bin)
+	if err != nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, path, "--print-defaults").Output()
+	if err != nil {
+		return nil
+	}
+	var sockets []string
+	for _, arg := range strings.Fields(string(out)) {
+		if v, ok := strings.CutPrefix(arg, "--socket="); ok {
+			sockets = append(sockets, strings.Trim(v, `"'`))
+		}
+	}
+	return sockets
+}
+
+// socketFromMyCnf membaca opsi socket dari file my.cnf, mengikuti !include dan !includedir.
+func socketFromMyCnf(path string, depth int) []string {
+	if depth > 4 {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var sockets []string
+	section := ""
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "!includedir"):
+			dir := strings.TrimSpace(strings.TrimPrefix(line, "!includedir"))
+			files, _ := filepath.Glob(filepath.Join(dir, "*.cnf"))
+			for _, inc := range files {
+				sockets = append(sockets, socketFromMyCnf(inc, depth+1)...)
+			}
+		case strings.HasPrefix(line, "!include"):
+			sockets = append(sockets, socketFromMyCnf(strings.TrimSpace(strings.TrimPrefix(line, "!include")), depth+1)...)
+		case strings.HasPrefix(line, "["):
+			section = strings.ToLower(strings.Trim(line, "[] "))
+		default:
+			k, v, ok := strings.Cut(line, "=")
+			if !ok || !socketSections[section] || strings.TrimSpace(k) != "socket" {
+				continue
+			}
+			sockets = append(sockets, strings.Trim(strings.TrimSpace(v), `"'`))
+		}
+	}
+	return sockets
+}
+
+// DBInfoAddr mengembalikan alamat koneksi untuk ditampilkan: "unix:<path>" atau "host:port".
+func DBInfoAddr(info domain.DBInfo) string {
+	if strings.TrimSpace(info.Socket) != "" {
+		return "unix:" + info.Socket
+	}
+	return fmt.Sprintf("%s:%d", info.Host, info.Port)
+}
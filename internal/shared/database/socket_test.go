@@ -0,0 +1,128 @@
+package database
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSocketFromMyCnf(t *testing.T) {
+	dir := t.TempDir()
+	confd := filepath.Join(dir, "conf.d")
+	if err := os.Mkdir(confd, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(filepath.Join(confd, "50-server.cnf"), "[mysqld]\nsocket = /run/mysqld/mysqld.sock\n")
+	write(filepath.Join(confd, "60-galera.cnf"), "[galera]\nsocket=/tmp/galera.sock\n")
+	write(filepath.Join(confd, "README"), "[client]\nsocket=/tmp/ignored.sock\n")
+	write(filepath.Join(dir, "client.cnf"), "[client]\nsocket='/var/lib/mysql/mysql.sock'\n")
+	main := filepath.Join(dir, "my.cnf")
+	write(main, strings.Join([]string{
+		"# komentar",
+		"[mysqldump]",
+		"socket=/tmp/dump.sock",
+		"[Client]",
+		`socket="/tmp/client.sock"`,
+		"port=3306",
+		"!include " + filepath.Join(dir, "client.cnf"),
+		"!includedir " + confd,
+	}, "\n")+"\n")
+
+	got := socketFromMyCnf(main, 0)
+	want := []string{"/tmp/client.sock", "/var/lib/mysql/mysql.sock", "/run/mysqld/mysqld.sock"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("socketFromMyCnf() = %v, want %v", got, want)
+	}
+
+	if got := socketFromMyCnf(filepath.Join(dir, "missing.cnf"), 0); len(got) != 0 {
+		t.Fatalf("socketFromMyCnf(missing) = %v, want kosong", got)
+	}
+
+	// Include yang saling merujuk berhenti di batas kedalaman.
+	loop := filepath.Join(dir, "loop.cnf")
+	write(loop, "[client]\nsocket=/tmp/loop.sock\n!include "+loop+"\n")
+	if got := socketFromMyCnf(loop, 0); len(got) != 5 {
+		t.Fatalf("socketFromMyCnf(loop) = %d socket, want 5", len(got))
+	}
+}
+
+func TestValidateSocketPath(t *testing.T) {
+	// Path unix socket dibatasi ~108 byte, jadi dibuat di temp dir pendek.
+	dir, err := os.MkdirTemp("", "sock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sock := filepath.Join(dir, "mysqld.sock")
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Skipf("unix socket tidak tersedia: %v", err)
+	}
+	defer l.Close()
+	regular := filepath.Join(dir, "file")
+	if err := os.WriteFile(regular, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{name: "socket", path: " " + sock + " "},
+		{name: "empty", path: "  ", wantErr: "kosong"},
+		{name: "missing", path: filepath.Join(dir, "none.sock"), wantErr: "tidak bisa diakses"},
+		{name: "regular file", path: regular, wantErr: "bukan unix socket"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSocketPath(tt.path)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateSocketPath() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateSocketPath() error = %v, want mengandung %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigDSN(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{
+			name: "tcp",
+			cfg:  Config{Host: "10.0.0.5", Port: 3306, User: "app", Password: "pw"},
+			want: "app:pw@tcp(10.0.0.5:3306)/",
+		},
+		{
+			name: "socket replaces host and port",
+			cfg:  Config{Host: "10.0.0.5", Port: 3306, User: "root", Socket: "/run/mysqld/mysqld.sock"},
+			want: "root@unix(/run/mysqld/mysqld.sock)/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.DSN(); !strings.HasPrefix(got, tt.want) {
+				t.Fatalf("DSN() = %q, want prefix %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// File : internal/shared/database/tls.go
+// Deskripsi : Pemetaan ssl_mode profile ke go-sql-driver (DSN) dan argumen client/dump CLI
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package database
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"sfdbtools/internal/domain"
+	"sfdbtools/internal/shared/consts"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// SSLModes adalah daftar ssl_mode yang valid (urutan dari paling longgar ke paling ketat).
+var SSLModes = []string{
+	consts.SSLModeDisabled,
+	consts.SSLModePreferred,
+	consts.SSLModeRequired,
+	consts.SSLModeVerifyCA,
+	consts.SSLModeVerifyIdentity,
+}
+
+// registeredTLS mencatat nama config TLS yang sudah didaftarkan ke driver.
+var registeredTLS sync.Map
+
+// NormalizeSSLMode memvalidasi ssl_mode (case-insensitive, "_" diterima sebagai "-").
+// String kosong berarti mode tidak di-set dan dikembalikan apa adanya.
+func NormalizeSSLMode(mode string) (string, error) {
+	m := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(mode)), "_", "-")
+	if m == "" {
+		return "", nil
+	}
+	for _, v := range SSLModes {
+		if m == v {
+			return m, nil
+		}
+	}
+	return "", fmt.Errorf("ssl_mode tidak valid %q (pilihan: %s)", mode, strings.Join(SSLModes, ", "))
+}
+
+// SSLModeRequiresTLS true jika koneksi wajib terenkripsi.
+// Untuk mode ini, fallback otomatis ke --skip-ssl tidak boleh dilakukan.
+func SSLModeRequiresTLS(mode string) bool {
+	m, _ := NormalizeSSLMode(mode)
+	switch m {
+	case consts.SSLModeRequired, consts.SSLModeVerifyCA, consts.SSLModeVerifyIdentity:
+		return true
+	}
+	return false
+}
+
+// ValidateTLSConfig memeriksa kombinasi field TLS profile.
+func ValidateTLSConfig(t domain.TLSConfig) error {
+	mode, err := NormalizeSSLMode(t.Mode)
+	if err != nil {
+		return err
+	}
+	hasCert := strings.TrimSpace(t.Cert) != ""
+	hasKey := strings.TrimSpace(t.Key) != ""
+	if hasCert != hasKey {
+		return fmt.Errorf("ssl_cert dan ssl_key harus diisi berpasangan")
+	}
+	hasFiles := hasCert || strings.TrimSpace(t.CA) != ""
+	if hasFiles && (mode == "" || mode == consts.SSLModeDisabled) {
+		return fmt.Errorf("ssl_ca/ssl_cert/ssl_key diisi tetapi ssl_mode %q tidak mengaktifkan TLS", t.Mode)
+	}
+	if mode == consts.SSLModeVerifyCA && strings.TrimSpace(t.CA) == "" {
+		return fmt.Errorf("ssl_mode verify-ca membutuhkan ssl_ca")
+	}
+	return nil
+}
+
+// ValidateClientTLSTunnel menolak ssl_mode yang memeriksa hostname sertifikat untuk client/dump CLI
+// lewat SSH tunnel. CLI terhubung ke 127.0.0.1 sehingga pemeriksaan hostname selalu gagal, dan berbeda
+// dengan driver Go (TLSServerName) CLI tidak punya opsi untuk memakai hostname server asli.
+// verify-identity selalu ditolak; verify-ca ditolak untuk binary MariaDB karena dipetakan ke
+// --ssl-verify-server-cert yang ikut memeriksa hostname (lihat ClientTLSArgs).
+func ValidateClientTLSTunnel(t domain.TLSConfig, tunnel, mariadb bool) error {
+	if !tunnel {
+		return nil
+	}
+	mode, _ := NormalizeSSLMode(t.Mode)
+	switch {
+	case mode == consts.SSLModeVerifyIdentity:
+		return fmt.Errorf("ssl_mode verify-identity tidak didukung client mysqldump/mysql lewat SSH tunnel " +
+			"(hostname sertifikat tidak cocok dengan 127.0.0.1); gunakan ssl_mode verify-ca (client MySQL) atau koneksi tanpa tunnel")
+	case mode == consts.SSLModeVerifyCA && mariadb:
+		return fmt.Errorf("ssl_mode verify-ca tidak didukung client/dump MariaDB lewat SSH tunnel " +
+			"(MariaDB ikut memeriksa hostname sertifikat terhadap 127.0.0.1); gunakan ssl_mode required, client MySQL, atau koneksi tanpa tunnel")
+	}
+	return nil
+}
+
+// ClientTLSArgs membuat argumen TLS untuk client/dump CLI.
+// mariadb=true memakai opsi MariaDB (--ssl, --ssl-verify-server-cert) karena MariaDB tidak mengenal --ssl-mode.
+// Catatan: MariaDB tidak punya padanan verify-ca, sehingga verify-ca ikut memverifikasi hostname.
+func ClientTLSArgs(t domain.TLSConfig, mariadb bool) []string {
+	mode, err := NormalizeSSLMode(t.Mode)
+	if err != nil || mode == "" {
+		return nil
+	}
+
+	var args []string
+	if !mariadb {
+		args = append(args, "--ssl-mode="+strings.ToUpper(strings.ReplaceAll(mode, "-", "_")))
+		if mode == consts.SSLModeDisabled {
+			return args
+		}
+		return append(args, tlsFileArgs(t)...)
+	}
+
+	switch mode {
+	case consts.SSLModeDisabled:
+		return []string{"--skip-ssl"}
+	case consts.SSLModePreferred:
+		// Default client MariaDB: TLS dipakai jika server mendukung.
+		return nil
+	case consts.SSLModeRequired:
+		args = append(args, "--ssl", "--disable-ssl-verify-server-cert")
+	default:
+		args = append(args, "--ssl", "--ssl-verify-server-cert")
+	}
+	return append(args, tlsFileArgs(t)...)
+}
+
+func tlsFileArgs(t domain.TLSConfig) []string {
+	var args []string
+	if v := strings.TrimSpace(t.CA); v != "" {
+		args = append(args, "--ssl-ca="+v)
+	}
+	if v := strings.TrimSpace(t.Cert); v != "" {
+		args = append(args, "--ssl-cert="+v)
+	}
+	if v := strings.TrimSpace(t.Key); v != "" {
+		args = append(args, "--ssl-key="+v)
+	}
+	return args
+}
+
+// tlsParam mengembalikan nilai parameter "tls" DSN untuk konfigurasi ini.
+// Mode yang butuh CA/client cert didaftarkan ke driver dengan nama deterministik.
+func (c *Config) tlsParam() (string, error) {
+	mode, err := NormalizeSSLMode(c.TLS.Mode)
+	if err != nil {
+		return "", err
+	}
+	hasClientCert := strings.TrimSpace(c.TLS.Cert) != ""
+
+	switch mode {
+	case "":
+		return "", nil
+	case consts.SSLModeDisabled:
+		return "false", nil
+	case consts.SSLModePreferred:
+		return "preferred", nil
+	case consts.SSLModeRequired:
+		if !hasClientCert {
+			return "skip-verify", nil
+		}
+	}
+
+	serverName := strings.TrimSpace(c.TLSServerName)
+	if serverName == "" {
+		serverName = c.Host
+	}
+	sum := sha256.Sum256([]byte(strings.Join([]string{mode, c.TLS.CA, c.TLS.Cert, c.TLS.Key, serverName}, "\x00")))
+	name := "sfdb-" + hex.EncodeToString(sum[:8])
+	if _, ok := registeredTLS.Load(name); ok {
+		return name, nil
+	}
+
+	tlsCfg, err := buildTLSConfig(mode, c.TLS, serverName)
+	if err != nil {
+		return "", err
+	}
+	if err := mysql.RegisterTLSConfig(name, tlsCfg); err != nil {
+		return "", fmt.Errorf("gagal mendaftarkan konfigurasi TLS: %w", err)
+	}
+	registeredTLS.Store(name, struct{}{})
+	return name, nil
+}
+
+func buildTLSConfig(mode string, t domain.TLSConfig, serverName string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if ca := strings.TrimSpace(t.CA); ca != "" {
+		pem, err := os.ReadFile(ca)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membaca ssl_ca %s: %w", ca, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ssl_ca %s tidak berisi sertifikat PEM yang valid", ca)
+		}
+		cfg.RootCAs = pool
+	}
+	if cert := strings.TrimSpace(t.Cert); cert != "" {
+		pair, err := tls.LoadX509KeyPair(cert, strings.TrimSpace(t.Key))
+		if err != nil {
+			return nil, fmt.Errorf("gagal memuat ssl_cert/ssl_key: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{pair}
+	}
+
+	switch mode {
+	case consts.SSLModeRequired:
+		cfg.InsecureSkipVerify = true
+	case consts.SSLModeVerifyCA:
+		// Verifikasi rantai sertifikat terhadap CA tanpa mencocokkan hostname.
+		cfg.InsecureSkipVerify = true
+		roots := cfg.RootCAs
+		cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyChain(rawCerts, roots)
+		}
+	case consts.SSLModeVerifyIdentity:
+		// Tanpa ssl_ca, RootCAs nil = CA sistem.
+		cfg.ServerName = serverName
+	}
+	return cfg, nil
+}
+
+func verifyChain(rawCerts [][]byte, roots *x509.CertPool) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("server tidak mengirim sertifikat TLS")
+	}
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		c, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("sertifikat server tidak valid: %w", err)
+		}
+		certs = append(certs, c)
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range certs[1:] {
+		intermediates.AddCert(c)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates})
+	return err
+}
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"slices"
+	"testing"
+
+	"sfdbtools/internal/domain"
+)
+
+func TestNormalizeSSLMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "", want: ""},
+		{in: "   ", want: ""},
+		{in: "disabled", want: "disabled"},
+		{in: "PREFERRED", want: "preferred"},
+		{in: " required ", want: "required"},
+		{in: "VERIFY_CA", want: "verify-ca"},
+		{in: "verify_identity", want: "verify-identity"},
+		{in: "verify-full", wantErr: true},
+		{in: "true", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := NormalizeSSLMode(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeSSLMode(%q) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("NormalizeSSLMode(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTLSConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     domain.TLSConfig
+		wantErr bool
+	}{
+		{name: "empty", cfg: domain.TLSConfig{}},
+		{name: "required without files", cfg: domain.TLSConfig{Mode: "required"}},
+		{name: "verify-ca with ca", cfg: domain.TLSConfig{Mode: "verify-ca", CA: "/etc/ssl/ca.pem"}},
+		{name: "invalid mode", cfg: domain.TLSConfig{Mode: "strict"}, wantErr: true},
+		{name: "cert without key", cfg: domain.TLSConfig{Mode: "required", Cert: "/c.pem"}, wantErr: true},
+		{name: "files with disabled", cfg: domain.TLSConfig{Mode: "disabled", CA: "/ca.pem"}, wantErr: true},
+		{name: "files without mode", cfg: domain.TLSConfig{CA: "/ca.pem"}, wantErr: true},
+		{name: "verify-ca without ca", cfg: domain.TLSConfig{Mode: "verify-ca"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTLSConfig(tt.cfg); (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateTLSConfig(%+v) err = %v, wantErr %v", tt.cfg, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClientTLSArgs(t *testing.T) {
+	files := domain.TLSConfig{CA: "/ca.pem", Cert: "/c.pem", Key: "/k.pem"}
+	withMode := func(mode string) domain.TLSConfig {
+		c := files
+		c.Mode = mode
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		cfg     domain.TLSConfig
+		mariadb bool
+		want    []string
+	}{
+		{name: "unset", cfg: domain.TLSConfig{}, want: nil},
+		{name: "mysql disabled", cfg: withMode("disabled"), want: []string{"--ssl-mode=DISABLED"}},
+		{name: "mysql verify-ca", cfg: withMode("verify_ca"),
+			want: []string{"--ssl-mode=VERIFY_CA", "--ssl-ca=/ca.pem", "--ssl-cert=/c.pem", "--ssl-key=/k.pem"}},
+		{name: "mariadb disabled", cfg: withMode("disabled"), mariadb: true, want: []string{"--skip-ssl"}},
+		{name: "mariadb preferred", cfg: withMode("preferred"), mariadb: true, want: nil},
+		{name: "mariadb required", cfg: domain.TLSConfig{Mode: "required"}, mariadb: true,
+			want: []string{"--ssl", "--disable-ssl-verify-server-cert"}},
+		{name: "mariadb verify-identity", cfg: domain.TLSConfig{Mode: "verify-identity", CA: "/ca.pem"}, mariadb: true,
+			want: []string{"--ssl", "--ssl-verify-server-cert", "--ssl-ca=/ca.pem"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClientTLSArgs(tt.cfg, tt.mariadb); !slices.Equal(got, tt.want) {
+				t.Fatalf("ClientTLSArgs = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateClientTLSTunnel(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    string
+		tunnel  bool
+		mariadb bool
+		wantErr bool
+	}{
+		{name: "verify-identity direct", mode: "verify-identity"},
+		{name: "verify-identity over tunnel", mode: "VERIFY_IDENTITY", tunnel: true, wantErr: true},
+		{name: "verify-identity mariadb over tunnel", mode: "verify-identity", tunnel: true, mariadb: true, wantErr: true},
+		{name: "verify-ca over tunnel", mode: "verify-ca", tunnel: true},
+		{name: "verify-ca mariadb over tunnel", mode: "verify-ca", tunnel: true, mariadb: true, wantErr: true},
+		{name: "verify-ca mariadb direct", mode: "verify-ca", mariadb: true},
+		{name: "required mariadb over tunnel", mode: "required", tunnel: true, mariadb: true},
+		{name: "unset over tunnel", tunnel: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateClientTLSTunnel(domain.TLSConfig{Mode: tt.mode}, tt.tunnel, tt.mariadb)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateClientTLSTunnel err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,16 +1,23 @@
 // File : internal/shared/execx/dump.go
-// Deskripsi : Helper untuk resolve binary database CLI (mariadb-dump/mysqldump)
+// Deskripsi : Helper untuk resolve binary database CLI (mariadb-dump/mysqldump, mariadb/mysql)
 // Author : Hadiyatna Muflihun
 // Tanggal : 15 Januari 2026
-// Last Modified : 15 Januari 2026
+// Last Modified : 18 Oktober 2026
 
 package execx
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
+	"strings"
+	"sync"
+	"time"
 )
 
+// mariadbClientCache menyimpan hasil deteksi flavor per path binary.
+var mariadbClientCache sync.Map
+
 // ResolvedBinary merepresentasikan binary yang berhasil di-resolve dari PATH.
 // Name adalah nama command yang user kenal, Path adalah path absolut yang ditemukan.
 type ResolvedBinary struct {
@@ -29,3 +36,32 @@ func ResolveMariaDBDumpOrMysqldump() (ResolvedBinary, error) {
 	}
 	return ResolvedBinary{}, fmt.Errorf("binary dump tidak ditemukan: butuh 'mariadb-dump' atau 'mysqldump' di PATH")
 }
+
+// ResolveMariaDBOrMySQLClient memilih client mariadb jika tersedia, dan fallback ke mysql.
+func ResolveMariaDBOrMySQLClient() (ResolvedBinary, error) {
+	if p, err := exec.LookPath("mariadb"); err == nil {
+		return ResolvedBinary{Name: "mariadb", Path: p}, nil
+	}
+	if p, err := exec.LookPath("mysql"); err == nil {
+		return ResolvedBinary{Name: "mysql", Path: p}, nil
+	}
+	return ResolvedBinary{}, fmt.Errorf("binary client database tidak ditemukan: butuh 'mariadb' atau 'mysql' di PATH")
+}
+
+// IsMariaDBClient true jika binary client/dump adalah build MariaDB.
+// Nama mariadb/mariadb-dump langsung dianggap MariaDB; mysql/mysqldump dicek via --version
+// karena pada instalasi MariaDB lama keduanya adalah binary MariaDB.
+func IsMariaDBClient(bin ResolvedBinary) bool {
+	if strings.HasPrefix(bin.Name, "mariadb") {
+		return true
+	}
+	if v, ok := mariadbClientCache.Load(bin.Path); ok {
+		return v.(bool)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, bin.Path, "--version").Output()
+	isMariaDB := err == nil && strings.Contains(strings.ToLower(string(out)), "mariadb")
+	mariadbClientCache.Store(bin.Path, isMariaDB)
+	return isMariaDB
+}
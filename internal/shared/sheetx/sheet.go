@@ -0,0 +1,134 @@
+// File : internal/shared/sheetx/sheet.go
+// Deskripsi : Writer tabel XLSX/CSV bersama (header tebal, freeze pane, autofilter, password workbook opsional)
+// Author : Hadiyatna Muflihun
+// Tanggal : 18 Oktober 2026
+// Last Modified : 18 Oktober 2026
+
+package sheetx
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"sfdbtools/internal/shared/fsops"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// SetSheetRows menulis header dan baris ke sheet yang sudah ada. Header dibuat tebal,
+// dibekukan, dan diberi autofilter agar kolom angka bisa langsung di-sort/filter di spreadsheet.
+func SetSheetRows(f *excelize.File, sheet string, headers []string, rows [][]interface{}) error {
+	head := make([]interface{}, len(headers))
+	for i, h := range headers {
+		head[i] = h
+	}
+	if err := f.SetSheetRow(sheet, "A1", &head); err != nil {
+		return err
+	}
+	for i := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+2)
+		if err := f.SetSheetRow(sheet, cell, &rows[i]); err != nil {
+			return err
+		}
+	}
+
+	lastCol, _ := excelize.ColumnNumberToName(len(headers))
+	if style, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}}); err == nil {
+		_ = f.SetCellStyle(sheet, "A1", lastCol+"1", style)
+	}
+	if len(rows) > 0 {
+		lastCell, _ := excelize.CoordinatesToCellName(len(headers), len(rows)+1)
+		_ = f.AutoFilter(sheet, "A1:"+lastCell, nil)
+	}
+	_ = f.SetPanes(sheet, &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"})
+	return nil
+}
+
+// SaveXLSX menyimpan workbook ke path (atomic, permission 0600). Jika password diisi,
+// seluruh workbook dienkripsi.
+func SaveXLSX(path string, f *excelize.File, password string) error {
+	var buf bytes.Buffer
+	if err := f.Write(&buf, excelize.Options{Password: password}); err != nil {
+		return fmt.Errorf("gagal membentuk file XLSX: %w", err)
+	}
+	if err := fsops.WriteFile(path, buf.Bytes()); err != nil {
+		return fmt.Errorf("gagal menulis file export %s: %w", path, err)
+	}
+	return nil
+}
+
+// WriteXLSX menulis satu tabel (header + baris) ke workbook satu sheet.
+func WriteXLSX(path, sheet string, headers []string, rows [][]interface{}, password string) error {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+
+	if err := f.SetSheetName(f.GetSheetName(0), sheet); err != nil {
+		return fmt.Errorf("gagal membuat sheet %s: %w", sheet, err)
+	}
+	if err := SetSheetRows(f, sheet, headers, rows); err != nil {
+		return err
+	}
+	return SaveXLSX(path, f, password)
+}
+
+// BuildCSV membentuk isi CSV dari header dan baris.
+func BuildCSV(headers []string, rows [][]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write(headers)
+	for _, row := range rows {
+		_ = w.Write(toStrings(row))
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("gagal membentuk CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteCSV menulis satu tabel (header + baris) ke CSV (atomic, permission 0600).
+func WriteCSV(path string, headers []string, rows [][]interface{}) error {
+	data, err := BuildCSV(headers, rows)
+	if err != nil {
+		return err
+	}
+	if err := fsops.WriteFile(path, data); err != nil {
+		return fmt.Errorf("gagal menulis file export %s: %w", path, err)
+	}
+	return nil
+}
+
+// TextRows mengubah baris string menjadi baris cell. Nilai tetap bertipe string sehingga port,
+// password numerik, dsb. ditulis sebagai teks dan tidak diubah formatnya oleh spreadsheet.
+func TextRows(rows [][]string) [][]interface{} {
+	out := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		cells := make([]interface{}, len(row))
+		for j, v := range row {
+			cells[j] = v
+		}
+		out[i] = cells
+	}
+	return out
+}
+
+func toStrings(row []interface{}) []string {
+	out := make([]string, len(row))
+	for i, v := range row {
+		switch val := v.(type) {
+		case string:
+			out[i] = val
+		case int:
+			out[i] = strconv.Itoa(val)
+		case int64:
+			out[i] = strconv.FormatInt(val, 10)
+		case float64:
+			out[i] = strconv.FormatFloat(val, 'f', 2, 64)
+		default:
+			out[i] = fmt.Sprint(val)
+		}
+	}
+	return out
+}
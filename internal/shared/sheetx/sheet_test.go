@@ -0,0 +1,93 @@
+package sheetx
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestWriteTable(t *testing.T) {
+	headers := []string{"name", "port", "password"}
+	rows := [][]string{{"prod", "3306", "007"}, {"stage", "3307", "a,b"}}
+
+	tests := []struct {
+		name     string
+		file     string
+		password string
+	}{
+		{name: "csv", file: "profiles.csv"},
+		{name: "xlsx", file: "profiles.xlsx"},
+		{name: "encrypted xlsx", file: "secure.xlsx", password: "rahasia"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			var err error
+			if filepath.Ext(tt.file) == ".csv" {
+				err = WriteCSV(path, headers, TextRows(rows))
+			} else {
+				err = WriteXLSX(path, "Profiles", headers, TextRows(rows), tt.password)
+			}
+			if err != nil {
+				t.Fatalf("write error = %v", err)
+			}
+
+			st, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if st.Mode().Perm() != 0o600 {
+				t.Fatalf("permission = %o, want 600", st.Mode().Perm())
+			}
+
+			got := readTable(t, path, tt.password)
+			want := append([][]string{headers}, rows...)
+			if len(got) != len(want) {
+				t.Fatalf("rows = %v, want %v", got, want)
+			}
+			for i := range want {
+				if !slices.Equal(got[i], want[i]) {
+					t.Fatalf("row %d = %v, want %v", i, got[i], want[i])
+				}
+			}
+
+			if tt.password != "" {
+				if f, err := excelize.OpenFile(path); err == nil {
+					f.Close()
+					t.Fatal("workbook terenkripsi bisa dibuka tanpa password")
+				}
+			}
+		})
+	}
+}
+
+func readTable(t *testing.T, path, password string) [][]string {
+	t.Helper()
+	if filepath.Ext(path) == ".csv" {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		rows, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rows
+	}
+	f, err := excelize.OpenFile(path, excelize.Options{Password: password})
+	if err != nil {
+		t.Fatalf("gagal membuka XLSX: %v", err)
+	}
+	defer f.Close()
+	rows, err := f.GetRows("Profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}